@@ -0,0 +1,50 @@
+package encoding
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Each blob field element must be smaller than the BLS modulus, so we only use the lower
+// 31 bytes of every 32-byte field element to store the data.
+var (
+	BlobBytesPerFieldElement = params.BlobTxBytesPerFieldElement - 1
+	BlobMaxDataSize          = params.BlobTxFieldElementsPerBlob * BlobBytesPerFieldElement
+)
+
+// EncodeBlob encodes the given data into a EIP-4844 blob, the first byte of each field element
+// is always zero.
+func EncodeBlob(data []byte) (*kzg4844.Blob, error) {
+	if len(data) > BlobMaxDataSize {
+		return nil, fmt.Errorf("data too large for a blob: %d > %d", len(data), BlobMaxDataSize)
+	}
+
+	var blob kzg4844.Blob
+	for i := 0; i*BlobBytesPerFieldElement < len(data); i++ {
+		start := i * BlobBytesPerFieldElement
+		end := start + BlobBytesPerFieldElement
+		if end > len(data) {
+			end = len(data)
+		}
+		copy(blob[i*params.BlobTxBytesPerFieldElement+1:], data[start:end])
+	}
+
+	return &blob, nil
+}
+
+// DecodeBlob decodes the data from the given blob, which was encoded by EncodeBlob, the returned
+// bytes always have the length of BlobMaxDataSize.
+func DecodeBlob(blob *kzg4844.Blob) ([]byte, error) {
+	data := make([]byte, 0, BlobMaxDataSize)
+	for i := 0; i < params.BlobTxFieldElementsPerBlob; i++ {
+		fieldElement := blob[i*params.BlobTxBytesPerFieldElement : (i+1)*params.BlobTxBytesPerFieldElement]
+		if fieldElement[0] != 0 {
+			return nil, fmt.Errorf("invalid blob field element %d, the first byte is not zero", i)
+		}
+		data = append(data, fieldElement[1:]...)
+	}
+
+	return data, nil
+}
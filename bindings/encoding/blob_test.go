@@ -0,0 +1,45 @@
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecodeBlob(t *testing.T) {
+	data := randomBytes(BlobMaxDataSize)
+
+	blob, err := EncodeBlob(data)
+	require.Nil(t, err)
+
+	decoded, err := DecodeBlob(blob)
+	require.Nil(t, err)
+	require.Equal(t, BlobMaxDataSize, len(decoded))
+	require.Equal(t, data, decoded)
+}
+
+func TestEncodeDecodeBlobPartial(t *testing.T) {
+	data := randomBytes(100)
+
+	blob, err := EncodeBlob(data)
+	require.Nil(t, err)
+
+	decoded, err := DecodeBlob(blob)
+	require.Nil(t, err)
+	require.Equal(t, data, decoded[:len(data)])
+	require.Equal(t, make([]byte, BlobMaxDataSize-len(data)), decoded[len(data):])
+}
+
+func TestEncodeBlobTooLarge(t *testing.T) {
+	_, err := EncodeBlob(randomBytes(BlobMaxDataSize + 1))
+	require.NotNil(t, err)
+}
+
+func TestDecodeBlobInvalidFieldElement(t *testing.T) {
+	blob, err := EncodeBlob(randomBytes(10))
+	require.Nil(t, err)
+
+	blob[32] = 1
+	_, err = DecodeBlob(blob)
+	require.NotNil(t, err)
+}
@@ -13,6 +13,7 @@ var (
 	driverCategory   = "DRIVER"
 	proposerCategory = "PROPOSER"
 	proverCategory   = "PROVER"
+	replayCategory   = "REPLAY"
 )
 
 // Required flags used by all client software.
@@ -115,6 +116,40 @@ var (
 		Category: commonCategory,
 		Value:    1 * time.Minute,
 	}
+	L1FallbackEndpoints = &cli.StringSliceFlag{
+		Name:     "l1.fallbacks",
+		Usage:    "Fallback RPC endpoints of L1 ethereum nodes, used when the primary L1 endpoint is unhealthy",
+		Category: commonCategory,
+	}
+	L2FallbackEndpoints = &cli.StringSliceFlag{
+		Name:     "l2.fallbacks",
+		Usage:    "Fallback RPC endpoints of L2 taiko-geth execution engines, used when the primary L2 endpoint is unhealthy",
+		Category: commonCategory,
+	}
+	RPCHealthCheckInterval = &cli.DurationFlag{
+		Name:     "rpc.healthCheckInterval",
+		Usage:    "Interval between two health checks of the RPC endpoints, only used with fallback endpoints",
+		Category: commonCategory,
+		Value:    12 * time.Second,
+	}
+	RPCMaxHeadLag = &cli.Uint64Flag{
+		Name:     "rpc.maxHeadLag",
+		Usage:    "Maximum number of blocks a healthy RPC endpoint's head can lag behind the highest known head",
+		Category: commonCategory,
+		Value:    3,
+	}
+	RPCMaxLatency = &cli.DurationFlag{
+		Name:     "rpc.maxLatency",
+		Usage:    "Maximum average latency of a healthy RPC endpoint, zero means no limit",
+		Category: commonCategory,
+		Value:    5 * time.Second,
+	}
+	RPCMaxErrorRate = &cli.Float64Flag{
+		Name:     "rpc.maxErrorRate",
+		Usage:    "Maximum ratio of the failed calls in the latest calls of a healthy RPC endpoint",
+		Category: commonCategory,
+		Value:    0.5,
+	}
 )
 
 // CommonFlags All common flags.
@@ -133,6 +168,12 @@ var CommonFlags = []cli.Flag{
 	BackOffRetryInterval,
 	RPCTimeout,
 	WaitReceiptTimeout,
+	L1FallbackEndpoints,
+	L2FallbackEndpoints,
+	RPCHealthCheckInterval,
+	RPCMaxHeadLag,
+	RPCMaxLatency,
+	RPCMaxErrorRate,
 }
 
 // MergeFlags merges the given flag slices.
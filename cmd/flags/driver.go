@@ -43,6 +43,44 @@ var (
 		Usage:    "HTTP RPC endpoint of another synced L2 execution engine node",
 		Category: driverCategory,
 	}
+	// Blob related.
+	L1BeaconEndpoint = &cli.StringFlag{
+		Name:     "l1.beacon",
+		Usage:    "HTTP endpoint of a L1 beacon node, used to fetch the blobs of blob proposals",
+		Category: driverCategory,
+	}
+	BlobArchiveDir = &cli.StringFlag{
+		Name: "blob.archiveDir",
+		Usage: "Path to a local blob archive directory, which will be checked before the L1 beacon node, " +
+			"each blob is stored in a file named by its versioned hash",
+		Category: driverCategory,
+	}
+	// Status server related.
+	DriverHTTPServerPort = &cli.Uint64Flag{
+		Name:     "http.port",
+		Usage:    "Port to expose for the driver status HTTP / JSON-RPC server, zero means disabled",
+		Category: driverCategory,
+	}
+)
+
+// Reorg journal related flags.
+var (
+	ReorgJournalPath = &cli.StringFlag{
+		Name:     "reorg.journalPath",
+		Usage:    "Database file path to persist the detected L1 reorg events, not persisted if not set",
+		Category: driverCategory,
+	}
+	ReorgJournalFrom = &cli.Uint64Flag{
+		Name:     "reorg.from",
+		Usage:    "Sequence number of the first reorg event to query",
+		Category: driverCategory,
+	}
+	ReorgJournalLimit = &cli.IntFlag{
+		Name:     "reorg.limit",
+		Usage:    "Maximum number of the reorg events to query",
+		Value:    100,
+		Category: driverCategory,
+	}
 )
 
 // DriverFlags All driver flags.
@@ -53,4 +91,18 @@ var DriverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	P2PSyncVerifiedBlocks,
 	P2PSyncTimeout,
 	CheckPointSyncURL,
+	L1BeaconEndpoint,
+	BlobArchiveDir,
+	DriverHTTPServerPort,
+	ReorgJournalPath,
 })
+
+// ReorgJournalFlags All reorg journal query flags, the journal can only be queried when the driver
+// using it is stopped.
+var ReorgJournalFlags = []cli.Flag{
+	ReorgJournalPath,
+	ReorgJournalFrom,
+	ReorgJournalLimit,
+	Verbosity,
+	LogJSON,
+}
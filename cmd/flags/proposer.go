@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/urfave/cli/v2"
 
 	"github.com/taikoxyz/taiko-client/internal/version"
@@ -8,12 +10,6 @@ import (
 
 // Required flags used by proposer.
 var (
-	L1ProposerPrivKey = &cli.StringFlag{
-		Name:     "l1.proposerPrivKey",
-		Usage:    "Private key of the L1 proposer, who will send TaikoL1.proposeBlock transactions",
-		Required: true,
-		Category: proposerCategory,
-	}
 	ProverEndpoints = &cli.StringFlag{
 		Name:     "proverEndpoints",
 		Usage:    "Comma-delineated list of prover endpoints proposer should query when attempting to propose a block",
@@ -30,6 +26,32 @@ var (
 
 // Optional flags used by proposer.
 var (
+	// Signer related, one of them is required.
+	L1ProposerPrivKey = &cli.StringFlag{
+		Name:     "l1.proposerPrivKey",
+		Usage:    "Private key of the L1 proposer, who will send TaikoL1.proposeBlock transactions",
+		Category: proposerCategory,
+	}
+	L1ProposerKeystore = &cli.StringFlag{
+		Name:     "l1.proposerKeystore",
+		Usage:    "Path to the encrypted keystore JSON file of the L1 proposer",
+		Category: proposerCategory,
+	}
+	L1ProposerKeystorePassword = &cli.StringFlag{
+		Name:     "l1.proposerKeystorePassword",
+		Usage:    "Path to the file containing the password of the L1 proposer keystore",
+		Category: proposerCategory,
+	}
+	L1ProposerRemoteSigner = &cli.StringFlag{
+		Name:     "l1.proposerRemoteSigner",
+		Usage:    "HTTP endpoint of a web3signer, which holds the key of the L1 proposer",
+		Category: proposerCategory,
+	}
+	L1ProposerRemoteSignerAddress = &cli.StringFlag{
+		Name:     "l1.proposerRemoteSignerAddress",
+		Usage:    "Address of the L1 proposer account in the web3signer",
+		Category: proposerCategory,
+	}
 	// Tier fee related.
 	OptimisticTierFee = &cli.Uint64Flag{
 		Name:     "tierFee.optimistic",
@@ -116,12 +138,165 @@ var (
 		Usage:    "Gas tip cap (in wei) for a TaikoL1.proposeBlock transaction when doing the transaction replacement",
 		Category: proposerCategory,
 	}
+	ProposeBlockTxResubmissionTimeout = &cli.DurationFlag{
+		Name:     "tx.resubmissionTimeout",
+		Usage:    "Time to wait before replacing a pending TaikoL1.proposeBlock transaction with a higher gas tip",
+		Value:    1 * time.Minute,
+		Category: proposerCategory,
+	}
+	ProposeBlockTxStuckTimeout = &cli.DurationFlag{
+		Name: "tx.stuckTimeout",
+		Usage: "Time after which a pending proposer transaction is considered stuck, " +
+			"and cancelled by a self-transfer",
+		Value:    10 * time.Minute,
+		Category: proposerCategory,
+	}
 	ProposeBlockIncludeParentMetaHash = &cli.BoolFlag{
 		Name:     "includeParentMetaHash",
 		Usage:    "Include parent meta hash when proposing block",
 		Value:    false,
 		Category: proposerCategory,
 	}
+	// ERC-20 fee token related.
+	ProposerFeeToken = &cli.StringFlag{
+		Name: "feeToken",
+		Usage: "ERC-20 token `address` used to pay the prover fees instead of ETH, " +
+			"the tier fees are then denominated in the smallest unit of this token",
+		Category: proposerCategory,
+	}
+	ProposerFeeTokenApproveAmount = &cli.StringFlag{
+		Name: "feeToken.approveAmount",
+		Usage: "Amount of the fee token to approve the assignment hook to spend, when the current allowance " +
+			"can not cover the max prover fee, the max prover fee is approved if not set",
+		Category: proposerCategory,
+	}
+	// Reputation-based prover selection related.
+	ProverReputation = &cli.BoolFlag{
+		Name: "proverSelector.reputation",
+		Usage: "Rank the prover endpoints by the provers' proving history and quoted fees, " +
+			"instead of picking them randomly, only ETH prover fees are supported",
+		Value:    false,
+		Category: proposerCategory,
+	}
+	ProverReputationDBPath = &cli.StringFlag{
+		Name:     "proverSelector.dbPath",
+		Usage:    "Database file path to persist the provers' proving history, kept in memory if not set",
+		Category: proposerCategory,
+	}
+	ProverReliabilityWeight = &cli.Float64Flag{
+		Name:     "proverSelector.reliabilityWeight",
+		Usage:    "Weight between 0 and 1 of the prover's reliability in its score, the rest is the weight of its quoted fee",
+		Value:    0.7,
+		Category: proposerCategory,
+	}
+	// Prover assignment auction related.
+	ProverAuction = &cli.BoolFlag{
+		Name: "proverSelector.auction",
+		Usage: "Request bids from all the prover endpoints at once and pick the cheapest valid one, " +
+			"instead of bumping the tier fees round by round, only ETH prover fees are supported",
+		Value:    false,
+		Category: proposerCategory,
+	}
+	ProverAuctionDeadline = &cli.DurationFlag{
+		Name:     "proverSelector.auctionDeadline",
+		Usage:    "Time to wait for the prover bids in each auction",
+		Value:    3 * time.Second,
+		Category: proposerCategory,
+	}
+	// Transaction inclusion policy related.
+	TxPolicyDenySenders = &cli.StringFlag{
+		Name: "txPolicy.denySenders",
+		Usage: "File listing the senders whose transactions will never be proposed, one address per line, " +
+			"reloaded on SIGHUP",
+		Category: proposerCategory,
+	}
+	TxPolicyDenyRecipients = &cli.StringFlag{
+		Name: "txPolicy.denyRecipients",
+		Usage: "File listing the recipients whose transactions will never be proposed, one address per line, " +
+			"reloaded on SIGHUP",
+		Category: proposerCategory,
+	}
+	TxPolicyAllowedContracts = &cli.StringFlag{
+		Name: "txPolicy.allowedContracts",
+		Usage: "File listing the only contracts which can be called by the proposed transactions, " +
+			"one address per line, reloaded on SIGHUP, all contract calls are allowed if not set",
+		Category: proposerCategory,
+	}
+	TxPolicyMinTip = &cli.Uint64Flag{
+		Name:     "txPolicy.minTip",
+		Usage:    "Minimum effective priority fee per gas (in wei) of a proposed transaction",
+		Category: proposerCategory,
+	}
+	TxPolicyMaxTxsPerSender = &cli.Uint64Flag{
+		Name:     "txPolicy.maxTxsPerSender",
+		Usage:    "Maximum number of transactions of a single sender proposed in one proposing epoch, 0 means no limit",
+		Category: proposerCategory,
+	}
+	// Proposal scheduling related.
+	ProposalScheduling = &cli.BoolFlag{
+		Name: "scheduler.enabled",
+		Usage: "Hold the proposals while the L1 fees are higher than their recent averages, " +
+			"the pool is checked every scheduler.checkInterval, can not be used with epoch.interval",
+		Value:    false,
+		Category: proposerCategory,
+	}
+	ProposalSchedulingCheckInterval = &cli.DurationFlag{
+		Name:     "scheduler.checkInterval",
+		Usage:    "Interval to check the L1 fees and the transaction pool when the scheduler is enabled",
+		Value:    12 * time.Second,
+		Category: proposerCategory,
+	}
+	ProposalSchedulingFeeHistoryBlocks = &cli.Uint64Flag{
+		Name:     "scheduler.feeHistoryBlocks",
+		Usage:    "Number of the latest L1 blocks whose fees are averaged as the fee baselines",
+		Value:    32,
+		Category: proposerCategory,
+	}
+	ProposalSchedulingFeeThreshold = &cli.Uint64Flag{
+		Name:     "scheduler.feeThreshold",
+		Usage:    "Percentage of the baseline above which an L1 fee is considered expensive",
+		Value:    110,
+		Category: proposerCategory,
+	}
+	ProposalSchedulingMaxDelay = &cli.DurationFlag{
+		Name:     "scheduler.maxDelay",
+		Usage:    "Maximum time to hold the proposals while the L1 fees are expensive",
+		Value:    5 * time.Minute,
+		Category: proposerCategory,
+	}
+	ProposalSchedulingMaxPendingBytes = &cli.Uint64Flag{
+		Name:     "scheduler.maxPendingBytes",
+		Usage:    "Maximum bytes of the pending transactions lists to hold, 0 means no limit",
+		Category: proposerCategory,
+	}
+	ProposalSchedulingMaxBaseFee = &cli.Uint64Flag{
+		Name: "scheduler.maxBaseFee",
+		Usage: "L1 base fee (in wei) above which the proposals are held regardless of its recent average, " +
+			"0 means no limit",
+		Category: proposerCategory,
+	}
+	ProposalSchedulingMaxBlobBaseFee = &cli.Uint64Flag{
+		Name: "scheduler.maxBlobBaseFee",
+		Usage: "L1 blob base fee (in wei) above which the proposals are held regardless of its recent average, " +
+			"0 means no limit",
+		Category: proposerCategory,
+	}
+	// Blob related.
+	BlobAllowed = &cli.BoolFlag{
+		Name: "l1.blobAllowed",
+		Usage: "Send EIP-4844 blob transactions when proposing blocks, if the L1 blob cost is " +
+			"lower than the calldata cost in the current epoch",
+		Value:    false,
+		Category: proposerCategory,
+	}
+	// Dry-run related.
+	DryRun = &cli.BoolFlag{
+		Name: "dryRun",
+		Usage: "Simulate the proposing transactions and log the estimated costs in each epoch, " +
+			"without sending any transaction",
+		Value:    false,
+		Category: proposerCategory,
+	}
 )
 
 // ProposerFlags All proposer flags.
@@ -129,6 +304,10 @@ var ProposerFlags = MergeFlags(CommonFlags, []cli.Flag{
 	L2HTTPEndpoint,
 	TaikoTokenAddress,
 	L1ProposerPrivKey,
+	L1ProposerKeystore,
+	L1ProposerKeystorePassword,
+	L1ProposerRemoteSigner,
+	L1ProposerRemoteSignerAddress,
 	ProposeInterval,
 	TxPoolLocals,
 	TxPoolLocalsOnly,
@@ -138,6 +317,8 @@ var ProposerFlags = MergeFlags(CommonFlags, []cli.Flag{
 	ProposeBlockTxGasLimit,
 	ProposeBlockTxReplacementMultiplier,
 	ProposeBlockTxGasTipCap,
+	ProposeBlockTxResubmissionTimeout,
+	ProposeBlockTxStuckTimeout,
 	ProverEndpoints,
 	OptimisticTierFee,
 	SgxTierFee,
@@ -147,4 +328,26 @@ var ProposerFlags = MergeFlags(CommonFlags, []cli.Flag{
 	MaxTierFeePriceBumps,
 	ProposeBlockIncludeParentMetaHash,
 	ProposerAssignmentHookAddress,
+	ProposerFeeToken,
+	ProposerFeeTokenApproveAmount,
+	ProverReputation,
+	ProverReputationDBPath,
+	ProverReliabilityWeight,
+	ProverAuction,
+	ProverAuctionDeadline,
+	TxPolicyDenySenders,
+	TxPolicyDenyRecipients,
+	TxPolicyAllowedContracts,
+	TxPolicyMinTip,
+	TxPolicyMaxTxsPerSender,
+	ProposalScheduling,
+	ProposalSchedulingCheckInterval,
+	ProposalSchedulingFeeHistoryBlocks,
+	ProposalSchedulingFeeThreshold,
+	ProposalSchedulingMaxDelay,
+	ProposalSchedulingMaxPendingBytes,
+	ProposalSchedulingMaxBaseFee,
+	ProposalSchedulingMaxBlobBaseFee,
+	BlobAllowed,
+	DryRun,
 })
@@ -8,12 +8,6 @@ import (
 
 // Required flags used by prover.
 var (
-	L1ProverPrivKey = &cli.StringFlag{
-		Name:     "l1.proverPrivKey",
-		Usage:    "Private key of L1 prover, who will send TaikoL1.proveBlock transactions",
-		Required: true,
-		Category: proverCategory,
-	}
 	ProverCapacity = &cli.Uint64Flag{
 		Name:     "prover.capacity",
 		Usage:    "Capacity of prover",
@@ -30,6 +24,32 @@ var (
 
 // Optional flags used by prover.
 var (
+	// Signer related, one of them is required.
+	L1ProverPrivKey = &cli.StringFlag{
+		Name:     "l1.proverPrivKey",
+		Usage:    "Private key of L1 prover, who will send TaikoL1.proveBlock transactions",
+		Category: proverCategory,
+	}
+	L1ProverKeystore = &cli.StringFlag{
+		Name:     "l1.proverKeystore",
+		Usage:    "Path to the encrypted keystore JSON file of the L1 prover",
+		Category: proverCategory,
+	}
+	L1ProverKeystorePassword = &cli.StringFlag{
+		Name:     "l1.proverKeystorePassword",
+		Usage:    "Path to the file containing the password of the L1 prover keystore",
+		Category: proverCategory,
+	}
+	L1ProverRemoteSigner = &cli.StringFlag{
+		Name:     "l1.proverRemoteSigner",
+		Usage:    "HTTP endpoint of a web3signer, which holds the key of the L1 prover",
+		Category: proverCategory,
+	}
+	L1ProverRemoteSignerAddress = &cli.StringFlag{
+		Name:     "l1.proverRemoteSignerAddress",
+		Usage:    "Address of the L1 prover account in the web3signer",
+		Category: proverCategory,
+	}
 	ZkEvmRpcdEndpoint = &cli.StringFlag{
 		Name:     "zkevm.rpcdEndpoint",
 		Usage:    "RPC endpoint of a ZKEVM RPCD service",
@@ -45,6 +65,24 @@ var (
 		Usage:    "RPC endpoint of a Raiko host service",
 		Category: proverCategory,
 	}
+	RaikoHostEndpoints = &cli.StringSliceFlag{
+		Name:     "raiko.hostEndpoints",
+		Usage:    "RPC endpoints of redundant Raiko host services, SGX proofs are raced across them and the primary endpoint",
+		Category: proverCategory,
+	}
+	ZkEvmRpcdEndpoints = &cli.StringSliceFlag{
+		Name: "zkevm.rpcdEndpoints",
+		Usage: "RPC endpoints of redundant ZKEVM RPCD services, PSE zkEVM proofs are raced across them and " +
+			"the primary endpoint",
+		Category: proverCategory,
+	}
+	ProofRaceBackends = &cli.Uint64Flag{
+		Name: "prover.proofRaceBackends",
+		Usage: "Number of the redundant proof producer backends requested for each proof, the healthiest ones are picked, " +
+			"one of the others is probed every 10 requests, 0 means all backends",
+		Value:    0,
+		Category: proverCategory,
+	}
 	StartingBlockID = &cli.Uint64Flag{
 		Name:     "prover.startingBlockID",
 		Usage:    "If set, prover will start proving blocks from the block with this ID",
@@ -84,6 +122,46 @@ var (
 		Usage:    "Minimum accepted fee for generating a SGX + PSE zkEVM proof",
 		Category: proverCategory,
 	}
+	TierFeeMargin = &cli.Uint64Flag{
+		Name:     "tierFee.margin",
+		Usage:    "Margin percentage added to the estimated proving cost when pricing the tier fees",
+		Value:    20,
+		Category: proverCategory,
+	}
+	TierFeeQueuePremium = &cli.Uint64Flag{
+		Name:     "tierFee.queuePremium",
+		Usage:    "Extra percentage added to the tier fees when the prover is fully loaded, scaled by the queue depth",
+		Value:    50,
+		Category: proverCategory,
+	}
+	TierFeeProveBlockGas = &cli.Uint64Flag{
+		Name:     "tierFee.proveBlockGas",
+		Usage:    "Estimated gas used by a TaikoL1.proveBlock transaction, built-in estimates per tier are used if not set",
+		Category: proverCategory,
+	}
+	TierFeeBondTokenPrice = &cli.Uint64Flag{
+		Name:     "tierFee.bondTokenPrice",
+		Usage:    "Price of one liveness bond token in wei, used to price the bond opportunity cost, ignored if not set",
+		Category: proverCategory,
+	}
+	TierFeeBondAPR = &cli.Uint64Flag{
+		Name:     "tierFee.bondAPR",
+		Usage:    "Annual opportunity cost percentage of the locked liveness bond",
+		Value:    5,
+		Category: proverCategory,
+	}
+	TierFeeUpdateInterval = &cli.DurationFlag{
+		Name:     "tierFee.updateInterval",
+		Usage:    "Interval to refresh the L1 gas price used to price the tier fees",
+		Value:    12 * time.Second,
+		Category: proverCategory,
+	}
+	FeeTokens = &cli.StringSliceFlag{
+		Name: "prover.feeTokens",
+		Usage: "Accepted ERC-20 fee tokens besides ETH, in the form of `address:rate`, where the rate is the number of " +
+			"the smallest token units worth one ether, used to convert the minimum tier fees",
+		Category: proverCategory,
+	}
 	// Guardian prover related.
 	GuardianProver = &cli.StringFlag{
 		Name:     "guardianProver",
@@ -126,6 +204,36 @@ var (
 		Category: proverCategory,
 		Value:    false,
 	}
+	ContestL2Endpoint = &cli.StringFlag{
+		Name: "contest.l2Endpoint",
+		Usage: "HTTP RPC endpoint of an independent L2 node, used to confirm a transition is wrong before contesting it, " +
+			"if not set, wrong transitions are only logged, never contested",
+		Category: proverCategory,
+	}
+	ContestMinConfirmations = &cli.Uint64Flag{
+		Name:     "contest.minConfirmations",
+		Usage:    "Number of L2 nodes which must confirm a transition is wrong before acting on it, 0 means all",
+		Value:    0,
+		Category: proverCategory,
+	}
+	ContestMinCooldownRemaining = &cli.DurationFlag{
+		Name:     "contest.minCooldownRemaining",
+		Usage:    "Skip contesting a transition if less than this much of its cooldown window is left",
+		Value:    0,
+		Category: proverCategory,
+	}
+	ContestMaxBond = &cli.StringFlag{
+		Name: "contest.maxBond",
+		Usage: "Maximum contest bond to risk, transitions of the tiers with higher contest bonds are proven " +
+			"at a higher tier directly instead, not set means no limit",
+		Category: proverCategory,
+	}
+	ContestRequireFollowUp = &cli.BoolFlag{
+		Name:     "contest.requireFollowUp",
+		Usage:    "Only contest a transition if this prover can follow up with a higher tier proof",
+		Value:    false,
+		Category: proverCategory,
+	}
 	// HTTP server related.
 	ProverHTTPServerPort = &cli.Uint64Flag{
 		Name:     "http.port",
@@ -178,11 +286,57 @@ var (
 		Usage:    "Amount to approve AssignmentHook contract for TaikoToken usage",
 		Category: proverCategory,
 	}
+	BondAllowanceThreshold = &cli.StringFlag{
+		Name: "bond.allowanceThreshold",
+		Usage: "Top up the TaikoToken allowances to --prover.allowance when they drop below this amount, " +
+			"not set means no automatic top-ups",
+		Category: proverCategory,
+	}
+	BondBalanceThreshold = &cli.StringFlag{
+		Name:     "bond.balanceThreshold",
+		Usage:    "Alert when the prover's TaikoToken balance plus its L1 escrow drops below this amount",
+		Category: proverCategory,
+	}
+	BondCheckInterval = &cli.DurationFlag{
+		Name:     "bond.checkInterval",
+		Usage:    "Interval to refresh the prover's TaikoToken balance and allowances",
+		Value:    1 * time.Minute,
+		Category: proverCategory,
+	}
+	LedgerProfitFilter = &cli.BoolFlag{
+		Name: "ledger.profitFilter",
+		Usage: "Skip proving unassigned blocks and contesting transitions when their expected profit is negative, " +
+			"estimated from the L1 gas price, the proof backend costs and the configured rewards",
+		Value:    false,
+		Category: proverCategory,
+	}
+	LedgerBackendCosts = &cli.StringSliceFlag{
+		Name:     "ledger.backendCosts",
+		Usage:    "Estimated cost (in wei) of generating a proof of each tier, in the form of `tier:cost`",
+		Category: proverCategory,
+	}
+	LedgerUnassignedReward = &cli.StringFlag{
+		Name:     "ledger.unassignedReward",
+		Usage:    "Expected reward (in wei) of proving an unassigned block after its proving window expired",
+		Category: proverCategory,
+	}
+	LedgerContestReward = &cli.StringFlag{
+		Name:     "ledger.contestReward",
+		Usage:    "Expected reward (in wei) of contesting an invalid transition, or proving a contested one",
+		Category: proverCategory,
+	}
 	GuardianProverHealthCheckServerEndpoint = &cli.StringFlag{
 		Name:     "prover.guardianProverHealthCheckServerEndpoint",
 		Usage:    "HTTP endpoint for main guardian prover health check server",
 		Category: proverCategory,
 	}
+	VerifyProofLocally = &cli.BoolFlag{
+		Name: "prover.verifyProofLocally",
+		Usage: "Simulate each proof submission against the latest L1 state before sending it, " +
+			"the proofs which will always be reverted are skipped",
+		Value:    false,
+		Category: proverCategory,
+	}
 	// Guardian prover specific flag
 	EnableLivenessBondProof = &cli.BoolFlag{
 		Name:     "prover.enableLivenessBondProof",
@@ -200,11 +354,25 @@ var ProverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	ZkEvmRpcdEndpoint,
 	ZkEvmRpcdParamsPath,
 	RaikoHostEndpoint,
+	RaikoHostEndpoints,
+	ZkEvmRpcdEndpoints,
+	ProofRaceBackends,
 	L1ProverPrivKey,
+	L1ProverKeystore,
+	L1ProverKeystorePassword,
+	L1ProverRemoteSigner,
+	L1ProverRemoteSignerAddress,
 	MinOptimisticTierFee,
 	MinSgxTierFee,
 	MinPseZkevmTierFee,
 	MinSgxAndPseZkevmTierFee,
+	TierFeeMargin,
+	TierFeeQueuePremium,
+	TierFeeProveBlockGas,
+	TierFeeBondTokenPrice,
+	TierFeeBondAPR,
+	TierFeeUpdateInterval,
+	FeeTokens,
 	StartingBlockID,
 	Dummy,
 	GuardianProver,
@@ -227,4 +395,17 @@ var ProverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	DatabaseCacheSize,
 	ProverAssignmentHookAddress,
 	Allowance,
+	VerifyProofLocally,
+	BondAllowanceThreshold,
+	BondBalanceThreshold,
+	BondCheckInterval,
+	LedgerProfitFilter,
+	LedgerBackendCosts,
+	LedgerUnassignedReward,
+	LedgerContestReward,
+	ContestL2Endpoint,
+	ContestMinConfirmations,
+	ContestMinCooldownRemaining,
+	ContestMaxBond,
+	ContestRequireFollowUp,
 })
@@ -0,0 +1,50 @@
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// Optional flags used by replay.
+var (
+	ReplayStartBlockID = &cli.Uint64Flag{
+		Name:     "replay.startBlockID",
+		Usage:    "First L2 block ID to replay, used with replay.endBlockID",
+		Category: replayCategory,
+	}
+	ReplayEndBlockID = &cli.Uint64Flag{
+		Name:     "replay.endBlockID",
+		Usage:    "Last L2 block ID to replay, used with replay.startBlockID",
+		Category: replayCategory,
+	}
+	ReplayL1Start = &cli.Uint64Flag{
+		Name:     "replay.l1Start",
+		Usage:    "First L1 block height to replay the blocks proposed in, used with replay.l1End",
+		Category: replayCategory,
+	}
+	ReplayL1End = &cli.Uint64Flag{
+		Name:     "replay.l1End",
+		Usage:    "Last L1 block height to replay the blocks proposed in, used with replay.l1Start",
+		Category: replayCategory,
+	}
+	ReplayReport = &cli.StringFlag{
+		Name:     "replay.report",
+		Usage:    "Path to write the JSON replay report to, the report is printed to stdout if not set",
+		Category: replayCategory,
+	}
+)
+
+// ReplayFlags All replay flags, the L2 taiko-geth execution engine behind the authenticated endpoint
+// is used to re-derive the blocks, so it must not be the one being audited, and it must already hold
+// the state of the replayed blocks' parents.
+var ReplayFlags = MergeFlags(CommonFlags, []cli.Flag{
+	L2WSEndpoint,
+	L2AuthEndpoint,
+	JWTSecret,
+	L1BeaconEndpoint,
+	BlobArchiveDir,
+	ReplayStartBlockID,
+	ReplayEndBlockID,
+	ReplayL1Start,
+	ReplayL1End,
+	ReplayReport,
+})
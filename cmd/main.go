@@ -9,9 +9,11 @@ import (
 	"github.com/taikoxyz/taiko-client/cmd/flags"
 	"github.com/taikoxyz/taiko-client/cmd/utils"
 	"github.com/taikoxyz/taiko-client/driver"
+	"github.com/taikoxyz/taiko-client/driver/journal"
 	"github.com/taikoxyz/taiko-client/internal/version"
 	"github.com/taikoxyz/taiko-client/proposer"
 	"github.com/taikoxyz/taiko-client/prover"
+	"github.com/taikoxyz/taiko-client/replayer"
 )
 
 func main() {
@@ -48,6 +50,20 @@ func main() {
 			Description: "Taiko prover software",
 			Action:      utils.SubcommandAction(new(prover.Prover)),
 		},
+		{
+			Name:        "reorgs",
+			Flags:       flags.ReorgJournalFlags,
+			Usage:       "Queries the L1 reorg events in a driver's reorg journal",
+			Description: "Taiko driver reorg journal query, the driver using the journal must be stopped",
+			Action:      journal.QueryAction,
+		},
+		{
+			Name:        "replay",
+			Flags:       flags.ReplayFlags,
+			Usage:       "Replays the L2 blocks derivation from L1, and compares the results against a L2 node",
+			Description: "Taiko derivation replayer, the L2 execution engine behind l2.auth is used to re-derive blocks",
+			Action:      utils.OneshotAction(new(replayer.Replayer)),
+		},
 	}
 
 	if err := app.Run(os.Args); err != nil {
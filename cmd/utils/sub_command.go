@@ -61,3 +61,26 @@ func SubcommandAction(app SubcommandApplication) cli.ActionFunc {
 		return nil
 	}
 }
+
+// OneshotAction runs the given application once, the application is closed as soon as its Start
+// method returns, instead of waiting for a termination signal.
+func OneshotAction(app SubcommandApplication) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		logger.InitLogger(c)
+
+		ctx, ctxClose := context.WithCancel(context.Background())
+		defer ctxClose()
+
+		if err := app.InitFromCli(ctx, c); err != nil {
+			return err
+		}
+		defer func() {
+			app.Close(ctx)
+			log.Info("Application stopped", "name", app.Name())
+		}()
+
+		log.Info("Starting Taiko client application", "name", app.Name())
+
+		return app.Start()
+	}
+}
@@ -60,6 +60,202 @@ const docTemplate = `{
                 }
             }
         },
+        "/bond": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get current prover bond status",
+                "operationId": "get-bond-status",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/bond.Status"
+                        }
+                    },
+                    "404": {
+                        "description": "bond manager not enabled",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/ledger": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get current prover ledger",
+                "operationId": "get-ledger",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.Status"
+                        }
+                    },
+                    "404": {
+                        "description": "ledger not enabled",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/quote": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Bid for a block proof assignment",
+                "parameters": [
+                    {
+                        "description": "quote request body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/server.CreateQuoteRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.QuoteResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "unknown tier",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/signedBlock/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get a signed block by block ID",
+                "operationId": "get-signed-block",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "block ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.SignedBlockResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid block ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "signed block not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/signedBlocks": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get signed blocks",
+                "operationId": "get-signed-blocks",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "minimum block timestamp",
+                        "name": "startTimestamp",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum block timestamp",
+                        "name": "endTimestamp",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "minimum block ID",
+                        "name": "startBlockID",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum block ID",
+                        "name": "endBlockID",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of blocks to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of blocks to return",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/db.SignedBlock"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "invalid query parameters",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "signed blocks database not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/status": {
             "get": {
                 "consumes": [
@@ -85,6 +281,72 @@ const docTemplate = `{
         "big.Int": {
             "type": "object"
         },
+        "bond.Exposure": {
+            "type": "object",
+            "properties": {
+                "blockID": {
+                    "type": "integer"
+                },
+                "contested": {
+                    "type": "boolean"
+                },
+                "livenessBond": {
+                    "$ref": "#/definitions/big.Int"
+                },
+                "tier": {
+                    "type": "integer"
+                },
+                "validityBond": {
+                    "$ref": "#/definitions/big.Int"
+                }
+            }
+        },
+        "bond.Status": {
+            "type": "object",
+            "properties": {
+                "allowances": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/big.Int"
+                    }
+                },
+                "balance": {
+                    "$ref": "#/definitions/big.Int"
+                },
+                "blocks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/bond.Exposure"
+                    }
+                },
+                "escrow": {
+                    "$ref": "#/definitions/big.Int"
+                },
+                "exposure": {
+                    "$ref": "#/definitions/big.Int"
+                }
+            }
+        },
+        "db.SignedBlock": {
+            "type": "object",
+            "properties": {
+                "blockHash": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "blockID": {
+                    "$ref": "#/definitions/big.Int"
+                },
+                "signature": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "integer"
+                }
+            }
+        },
         "encoding.TierFee": {
             "type": "object",
             "properties": {
@@ -96,9 +358,99 @@ const docTemplate = `{
                 }
             }
         },
+        "ledger.Entry": {
+            "type": "object",
+            "properties": {
+                "assigned": {
+                    "type": "boolean"
+                },
+                "backendCost": {
+                    "$ref": "#/definitions/big.Int"
+                },
+                "blockID": {
+                    "type": "integer"
+                },
+                "bondLocked": {
+                    "$ref": "#/definitions/big.Int"
+                },
+                "bondLost": {
+                    "$ref": "#/definitions/big.Int"
+                },
+                "bondReturned": {
+                    "$ref": "#/definitions/big.Int"
+                },
+                "fee": {
+                    "$ref": "#/definitions/big.Int"
+                },
+                "feeToken": {
+                    "type": "string"
+                },
+                "feeWei": {
+                    "$ref": "#/definitions/big.Int"
+                },
+                "gasCost": {
+                    "$ref": "#/definitions/big.Int"
+                },
+                "profit": {
+                    "$ref": "#/definitions/big.Int"
+                },
+                "settled": {
+                    "type": "boolean"
+                },
+                "tier": {
+                    "type": "integer"
+                }
+            }
+        },
+        "ledger.Status": {
+            "type": "object",
+            "properties": {
+                "blocks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledger.Entry"
+                    }
+                },
+                "totals": {
+                    "$ref": "#/definitions/ledger.Totals"
+                }
+            }
+        },
+        "ledger.Totals": {
+            "type": "object",
+            "properties": {
+                "backendCost": {
+                    "$ref": "#/definitions/big.Int"
+                },
+                "blocks": {
+                    "type": "integer"
+                },
+                "bondLocked": {
+                    "$ref": "#/definitions/big.Int"
+                },
+                "bondLost": {
+                    "$ref": "#/definitions/big.Int"
+                },
+                "bondReturned": {
+                    "$ref": "#/definitions/big.Int"
+                },
+                "fees": {
+                    "$ref": "#/definitions/big.Int"
+                },
+                "gasCost": {
+                    "$ref": "#/definitions/big.Int"
+                },
+                "profit": {
+                    "$ref": "#/definitions/big.Int"
+                }
+            }
+        },
         "server.CreateAssignmentRequestBody": {
             "type": "object",
             "properties": {
+                "dryRun": {
+                    "type": "boolean"
+                },
                 "expiry": {
                     "type": "integer"
                 },
@@ -119,6 +471,32 @@ const docTemplate = `{
                 }
             }
         },
+        "server.CreateQuoteRequestBody": {
+            "type": "object",
+            "properties": {
+                "dryRun": {
+                    "type": "boolean"
+                },
+                "expiry": {
+                    "type": "integer"
+                },
+                "feeToken": {
+                    "type": "string"
+                },
+                "tiers": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "txListHash": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
         "server.ProposeBlockResponse": {
             "type": "object",
             "properties": {
@@ -139,9 +517,67 @@ const docTemplate = `{
                 }
             }
         },
+        "server.QuoteResponse": {
+            "type": "object",
+            "properties": {
+                "maxBlockID": {
+                    "type": "integer"
+                },
+                "maxProposedIn": {
+                    "type": "integer"
+                },
+                "prover": {
+                    "type": "string"
+                },
+                "signedPayload": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "tierFees": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/encoding.TierFee"
+                    }
+                }
+            }
+        },
+        "server.SignedBlockResponse": {
+            "type": "object",
+            "properties": {
+                "blockHash": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "blockID": {
+                    "$ref": "#/definitions/big.Int"
+                },
+                "prover": {
+                    "type": "string"
+                },
+                "signature": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "integer"
+                }
+            }
+        },
         "server.Status": {
             "type": "object",
             "properties": {
+                "feeTokens": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "l1GasPrice": {
+                    "type": "integer"
+                },
                 "maxExpiry": {
                     "type": "integer"
                 },
@@ -151,6 +587,9 @@ const docTemplate = `{
                 "minPseZkevmTierFee": {
                     "type": "integer"
                 },
+                "minSgxAndPseZkevmTierFee": {
+                    "type": "integer"
+                },
                 "minSgxTierFee": {
                     "type": "integer"
                 },
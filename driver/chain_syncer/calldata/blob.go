@@ -0,0 +1,249 @@
+package calldata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/pkg/blobsource"
+	txListValidator "github.com/taikoxyz/taiko-client/pkg/txlistvalidator"
+)
+
+var (
+	errNoBlobSource = errors.New("no blob source configured")
+	// blobCachedFilterRange is the maximum L1 blocks range of each `TaikoL1.BlobCached` events query,
+	// when looking for the L1 block which originally carried a reused blob.
+	blobCachedFilterRange uint64 = 1000
+)
+
+// sliceTxListFromBlob slices the transactions list bytes from the given decoded blob data.
+func sliceTxListFromBlob(data []byte, offset *big.Int, size *big.Int) ([]byte, error) {
+	if !offset.IsUint64() || !size.IsUint64() {
+		return nil, fmt.Errorf("invalid transactions list range in blob: offset %s, size %s", offset, size)
+	}
+
+	end := offset.Uint64() + size.Uint64()
+	if end < offset.Uint64() || end > uint64(len(data)) {
+		return nil, fmt.Errorf("transactions list range out of blob: offset %s, size %s", offset, size)
+	}
+
+	return data[offset.Uint64():end], nil
+}
+
+// txListFromBlob decodes the given blob and slices the transactions list bytes from it. Since the blobs
+// are only checked against their KZG commitments on L1, a blob which can't be decoded, or a transactions
+// list range out of it, makes the transactions list invalid rather than failing the derivation, the
+// returned hint is HintOK only if the transactions list bytes are sliced.
+func txListFromBlob(
+	blockID *big.Int,
+	blob *kzg4844.Blob,
+	offset *big.Int,
+	size *big.Int,
+) ([]byte, txListValidator.InvalidTxListReason) {
+	data, err := encoding.DecodeBlob(blob)
+	if err != nil {
+		log.Info("Blob not decodable", "blockID", blockID, "error", err)
+		return nil, txListValidator.HintBlobNotDecodable
+	}
+
+	txListBytes, err := sliceTxListFromBlob(data, offset, size)
+	if err != nil {
+		log.Info("Invalid transactions list in blob", "blockID", blockID, "error", err)
+		return nil, txListValidator.HintBlobTxListOutOfRange
+	}
+
+	return txListBytes, txListValidator.HintOK
+}
+
+// fetchProposedBlob fetches the blob used by the given proposed block, and checks it against the
+// blob hash in block metadata.
+func (s *Syncer) fetchProposedBlob(
+	ctx context.Context,
+	event *bindings.TaikoL1ClientBlockProposed,
+	tx *types.Transaction,
+) (*kzg4844.Blob, error) {
+	if s.blobSource == nil {
+		return nil, errNoBlobSource
+	}
+
+	blobHash := common.Hash(event.Meta.BlobHash)
+
+	l1Header, err := s.rpc.L1.HeaderByHash(ctx, event.Raw.BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch L1 block header: %w", err)
+	}
+
+	s.blobCache.Prune(l1Header.Time)
+
+	if blob, ok := s.blobCache.Get(blobHash, l1Header.Time); ok {
+		log.Debug("Use cached blob", "blockID", event.BlockId, "blobHash", blobHash)
+		return blob, nil
+	}
+
+	return s.fetchBlob(ctx, event, tx, l1Header)
+}
+
+// fetchBlob fetches the blob used by the given proposed block from the blob source, if the blob
+// is reused, it will be fetched from the L1 block which originally carried it.
+func (s *Syncer) fetchBlob(
+	ctx context.Context,
+	event *bindings.TaikoL1ClientBlockProposed,
+	tx *types.Transaction,
+	l1Header *types.Header,
+) (*kzg4844.Blob, error) {
+	var (
+		blobHash  = common.Hash(event.Meta.BlobHash)
+		originTx  = tx
+		originLog *types.Log
+		err       error
+	)
+
+	// If the blob is not carried by the proposing transaction, it's a blob cached in protocol before.
+	if !isBlobCarried(tx, blobHash) {
+		if originLog, err = s.findBlobCachedLog(ctx, blobHash, l1Header); err != nil {
+			return nil, err
+		}
+		if l1Header, err = s.rpc.L1.HeaderByHash(ctx, originLog.BlockHash); err != nil {
+			return nil, fmt.Errorf("failed to fetch L1 block header: %w", err)
+		}
+		originTx = nil
+	}
+
+	log.Info(
+		"Fetch blob",
+		"blockID", event.BlockId,
+		"blobHash", blobHash,
+		"l1Height", l1Header.Number,
+		"reused", originTx == nil,
+	)
+
+	blob, err := s.blobSource.GetBlob(ctx, l1Header, blobHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch blob %s: %w", blobHash, err)
+	}
+
+	hash, err := blobsource.BlobHash(blob)
+	if err != nil {
+		return nil, err
+	}
+	if hash != blobHash {
+		return nil, fmt.Errorf("blob hash mismatch, expected %s, got %s", blobHash, hash)
+	}
+
+	// Only keep the blobs which can be reused by the following proposals.
+	if originTx != nil {
+		cached, err := s.isBlobCachedInTx(ctx, originTx, event.Raw.Address, blobHash)
+		if err != nil {
+			return nil, err
+		}
+		if !cached {
+			return blob, nil
+		}
+	}
+
+	s.blobCache.Add(blobHash, blob, l1Header.Time+s.blobExpiry)
+
+	return blob, nil
+}
+
+// isBlobCarried checks whether the given blob is attached to the given transaction.
+func isBlobCarried(tx *types.Transaction, blobHash common.Hash) bool {
+	for _, hash := range tx.BlobHashes() {
+		if hash == blobHash {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isBlobCachedInTx checks whether the given transaction cached the blob in protocol for reuse.
+func (s *Syncer) isBlobCachedInTx(
+	ctx context.Context,
+	tx *types.Transaction,
+	taikoL1Address common.Address,
+	blobHash common.Hash,
+) (bool, error) {
+	receipt, err := s.rpc.L1.TransactionReceipt(ctx, tx.Hash())
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch TaikoL1.proposeBlock transaction receipt: %w", err)
+	}
+
+	for _, l := range receipt.Logs {
+		if l.Address != taikoL1Address {
+			continue
+		}
+		event, err := s.rpc.TaikoL1.ParseBlobCached(*l)
+		if err != nil {
+			continue
+		}
+		if event.BlobHash == blobHash {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// findBlobCachedLog finds the latest `TaikoL1.BlobCached` event of the given blob, which is still
+// reusable in the given L1 block.
+func (s *Syncer) findBlobCachedLog(
+	ctx context.Context,
+	blobHash common.Hash,
+	l1Header *types.Header,
+) (*types.Log, error) {
+	end := l1Header.Number.Uint64()
+	for {
+		var start uint64
+		if end >= blobCachedFilterRange {
+			start = end - blobCachedFilterRange + 1
+		}
+
+		iter, err := s.rpc.TaikoL1.FilterBlobCached(&bind.FilterOpts{Start: start, End: &end, Context: ctx})
+		if err != nil {
+			return nil, fmt.Errorf("failed to filter TaikoL1.BlobCached events: %w", err)
+		}
+
+		var found *types.Log
+		for iter.Next() {
+			if iter.Event.BlobHash == blobHash {
+				raw := iter.Event.Raw
+				found = &raw
+			}
+		}
+		if err := iter.Error(); err != nil {
+			iter.Close()
+			return nil, fmt.Errorf("failed to iterate TaikoL1.BlobCached events: %w", err)
+		}
+		iter.Close()
+
+		if found != nil {
+			return found, nil
+		}
+		if start == 0 {
+			break
+		}
+
+		// Stop searching if the blobs cached before this range have already expired.
+		startHeader, err := s.rpc.L1.HeaderByNumber(ctx, new(big.Int).SetUint64(start))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch L1 block header: %w", err)
+		}
+		if startHeader.Time+s.blobExpiry <= l1Header.Time {
+			break
+		}
+
+		end = start - 1
+	}
+
+	return nil, fmt.Errorf("%w: no reusable cached blob %s", blobsource.ErrBlobNotFound, blobHash)
+}
@@ -0,0 +1,62 @@
+package calldata
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	txListValidator "github.com/taikoxyz/taiko-client/pkg/txlistvalidator"
+)
+
+func TestSliceTxListFromBlob(t *testing.T) {
+	data := []byte{0, 1, 2, 3, 4, 5}
+
+	txListBytes, err := sliceTxListFromBlob(data, big.NewInt(1), big.NewInt(3))
+	require.Nil(t, err)
+	require.Equal(t, []byte{1, 2, 3}, txListBytes)
+
+	txListBytes, err = sliceTxListFromBlob(data, big.NewInt(6), common.Big0)
+	require.Nil(t, err)
+	require.Empty(t, txListBytes)
+
+	_, err = sliceTxListFromBlob(data, big.NewInt(4), big.NewInt(3))
+	require.NotNil(t, err)
+
+	_, err = sliceTxListFromBlob(data, new(big.Int).Lsh(common.Big1, 64), common.Big1)
+	require.NotNil(t, err)
+}
+
+func TestTxListFromBlob(t *testing.T) {
+	txList := []byte{1, 2, 3, 4, 5}
+
+	blob, err := encoding.EncodeBlob(txList)
+	require.Nil(t, err)
+
+	txListBytes, hint := txListFromBlob(common.Big1, blob, common.Big0, big.NewInt(int64(len(txList))))
+	require.Equal(t, txListValidator.HintOK, hint)
+	require.Equal(t, txList, txListBytes)
+
+	// The transactions list range is out of the blob.
+	_, hint = txListFromBlob(common.Big1, blob, big.NewInt(int64(encoding.BlobMaxDataSize)), common.Big1)
+	require.Equal(t, txListValidator.HintBlobTxListOutOfRange, hint)
+
+	// A field element with a nonzero high byte is still below the BLS modulus, so the blob is
+	// valid on L1, but it can't be decoded.
+	blob[params.BlobTxBytesPerFieldElement] = 0x73
+	_, hint = txListFromBlob(common.Big1, blob, common.Big0, big.NewInt(int64(len(txList))))
+	require.Equal(t, txListValidator.HintBlobNotDecodable, hint)
+}
+
+func TestIsBlobCarried(t *testing.T) {
+	blobHash := common.HexToHash("0x01")
+	tx := types.NewTx(&types.BlobTx{BlobHashes: []common.Hash{blobHash}})
+
+	require.True(t, isBlobCarried(tx, blobHash))
+	require.False(t, isBlobCarried(tx, common.HexToHash("0x02")))
+	require.False(t, isBlobCarried(types.NewTx(&types.DynamicFeeTx{}), blobHash))
+}
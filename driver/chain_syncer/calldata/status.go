@@ -0,0 +1,77 @@
+package calldata
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/driver/journal"
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+	txListValidator "github.com/taikoxyz/taiko-client/pkg/txlistvalidator"
+)
+
+// maxRecentReorgs is the maximum number of the recent L1 reorgs kept by the syncer.
+const maxRecentReorgs = 16
+
+// InsertedBlock is a L2 block inserted by the syncer, the transactions list hint tells why the block is
+// an empty one, and the diagnostics are the proposed transactions the execution engine skipped.
+type InsertedBlock struct {
+	BlockID       *big.Int                            `json:"blockID"`
+	Hash          common.Hash                         `json:"hash"`
+	L1Height      uint64                              `json:"l1Height"`
+	L1Hash        common.Hash                         `json:"l1Hash"`
+	Transactions  int                                 `json:"transactions"`
+	TxListHint    txListValidator.InvalidTxListReason `json:"txListHint"`
+	TxDiagnostics []*txListValidator.TxDiagnostic     `json:"txDiagnostics,omitempty"`
+	InsertedAt    time.Time                           `json:"insertedAt"`
+}
+
+// RecentReorgs returns the recent L1 reorgs detected by the syncer, from the oldest to the newest.
+func (s *Syncer) RecentReorgs() []*journal.ReorgEvent {
+	s.statusMutex.RLock()
+	defer s.statusMutex.RUnlock()
+
+	return append([]*journal.ReorgEvent{}, s.recentReorgs...)
+}
+
+// LastInsertedBlock returns the last L2 block inserted by the syncer, nil if there is none.
+func (s *Syncer) LastInsertedBlock() *InsertedBlock {
+	s.statusMutex.RLock()
+	defer s.statusMutex.RUnlock()
+
+	return s.lastInsertedBlock
+}
+
+// recordReorg records a detected L1 reorg, which resets the L1Current cursor and the last inserted block ID,
+// the event is also appended to the reorg journal, if there is one.
+func (s *Syncer) recordReorg(e *journal.ReorgEvent) {
+	s.statusMutex.Lock()
+	defer s.statusMutex.Unlock()
+
+	e.DetectedAt = time.Now()
+	e.LastInsertedBlockIDOld = s.lastInsertedBlockID
+
+	metrics.DriverL1ReorgCounter.Inc(1)
+	metrics.DriverL1ReorgDepthHistogram.Update(int64(e.Depth()))
+
+	if s.reorgJournal != nil {
+		if err := s.reorgJournal.Append(e); err != nil {
+			log.Error("Failed to append reorg event to journal", "trigger", e.Trigger, "error", err)
+		}
+	}
+
+	s.recentReorgs = append(s.recentReorgs, e)
+	if len(s.recentReorgs) > maxRecentReorgs {
+		s.recentReorgs = s.recentReorgs[len(s.recentReorgs)-maxRecentReorgs:]
+	}
+}
+
+// recordInsertedBlock records the last inserted L2 block.
+func (s *Syncer) recordInsertedBlock(block *InsertedBlock) {
+	s.statusMutex.Lock()
+	defer s.statusMutex.Unlock()
+
+	s.lastInsertedBlock = block
+}
@@ -0,0 +1,65 @@
+package calldata
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/driver/journal"
+	txListValidator "github.com/taikoxyz/taiko-client/pkg/txlistvalidator"
+)
+
+func TestRecordReorg(t *testing.T) {
+	reorgJournal, err := journal.New(memorydb.New())
+	require.Nil(t, err)
+
+	s := &Syncer{lastInsertedBlockID: common.Big2, reorgJournal: reorgJournal}
+
+	for i := 0; i < maxRecentReorgs+2; i++ {
+		s.recordReorg(&journal.ReorgEvent{
+			Trigger:                journal.TriggerL1OriginMismatch,
+			L1CurrentHeightOld:     big.NewInt(int64(i + 1)),
+			L1CurrentHeightNew:     big.NewInt(int64(i)),
+			LastInsertedBlockIDNew: common.Big1,
+		})
+	}
+
+	reorgs := s.RecentReorgs()
+	require.Len(t, reorgs, maxRecentReorgs)
+	require.Equal(t, big.NewInt(3), reorgs[0].L1CurrentHeightOld)
+	require.Equal(t, big.NewInt(maxRecentReorgs+1), reorgs[maxRecentReorgs-1].L1CurrentHeightNew)
+	require.Equal(t, common.Big2, reorgs[0].LastInsertedBlockIDOld)
+	require.Equal(t, common.Big1, reorgs[0].LastInsertedBlockIDNew)
+	require.NotZero(t, reorgs[0].DetectedAt)
+
+	// All the reorgs are kept in the journal.
+	events, err := reorgJournal.Events(0, maxRecentReorgs+2)
+	require.Nil(t, err)
+	require.Len(t, events, maxRecentReorgs+2)
+	require.Equal(t, uint64(2), reorgs[0].Seq)
+}
+
+func TestRecordInsertedBlock(t *testing.T) {
+	s := new(Syncer)
+	require.Nil(t, s.LastInsertedBlock())
+
+	s.recordInsertedBlock(&InsertedBlock{
+		BlockID:    common.Big1,
+		Hash:       common.HexToHash("0x01"),
+		TxListHint: txListValidator.HintOK,
+		TxDiagnostics: []*txListValidator.TxDiagnostic{
+			{Index: 1, Hash: common.HexToHash("0x02"), Reason: txListValidator.HintTxFeeCapTooLow},
+		},
+	})
+	require.Equal(t, common.Big1, s.LastInsertedBlock().BlockID)
+
+	// The hint and the diagnostics are published in a readable form.
+	data, err := json.Marshal(s.LastInsertedBlock())
+	require.Nil(t, err)
+	require.Contains(t, string(data), `"txListHint":"ok"`)
+	require.Contains(t, string(data), `"reason":"transaction fee cap too low"`)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -19,18 +20,15 @@ import (
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	anchorTxConstructor "github.com/taikoxyz/taiko-client/driver/anchor_tx_constructor"
 	"github.com/taikoxyz/taiko-client/driver/chain_syncer/beaconsync"
+	"github.com/taikoxyz/taiko-client/driver/journal"
 	"github.com/taikoxyz/taiko-client/driver/state"
 	"github.com/taikoxyz/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-client/pkg/blobsource"
 	eventIterator "github.com/taikoxyz/taiko-client/pkg/chain_iterator/event_iterator"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
 	txListValidator "github.com/taikoxyz/taiko-client/pkg/txlistvalidator"
 )
 
-var (
-	// Brecht recommends to hardcore 79, may be unrequired as proof system changes
-	defaultMaxTxPerBlock = uint64(79)
-)
-
 // Syncer responsible for letting the L2 execution engine catching up with protocol's latest
 // pending block through deriving L1 calldata.
 type Syncer struct {
@@ -40,9 +38,17 @@ type Syncer struct {
 	progressTracker   *beaconsync.SyncProgressTracker          // Sync progress tracker
 	anchorConstructor *anchorTxConstructor.AnchorTxConstructor // TaikoL2.anchor transactions constructor
 	txListValidator   *txListValidator.TxListValidator         // Transactions list validator
+	blobSource        blobsource.BlobSource                    // Blobs source, used by blob proposals
+	blobCache         *blobsource.Cache                        // Blobs cached in protocol for reuse
+	blobExpiry        uint64                                   // Protocol's blob reuse expiry, in seconds
+	reorgJournal      *journal.ReorgJournal                    // Detected L1 reorgs journal, optional
 	// Used by BlockInserter
 	lastInsertedBlockID *big.Int
 	reorgDetectedFlag   bool
+	// Used by status queries
+	recentReorgs      []*journal.ReorgEvent
+	lastInsertedBlock *InsertedBlock
+	statusMutex       sync.RWMutex
 }
 
 // NewSyncer creates a new syncer instance.
@@ -52,6 +58,8 @@ func NewSyncer(
 	state *state.State,
 	progressTracker *beaconsync.SyncProgressTracker,
 	signalServiceAddress common.Address,
+	blobSource blobsource.BlobSource,
+	reorgJournal *journal.ReorgJournal,
 ) (*Syncer, error) {
 	configs, err := rpc.TaikoL1.GetConfig(&bind.CallOpts{Context: ctx})
 	if err != nil {
@@ -71,10 +79,14 @@ func NewSyncer(
 		anchorConstructor: constructor,
 		txListValidator: txListValidator.NewTxListValidator(
 			uint64(configs.BlockMaxGasLimit),
-			defaultMaxTxPerBlock,
+			txListValidator.DefaultMaxTransactionsPerBlock,
 			configs.BlockMaxTxListBytes.Uint64(),
 			rpc.L2ChainID,
 		),
+		blobSource:   blobSource,
+		blobCache:    blobsource.NewCache(),
+		blobExpiry:   configs.BlobExpiry.Uint64(),
+		reorgJournal: reorgJournal,
 	}, nil
 }
 
@@ -103,6 +115,15 @@ func (s *Syncer) ProcessL1Blocks(ctx context.Context, l1End *types.Header) error
 				"l1Head", l1End.Number,
 			)
 
+			s.recordReorg(&journal.ReorgEvent{
+				Trigger:            journal.TriggerL1CurrentAheadOfHead,
+				L1Height:           l1End.Number,
+				L1Hash:             l1End.Hash(),
+				L1CurrentHeightOld: startL1Current.Number,
+				L1CurrentHashOld:   startL1Current.Hash(),
+				L1CurrentHeightNew: newL1Current.Number,
+				L1CurrentHashNew:   newL1Current.Hash(),
+			})
 			s.state.SetL1Current(newL1Current)
 			s.lastInsertedBlockID = nil
 		}
@@ -148,6 +169,7 @@ func (s *Syncer) onBlockProposed(
 			reorged                    bool
 			l1CurrentToReset           *types.Header
 			lastInsertedBlockIDToReset *big.Int
+			trigger                    = journal.TriggerLastVerifiedBlockMismatch
 			err                        error
 		)
 		reorged, err = s.checkLastVerifiedBlockMismatch(ctx)
@@ -165,6 +187,7 @@ func (s *Syncer) onBlockProposed(
 			l1CurrentToReset = genesisL1Header
 			lastInsertedBlockIDToReset = common.Big0
 		} else {
+			trigger = journal.TriggerL1OriginMismatch
 			reorged, l1CurrentToReset, lastInsertedBlockIDToReset, err = s.rpc.CheckL1ReorgFromL2EE(
 				ctx,
 				new(big.Int).Sub(event.BlockId, common.Big1),
@@ -185,6 +208,16 @@ func (s *Syncer) onBlockProposed(
 				"lastInsertedBlockIDOld", s.lastInsertedBlockID,
 				"lastInsertedBlockIDNew", lastInsertedBlockIDToReset,
 			)
+			s.recordReorg(&journal.ReorgEvent{
+				Trigger:                trigger,
+				L1Height:               new(big.Int).SetUint64(event.Raw.BlockNumber),
+				L1Hash:                 event.Raw.BlockHash,
+				L1CurrentHeightOld:     s.state.GetL1Current().Number,
+				L1CurrentHashOld:       s.state.GetL1Current().Hash(),
+				L1CurrentHeightNew:     l1CurrentToReset.Number,
+				L1CurrentHashNew:       l1CurrentToReset.Hash(),
+				LastInsertedBlockIDNew: lastInsertedBlockIDToReset,
+			})
 			s.state.SetL1Current(l1CurrentToReset)
 			s.lastInsertedBlockID = lastInsertedBlockIDToReset
 			s.reorgDetectedFlag = true
@@ -229,28 +262,124 @@ func (s *Syncer) onBlockProposed(
 
 	log.Debug("Parent block", "height", parent.Number, "hash", parent.Hash())
 
+	if event.Meta.Timestamp > uint64(time.Now().Unix()) {
+		log.Warn("Future L2 block, waiting", "L2BlockTimestamp", event.Meta.Timestamp, "now", time.Now().Unix())
+		time.Sleep(time.Until(time.Unix(int64(event.Meta.Timestamp), 0)))
+	}
+
+	payloadData, hint, diagnostics, err := s.InsertBlock(ctx, event, parent, s.state.GetHeadBlockID())
+	if err != nil {
+		return err
+	}
+
+	log.Debug("Payload data", "hash", payloadData.BlockHash, "txs", len(payloadData.Transactions))
+
+	log.Info(
+		"🔗 New L2 block inserted",
+		"blockID", event.BlockId,
+		"height", payloadData.Number,
+		"hash", payloadData.BlockHash,
+		"latestVerifiedBlockID", s.state.GetLatestVerifiedBlock().ID,
+		"latestVerifiedBlockHash", s.state.GetLatestVerifiedBlock().Hash,
+		"transactions", len(payloadData.Transactions),
+		"txListHint", hint,
+		"skippedTransactions", len(diagnostics),
+		"baseFee", payloadData.BaseFeePerGas,
+		"withdrawals", len(payloadData.Withdrawals),
+	)
+
+	metrics.DriverL1CurrentHeightGauge.Update(int64(event.Raw.BlockNumber))
+	s.lastInsertedBlockID = event.BlockId
+	s.recordInsertedBlock(&InsertedBlock{
+		BlockID:       event.BlockId,
+		Hash:          payloadData.BlockHash,
+		L1Height:      event.Raw.BlockNumber,
+		L1Hash:        event.Raw.BlockHash,
+		Transactions:  len(payloadData.Transactions),
+		TxListHint:    hint,
+		TxDiagnostics: diagnostics,
+		InsertedAt:    time.Now(),
+	})
+
+	if s.progressTracker.Triggered() {
+		s.progressTracker.ClearMeta()
+	}
+
+	return nil
+}
+
+// InsertBlock derives the L2 block of the given BlockProposed event from L1, and inserts it on top of the
+// given parent block into the L2 execution engine. The returned hint tells whether the proposed
+// transactions list is valid, and the diagnostics are the transactions the execution engine is expected
+// to skip. It doesn't touch the syncer's state, nor waits for the blocks with future timestamps, so it
+// can also be used to replay the proposed blocks on another L2 execution engine, which must already hold
+// the parent block's state.
+func (s *Syncer) InsertBlock(
+	ctx context.Context,
+	event *bindings.TaikoL1ClientBlockProposed,
+	parent *types.Header,
+	headBlockID *big.Int,
+) (
+	payload *engine.ExecutableData,
+	hint txListValidator.InvalidTxListReason,
+	diagnostics []*txListValidator.TxDiagnostic,
+	err error,
+) {
 	tx, err := s.rpc.L1.TransactionInBlock(
 		ctx,
 		event.Raw.BlockHash,
 		event.Raw.TxIndex,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to fetch original TaikoL1.proposeBlock transaction: %w", err)
+		return nil, txListValidator.HintNone, nil, fmt.Errorf(
+			"failed to fetch original TaikoL1.proposeBlock transaction: %w",
+			err,
+		)
 	}
 
-	// Check whether the transactions list is valid.
-	txListBytes, hint, invalidTxIndex, err := s.txListValidator.ValidateTxList(event.BlockId, tx.Data())
+	baseFee, err := s.getBasefee(ctx, event, parent)
 	if err != nil {
-		return fmt.Errorf("failed to validate transactions list: %w", err)
+		return nil, txListValidator.HintNone, nil, err
+	}
+
+	// Check whether the transactions list is valid.
+	var txListBytes []byte
+	if event.Meta.BlobUsed {
+		blob, err := s.fetchProposedBlob(ctx, event, tx)
+		if err != nil {
+			return nil, txListValidator.HintNone, nil, fmt.Errorf("failed to fetch blob: %w", err)
+		}
+
+		if txListBytes, hint = txListFromBlob(
+			event.BlockId,
+			blob,
+			event.Meta.TxListByteOffset,
+			event.Meta.TxListByteSize,
+		); hint == txListValidator.HintOK {
+			hint = s.txListValidator.ValidateTxListBytes(event.BlockId, txListBytes)
+		}
+	} else {
+		if txListBytes, hint, err = s.txListValidator.ValidateTxList(
+			event.BlockId,
+			tx.Data(),
+		); err != nil {
+			return nil, txListValidator.HintNone, nil, fmt.Errorf("failed to validate transactions list: %w", err)
+		}
 	}
 
 	log.Info(
 		"Validate transactions list",
 		"blockID", event.BlockId,
+		"blobUsed", event.Meta.BlobUsed,
 		"hint", hint,
-		"invalidTxIndex", invalidTxIndex,
 	)
 
+	// The invalid transactions in a valid list are skipped by the execution engine one at a time,
+	// they are only diagnosed here.
+	if hint == txListValidator.HintOK {
+		diagnostics = s.txListValidator.DiagnoseTxList(event.BlockId, txListBytes, baseFee)
+	}
+
 	l1Origin := &rawdb.L1Origin{
 		BlockID:       event.BlockId,
 		L2BlockHash:   common.Hash{}, // Will be set by taiko-geth.
@@ -258,51 +387,30 @@ func (s *Syncer) onBlockProposed(
 		L1BlockHash:   event.Raw.BlockHash,
 	}
 
-	if event.Meta.Timestamp > uint64(time.Now().Unix()) {
-		log.Warn("Future L2 block, waiting", "L2BlockTimestamp", event.Meta.Timestamp, "now", time.Now().Unix())
-		time.Sleep(time.Until(time.Unix(int64(event.Meta.Timestamp), 0)))
-	}
-
 	// If the transactions list is invalid, we simply insert an empty L2 block.
 	if hint != txListValidator.HintOK {
-		log.Info("Invalid transactions list, insert an empty L2 block instead", "blockID", event.BlockId)
+		log.Info(
+			"Invalid transactions list, insert an empty L2 block instead",
+			"blockID", event.BlockId,
+			"hint", hint,
+		)
 		txListBytes = []byte{}
 	}
 
-	payloadData, err := s.insertNewHead(
+	payload, err = s.insertNewHead(
 		ctx,
 		event,
 		parent,
-		s.state.GetHeadBlockID(),
+		headBlockID,
 		txListBytes,
+		baseFee,
 		l1Origin,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to insert new head to L2 execution engine: %w", err)
-	}
-
-	log.Debug("Payload data", "hash", payloadData.BlockHash, "txs", len(payloadData.Transactions))
-
-	log.Info(
-		"🔗 New L2 block inserted",
-		"blockID", event.BlockId,
-		"height", payloadData.Number,
-		"hash", payloadData.BlockHash,
-		"latestVerifiedBlockID", s.state.GetLatestVerifiedBlock().ID,
-		"latestVerifiedBlockHash", s.state.GetLatestVerifiedBlock().Hash,
-		"transactions", len(payloadData.Transactions),
-		"baseFee", payloadData.BaseFeePerGas,
-		"withdrawals", len(payloadData.Withdrawals),
-	)
-
-	metrics.DriverL1CurrentHeightGauge.Update(int64(event.Raw.BlockNumber))
-	s.lastInsertedBlockID = event.BlockId
-
-	if s.progressTracker.Triggered() {
-		s.progressTracker.ClearMeta()
+		return nil, txListValidator.HintNone, nil, fmt.Errorf("failed to insert new head to L2 execution engine: %w", err)
 	}
 
-	return nil
+	return payload, hint, diagnostics, nil
 }
 
 // insertNewHead tries to insert a new head block to the L2 execution engine's local
@@ -313,6 +421,7 @@ func (s *Syncer) insertNewHead(
 	parent *types.Header,
 	headBlockID *big.Int,
 	txListBytes []byte,
+	baseFee *big.Int,
 	l1Origin *rawdb.L1Origin,
 ) (*engine.ExecutableData, error) {
 	log.Debug(
@@ -332,24 +441,6 @@ func (s *Syncer) insertNewHead(
 		}
 	}
 
-	// Get L2 baseFee
-	baseFee, err := s.rpc.TaikoL2.GetBasefee(
-		&bind.CallOpts{BlockNumber: parent.Number, Context: ctx},
-		event.Meta.L1Height,
-		uint32(parent.GasUsed),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get L2 baseFee: %w", encoding.TryParsingCustomError(err))
-	}
-
-	log.Info(
-		"L2 baseFee",
-		"blockID", event.BlockId,
-		"baseFee", baseFee,
-		"syncedL1Height", event.Meta.L1Height,
-		"parentGasUsed", parent.GasUsed,
-	)
-
 	// Get withdrawals
 	withdrawals := make(types.Withdrawals, len(event.DepositsProcessed))
 	for i, d := range event.DepositsProcessed {
@@ -404,6 +495,32 @@ func (s *Syncer) insertNewHead(
 	return payload, nil
 }
 
+// getBasefee fetches the L2 base fee of the given proposed block from the TaikoL2 contract.
+func (s *Syncer) getBasefee(
+	ctx context.Context,
+	event *bindings.TaikoL1ClientBlockProposed,
+	parent *types.Header,
+) (*big.Int, error) {
+	baseFee, err := s.rpc.TaikoL2.GetBasefee(
+		&bind.CallOpts{BlockNumber: parent.Number, Context: ctx},
+		event.Meta.L1Height,
+		uint32(parent.GasUsed),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L2 baseFee: %w", encoding.TryParsingCustomError(err))
+	}
+
+	log.Info(
+		"L2 baseFee",
+		"blockID", event.BlockId,
+		"baseFee", baseFee,
+		"syncedL1Height", event.Meta.L1Height,
+		"parentGasUsed", parent.GasUsed,
+	)
+
+	return baseFee, nil
+}
+
 // createExecutionPayloads creates a new execution payloads through
 // Engine APIs.
 func (s *Syncer) createExecutionPayloads(
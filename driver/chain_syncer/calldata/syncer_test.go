@@ -39,6 +39,8 @@ func (s *CalldataSyncerTestSuite) SetupTest() {
 		state,
 		beaconsync.NewSyncProgressTracker(s.RPCClient.L2, 1*time.Hour),
 		common.HexToAddress(os.Getenv("L1_SIGNAL_SERVICE_CONTRACT_ADDRESS")),
+		nil,
+		nil,
 	)
 	s.Nil(err)
 	s.s = syncer
@@ -81,6 +83,8 @@ func (s *CalldataSyncerTestSuite) TestCancelNewSyncer() {
 		s.s.state,
 		s.s.progressTracker,
 		common.HexToAddress(os.Getenv("L1_SIGNAL_SERVICE_CONTRACT_ADDRESS")),
+		nil,
+		nil,
 	)
 	s.Nil(syncer)
 	s.NotNil(err)
@@ -117,24 +121,28 @@ func (s *CalldataSyncerTestSuite) TestInsertNewHead() {
 	s.Nil(err)
 	l1Head, err := s.s.rpc.L1.BlockByNumber(context.Background(), nil)
 	s.Nil(err)
+	event := &bindings.TaikoL1ClientBlockProposed{
+		BlockId: common.Big1,
+		Meta: bindings.TaikoDataBlockMetadata{
+			Id:         1,
+			L1Height:   l1Head.NumberU64(),
+			L1Hash:     l1Head.Hash(),
+			Coinbase:   common.BytesToAddress(testutils.RandomBytes(1024)),
+			BlobHash:   testutils.RandomHash(),
+			Difficulty: testutils.RandomHash(),
+			GasLimit:   utils.RandUint32(nil),
+			Timestamp:  uint64(time.Now().Unix()),
+		},
+	}
+	baseFee, err := s.s.getBasefee(context.Background(), event, parent)
+	s.Nil(err)
 	_, err = s.s.insertNewHead(
 		context.Background(),
-		&bindings.TaikoL1ClientBlockProposed{
-			BlockId: common.Big1,
-			Meta: bindings.TaikoDataBlockMetadata{
-				Id:         1,
-				L1Height:   l1Head.NumberU64(),
-				L1Hash:     l1Head.Hash(),
-				Coinbase:   common.BytesToAddress(testutils.RandomBytes(1024)),
-				BlobHash:   testutils.RandomHash(),
-				Difficulty: testutils.RandomHash(),
-				GasLimit:   utils.RandUint32(nil),
-				Timestamp:  uint64(time.Now().Unix()),
-			},
-		},
+		event,
 		parent,
 		common.Big2,
 		[]byte{},
+		baseFee,
 		&rawdb.L1Origin{
 			BlockID:       common.Big1,
 			L1BlockHeight: common.Big1,
@@ -11,7 +11,9 @@ import (
 
 	"github.com/taikoxyz/taiko-client/driver/chain_syncer/beaconsync"
 	"github.com/taikoxyz/taiko-client/driver/chain_syncer/calldata"
+	"github.com/taikoxyz/taiko-client/driver/journal"
 	"github.com/taikoxyz/taiko-client/driver/state"
+	"github.com/taikoxyz/taiko-client/pkg/blobsource"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
 )
 
@@ -42,12 +44,22 @@ func New(
 	p2pSyncVerifiedBlocks bool,
 	p2pSyncTimeout time.Duration,
 	signalServiceAddress common.Address,
+	blobSource blobsource.BlobSource,
+	reorgJournal *journal.ReorgJournal,
 ) (*L2ChainSyncer, error) {
 	tracker := beaconsync.NewSyncProgressTracker(rpc.L2, p2pSyncTimeout)
 	go tracker.Track(ctx)
 
 	beaconSyncer := beaconsync.NewSyncer(ctx, rpc, state, tracker)
-	calldataSyncer, err := calldata.NewSyncer(ctx, rpc, state, tracker, signalServiceAddress)
+	calldataSyncer, err := calldata.NewSyncer(
+		ctx,
+		rpc,
+		state,
+		tracker,
+		signalServiceAddress,
+		blobSource,
+		reorgJournal,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -157,3 +169,8 @@ func (s *L2ChainSyncer) BeaconSyncer() *beaconsync.Syncer {
 func (s *L2ChainSyncer) CalldataSyncer() *calldata.Syncer {
 	return s.calldataSyncer
 }
+
+// ProgressTracker returns the beacon sync progress tracker.
+func (s *L2ChainSyncer) ProgressTracker() *beaconsync.SyncProgressTracker {
+	return s.progressTracker
+}
@@ -19,6 +19,10 @@ type Config struct {
 	P2PSyncVerifiedBlocks bool
 	P2PSyncTimeout        time.Duration
 	RPCTimeout            time.Duration
+	L1BeaconEndpoint      string
+	BlobArchiveDir        string
+	HTTPServerPort        uint64
+	ReorgJournalPath      string
 }
 
 // NewConfigFromCliContext creates a new config instance from
@@ -41,18 +45,30 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 	var timeout = c.Duration(flags.RPCTimeout.Name)
 	return &Config{
 		ClientConfig: &rpc.ClientConfig{
-			L1Endpoint:       c.String(flags.L1WSEndpoint.Name),
-			L2Endpoint:       c.String(flags.L2WSEndpoint.Name),
-			L2CheckPoint:     l2CheckPoint,
-			TaikoL1Address:   common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
-			TaikoL2Address:   common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
-			L2EngineEndpoint: c.String(flags.L2AuthEndpoint.Name),
-			JwtSecret:        string(jwtSecret),
-			RetryInterval:    c.Duration(flags.BackOffRetryInterval.Name),
-			Timeout:          timeout,
+			L1Endpoint:          c.String(flags.L1WSEndpoint.Name),
+			L1FallbackEndpoints: c.StringSlice(flags.L1FallbackEndpoints.Name),
+			L2Endpoint:          c.String(flags.L2WSEndpoint.Name),
+			L2FallbackEndpoints: c.StringSlice(flags.L2FallbackEndpoints.Name),
+			L2CheckPoint:        l2CheckPoint,
+			TaikoL1Address:      common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
+			TaikoL2Address:      common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
+			L2EngineEndpoint:    c.String(flags.L2AuthEndpoint.Name),
+			JwtSecret:           string(jwtSecret),
+			RetryInterval:       c.Duration(flags.BackOffRetryInterval.Name),
+			Timeout:             timeout,
+			HealthCheck: &rpc.HealthCheckConfig{
+				Interval:     c.Duration(flags.RPCHealthCheckInterval.Name),
+				MaxHeadLag:   c.Uint64(flags.RPCMaxHeadLag.Name),
+				MaxLatency:   c.Duration(flags.RPCMaxLatency.Name),
+				MaxErrorRate: c.Float64(flags.RPCMaxErrorRate.Name),
+			},
 		},
 		P2PSyncVerifiedBlocks: p2pSyncVerifiedBlocks,
 		P2PSyncTimeout:        c.Duration(flags.P2PSyncTimeout.Name),
 		RPCTimeout:            timeout,
+		L1BeaconEndpoint:      c.String(flags.L1BeaconEndpoint.Name),
+		BlobArchiveDir:        c.String(flags.BlobArchiveDir.Name),
+		HTTPServerPort:        c.Uint64(flags.DriverHTTPServerPort.Name),
+		ReorgJournalPath:      c.String(flags.ReorgJournalPath.Name),
 	}, nil
 }
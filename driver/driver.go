@@ -2,6 +2,9 @@ package driver
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
 	"sync"
 	"time"
 
@@ -11,11 +14,16 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/leveldb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 
 	chainSyncer "github.com/taikoxyz/taiko-client/driver/chain_syncer"
+	"github.com/taikoxyz/taiko-client/driver/journal"
+	"github.com/taikoxyz/taiko-client/driver/server"
 	"github.com/taikoxyz/taiko-client/driver/state"
+	"github.com/taikoxyz/taiko-client/pkg/blobsource"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
 	"github.com/urfave/cli/v2"
 )
@@ -32,6 +40,10 @@ type Driver struct {
 	rpc           *rpc.Client
 	l2ChainSyncer *chainSyncer.L2ChainSyncer
 	state         *state.State
+	srv           *server.DriverServer
+
+	reorgJournal   *journal.ReorgJournal
+	reorgJournalDB ethdb.KeyValueStore
 
 	l1HeadCh   chan *types.Header
 	l1HeadSub  event.Subscription
@@ -85,6 +97,26 @@ func (d *Driver) InitFromConfig(ctx context.Context, cfg *Config) (err error) {
 		return err
 	}
 
+	if cfg.ReorgJournalPath != "" {
+		if d.reorgJournalDB, err = leveldb.New(
+			cfg.ReorgJournalPath,
+			16,
+			16, // Minimum number of files handles is 16 in leveldb.
+			"taiko",
+			false,
+		); err != nil {
+			return err
+		}
+		if d.reorgJournal, err = journal.New(d.reorgJournalDB); err != nil {
+			return err
+		}
+	}
+
+	blobSource, err := blobsource.New(cfg.BlobArchiveDir, cfg.L1BeaconEndpoint, cfg.RPCTimeout)
+	if err != nil {
+		return err
+	}
+
 	if d.l2ChainSyncer, err = chainSyncer.New(
 		d.ctx,
 		d.rpc,
@@ -92,12 +124,24 @@ func (d *Driver) InitFromConfig(ctx context.Context, cfg *Config) (err error) {
 		cfg.P2PSyncVerifiedBlocks,
 		cfg.P2PSyncTimeout,
 		signalServiceAddress,
+		blobSource,
+		d.reorgJournal,
 	); err != nil {
 		return err
 	}
 
 	d.l1HeadSub = d.state.SubL1HeadsFeed(d.l1HeadCh)
 
+	if cfg.HTTPServerPort != 0 {
+		if d.srv, err = server.New(&server.NewDriverServerOpts{
+			State:        d.state,
+			ChainSyncer:  d.l2ChainSyncer,
+			ReorgJournal: d.reorgJournal,
+		}); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -108,14 +152,33 @@ func (d *Driver) Start() error {
 	go d.reportProtocolStatus()
 	go d.exchangeTransitionConfigLoop()
 
+	if d.srv != nil {
+		go func() {
+			if err := d.srv.Start(fmt.Sprintf(":%v", d.HTTPServerPort)); !errors.Is(err, http.ErrServerClosed) {
+				log.Crit("Failed to start http server", "error", err)
+			}
+		}()
+	}
+
 	return nil
 }
 
 // Close closes the driver instance.
 func (d *Driver) Close(ctx context.Context) {
+	if d.srv != nil {
+		if err := d.srv.Shutdown(ctx); err != nil {
+			log.Error("Failed to shut down http server", "error", err)
+		}
+	}
 	d.l1HeadSub.Unsubscribe()
 	d.state.Close()
 	d.wg.Wait()
+
+	if d.reorgJournalDB != nil {
+		if err := d.reorgJournalDB.Close(); err != nil {
+			log.Error("Failed to close reorg journal db", "error", err)
+		}
+	}
 }
 
 // eventLoop starts the main loop of a L2 execution engine's driver.
@@ -0,0 +1,154 @@
+package journal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+var (
+	reorgKeyPrefix  = "reorg"
+	nextSeqKey      = "reorgNextSeq"
+	separator       = "++"
+	defaultMaxQuery = 100
+)
+
+// ReorgTrigger is the check which detected a L1 reorg.
+type ReorgTrigger string
+
+// All L1 reorg triggers.
+const (
+	// TriggerL1CurrentAheadOfHead means the L1Current cursor is not lower than the new L1 head, but
+	// has a different hash.
+	TriggerL1CurrentAheadOfHead ReorgTrigger = "l1CurrentAheadOfHead"
+	// TriggerLastVerifiedBlockMismatch means the protocol's last verified block hash mismatches the
+	// L2 execution engine's one.
+	TriggerLastVerifiedBlockMismatch ReorgTrigger = "lastVerifiedBlockMismatch"
+	// TriggerL1OriginMismatch means the L1 origin of the parent block in L2 execution engine is no
+	// longer in the canonical L1 chain.
+	TriggerL1OriginMismatch ReorgTrigger = "l1OriginMismatch"
+)
+
+// ReorgEvent is a L1 reorg detected by the driver, which resets the L1Current cursor.
+type ReorgEvent struct {
+	Seq                    uint64       `json:"seq"`
+	Trigger                ReorgTrigger `json:"trigger"`
+	DetectedAt             time.Time    `json:"detectedAt"`
+	L1Height               *big.Int     `json:"l1Height"`
+	L1Hash                 common.Hash  `json:"l1Hash"`
+	L1CurrentHeightOld     *big.Int     `json:"l1CurrentHeightOld"`
+	L1CurrentHashOld       common.Hash  `json:"l1CurrentHashOld"`
+	L1CurrentHeightNew     *big.Int     `json:"l1CurrentHeightNew"`
+	L1CurrentHashNew       common.Hash  `json:"l1CurrentHashNew"`
+	LastInsertedBlockIDOld *big.Int     `json:"lastInsertedBlockIDOld"`
+	LastInsertedBlockIDNew *big.Int     `json:"lastInsertedBlockIDNew"`
+}
+
+// Depth returns the number of L1 blocks the L1Current cursor is rewound by the reorg.
+func (e *ReorgEvent) Depth() uint64 {
+	if e.L1CurrentHeightOld == nil || e.L1CurrentHeightNew == nil ||
+		e.L1CurrentHeightOld.Cmp(e.L1CurrentHeightNew) <= 0 {
+		return 0
+	}
+
+	return new(big.Int).Sub(e.L1CurrentHeightOld, e.L1CurrentHeightNew).Uint64()
+}
+
+// ReorgJournal persists the detected L1 reorgs into the given db in order, so that the reorg
+// history can be queried after the fact.
+type ReorgJournal struct {
+	db      ethdb.KeyValueStore
+	nextSeq uint64
+	mutex   sync.Mutex
+}
+
+// New creates a new ReorgJournal instance.
+func New(db ethdb.KeyValueStore) (*ReorgJournal, error) {
+	j := &ReorgJournal{db: db}
+
+	val, err := db.Get([]byte(nextSeqKey))
+	if err == nil {
+		if len(val) != 8 {
+			return nil, fmt.Errorf("invalid reorg journal sequence: %x", val)
+		}
+		j.nextSeq = binary.BigEndian.Uint64(val)
+	}
+
+	return j, nil
+}
+
+// Append appends the given event to the journal, and sets its sequence number.
+func (j *ReorgJournal) Append(e *ReorgEvent) error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	e.Seq = j.nextSeq
+
+	val, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	batch := j.db.NewBatch()
+	if err := batch.Put(buildReorgKey(e.Seq), val); err != nil {
+		return err
+	}
+	if err := batch.Put([]byte(nextSeqKey), binary.BigEndian.AppendUint64(nil, e.Seq+1)); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	j.nextSeq++
+
+	return nil
+}
+
+// Events returns at most limit events in the journal, starting from the given sequence number,
+// a non-positive limit means the default limit.
+func (j *ReorgJournal) Events(from uint64, limit int) ([]*ReorgEvent, error) {
+	return Events(j.db, from, limit)
+}
+
+// Events reads at most limit events from the given journal db, starting from the given sequence
+// number, a non-positive limit means the default limit.
+func Events(db ethdb.Iteratee, from uint64, limit int) ([]*ReorgEvent, error) {
+	if limit <= 0 {
+		limit = defaultMaxQuery
+	}
+
+	var (
+		prefix = []byte(reorgKeyPrefix + separator)
+		iter   = db.NewIterator(prefix, binary.BigEndian.AppendUint64(nil, from))
+		events []*ReorgEvent
+	)
+	defer iter.Release()
+
+	for iter.Next() && len(events) < limit {
+		e := new(ReorgEvent)
+		if err := json.Unmarshal(iter.Value(), e); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal reorg event %x: %w", iter.Key(), err)
+		}
+		events = append(events, e)
+	}
+
+	return events, iter.Error()
+}
+
+// buildReorgKey builds the db key of the reorg event with the given sequence number, the
+// sequence number is big-endian encoded so that the events are iterated in order.
+func buildReorgKey(seq uint64) []byte {
+	return bytes.Join(
+		[][]byte{
+			[]byte(reorgKeyPrefix),
+			binary.BigEndian.AppendUint64(nil, seq),
+		}, []byte(separator))
+}
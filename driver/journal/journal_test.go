@@ -0,0 +1,60 @@
+package journal
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReorgJournal(t *testing.T) {
+	db := memorydb.New()
+	j, err := New(db)
+	require.Nil(t, err)
+
+	for i := 0; i < 300; i++ {
+		require.Nil(t, j.Append(&ReorgEvent{
+			Trigger:            TriggerL1CurrentAheadOfHead,
+			L1CurrentHeightOld: big.NewInt(int64(i + 10)),
+			L1CurrentHashOld:   common.BigToHash(big.NewInt(int64(i))),
+			L1CurrentHeightNew: big.NewInt(int64(i)),
+		}))
+	}
+
+	// Default limit.
+	events, err := j.Events(0, 0)
+	require.Nil(t, err)
+	require.Len(t, events, defaultMaxQuery)
+	for i, e := range events {
+		require.Equal(t, uint64(i), e.Seq)
+	}
+
+	// The events are iterated in order across byte boundaries of the sequence numbers.
+	events, err = j.Events(250, 10)
+	require.Nil(t, err)
+	require.Len(t, events, 10)
+	require.Equal(t, uint64(250), events[0].Seq)
+	require.Equal(t, uint64(259), events[9].Seq)
+	require.Equal(t, uint64(10), events[0].Depth())
+	require.Equal(t, TriggerL1CurrentAheadOfHead, events[0].Trigger)
+
+	// The sequence number is restored after reopening.
+	j, err = New(db)
+	require.Nil(t, err)
+	e := &ReorgEvent{Trigger: TriggerL1OriginMismatch}
+	require.Nil(t, j.Append(e))
+	require.Equal(t, uint64(300), e.Seq)
+
+	events, err = j.Events(300, 10)
+	require.Nil(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, TriggerL1OriginMismatch, events[0].Trigger)
+}
+
+func TestReorgEventDepth(t *testing.T) {
+	require.Zero(t, new(ReorgEvent).Depth())
+	require.Zero(t, (&ReorgEvent{L1CurrentHeightOld: common.Big1, L1CurrentHeightNew: common.Big2}).Depth())
+	require.Equal(t, uint64(1), (&ReorgEvent{L1CurrentHeightOld: common.Big2, L1CurrentHeightNew: common.Big1}).Depth())
+}
@@ -0,0 +1,43 @@
+package journal
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+
+	"github.com/ethereum/go-ethereum/ethdb/leveldb"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-client/cmd/logger"
+)
+
+// QueryAction prints the reorg events in the journal given by the command line flags as JSON lines.
+func QueryAction(c *cli.Context) error {
+	logger.InitLogger(c)
+
+	path := c.String(flags.ReorgJournalPath.Name)
+	if path == "" {
+		return errors.New("empty reorg journal path")
+	}
+
+	db, err := leveldb.New(path, 16, 16, "taiko", true)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	events, err := Events(db, c.Uint64(flags.ReorgJournalFrom.Name), c.Int(flags.ReorgJournalLimit.Name))
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	for _, e := range events {
+		if err := encoder.Encode(e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/labstack/echo/v4"
+
+	"github.com/taikoxyz/taiko-client/driver/chain_syncer/calldata"
+	"github.com/taikoxyz/taiko-client/driver/journal"
+)
+
+var errNoReorgJournal = errors.New("reorg journal not enabled")
+
+// BlockInfo is the number and hash of a block.
+type BlockInfo struct {
+	Number *big.Int    `json:"number"`
+	Hash   common.Hash `json:"hash"`
+}
+
+// VerifiedBlockInfo is the ID and hash of the latest verified L2 block.
+type VerifiedBlockInfo struct {
+	ID   *big.Int    `json:"id"`
+	Hash common.Hash `json:"hash"`
+}
+
+// BeaconSyncStatus is the status of the P2P beacon sync in the L2 execution engine.
+type BeaconSyncStatus struct {
+	Triggered                 bool     `json:"triggered"`
+	OutOfSync                 bool     `json:"outOfSync"`
+	LastSyncedVerifiedBlockID *big.Int `json:"lastSyncedVerifiedBlockID"`
+}
+
+// Status represents the current driver status.
+type Status struct {
+	Synced              bool                    `json:"synced"`
+	L1Head              *BlockInfo              `json:"l1Head"`
+	L1Current           *BlockInfo              `json:"l1Current"`
+	L2Head              *BlockInfo              `json:"l2Head"`
+	HeadBlockID         *big.Int                `json:"headBlockID"`
+	LatestVerifiedBlock *VerifiedBlockInfo      `json:"latestVerifiedBlock"`
+	BeaconSync          *BeaconSyncStatus       `json:"beaconSync"`
+	RecentReorgs        []*journal.ReorgEvent   `json:"recentReorgs"`
+	LastInsertedBlock   *calldata.InsertedBlock `json:"lastInsertedBlock"`
+}
+
+// SyncedResponse tells whether the driver is synced.
+type SyncedResponse struct {
+	Synced bool `json:"synced"`
+}
+
+// API is the JSON-RPC API of the driver status server, served under the "driver" namespace.
+type API struct {
+	srv *DriverServer
+}
+
+// Status returns the current driver status.
+func (api *API) Status() *Status {
+	return api.srv.status()
+}
+
+// Synced returns whether the driver is synced.
+func (api *API) Synced() bool {
+	return api.srv.status().Synced
+}
+
+// Reorgs returns at most limit reorg events in the journal, starting from the given sequence number.
+func (api *API) Reorgs(from uint64, limit int) ([]*journal.ReorgEvent, error) {
+	if api.srv.reorgJournal == nil {
+		return nil, errNoReorgJournal
+	}
+
+	return api.srv.reorgJournal.Events(from, limit)
+}
+
+// GetStatus handles a query to the current driver status.
+func (srv *DriverServer) GetStatus(c echo.Context) error {
+	return c.JSON(http.StatusOK, srv.status())
+}
+
+// GetSynced handles a query to whether the driver is synced, responds with 503 if it's not, so that
+// it can be used by load balancers directly.
+func (srv *DriverServer) GetSynced(c echo.Context) error {
+	if !srv.status().Synced {
+		return c.JSON(http.StatusServiceUnavailable, &SyncedResponse{Synced: false})
+	}
+
+	return c.JSON(http.StatusOK, &SyncedResponse{Synced: true})
+}
+
+// ReorgsQuery is the query parameters of a reorg events query.
+type ReorgsQuery struct {
+	From  uint64 `query:"from"`
+	Limit int    `query:"limit"`
+}
+
+// GetReorgs handles a query to the reorg events in the journal, starting from the given sequence
+// number, responds with 404 if the reorg journal is not enabled.
+func (srv *DriverServer) GetReorgs(c echo.Context) error {
+	if srv.reorgJournal == nil {
+		return echo.NewHTTPError(http.StatusNotFound, errNoReorgJournal.Error())
+	}
+
+	query := new(ReorgsQuery)
+	if err := c.Bind(query); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	events, err := srv.reorgJournal.Events(query.From, query.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, events)
+}
+
+// status collects the current driver status.
+func (srv *DriverServer) status() *Status {
+	var (
+		calldataSyncer = srv.chainSyncer.CalldataSyncer()
+		tracker        = srv.chainSyncer.ProgressTracker()
+		verified       = srv.state.GetLatestVerifiedBlock()
+		status         = &Status{
+			L1Head:              newBlockInfo(srv.state.GetL1Head()),
+			L1Current:           newBlockInfo(srv.state.GetL1Current()),
+			L2Head:              newBlockInfo(srv.state.GetL2Head()),
+			HeadBlockID:         srv.state.GetHeadBlockID(),
+			LatestVerifiedBlock: &VerifiedBlockInfo{ID: verified.ID, Hash: verified.Hash},
+			BeaconSync: &BeaconSyncStatus{
+				Triggered:                 tracker.Triggered(),
+				OutOfSync:                 tracker.OutOfSync(),
+				LastSyncedVerifiedBlockID: tracker.LastSyncedVerifiedBlockID(),
+			},
+			RecentReorgs:      calldataSyncer.RecentReorgs(),
+			LastInsertedBlock: calldataSyncer.LastInsertedBlock(),
+		}
+	)
+	status.Synced = isSynced(status)
+
+	return status
+}
+
+// isSynced checks whether the L2 execution engine has inserted all the blocks proposed in the protocol,
+// and no beacon sync is in progress.
+func isSynced(status *Status) bool {
+	if status.BeaconSync.Triggered || status.L2Head == nil || status.HeadBlockID == nil {
+		return false
+	}
+
+	return status.L2Head.Number.Cmp(status.HeadBlockID) >= 0
+}
+
+// newBlockInfo creates a new BlockInfo from the given header, returns nil if the header is nil.
+func newBlockInfo(header *types.Header) *BlockInfo {
+	if header == nil {
+		return nil
+	}
+
+	return &BlockInfo{Number: header.Number, Hash: header.Hash()}
+}
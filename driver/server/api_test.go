@@ -0,0 +1,69 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/driver/journal"
+)
+
+func TestIsSynced(t *testing.T) {
+	status := &Status{
+		L2Head:      newBlockInfo(&types.Header{Number: common.Big2}),
+		HeadBlockID: common.Big2,
+		BeaconSync:  &BeaconSyncStatus{},
+	}
+	require.True(t, isSynced(status))
+
+	status.HeadBlockID = common.Big3
+	require.False(t, isSynced(status))
+
+	status.HeadBlockID = common.Big1
+	status.BeaconSync.Triggered = true
+	require.False(t, isSynced(status))
+}
+
+func TestNewBlockInfo(t *testing.T) {
+	require.Nil(t, newBlockInfo(nil))
+
+	header := &types.Header{Number: common.Big1}
+	info := newBlockInfo(header)
+	require.Equal(t, common.Big1, info.Number)
+	require.Equal(t, header.Hash(), info.Hash)
+}
+
+func TestGetReorgs(t *testing.T) {
+	srv, err := New(&NewDriverServerOpts{})
+	require.Nil(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/reorgs", nil)
+	rec := httptest.NewRecorder()
+	srv.echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	reorgJournal, err := journal.New(memorydb.New())
+	require.Nil(t, err)
+	for i := 0; i < 3; i++ {
+		require.Nil(t, reorgJournal.Append(&journal.ReorgEvent{Trigger: journal.TriggerL1CurrentAheadOfHead}))
+	}
+
+	srv, err = New(&NewDriverServerOpts{ReorgJournal: reorgJournal})
+	require.Nil(t, err)
+
+	req = httptest.NewRequest(http.MethodGet, "/reorgs?from=1&limit=1", nil)
+	rec = httptest.NewRecorder()
+	srv.echo.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var events []*journal.ReorgEvent
+	require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &events))
+	require.Len(t, events, 1)
+	require.Equal(t, uint64(1), events[0].Seq)
+}
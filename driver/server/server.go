@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+
+	chainSyncer "github.com/taikoxyz/taiko-client/driver/chain_syncer"
+	"github.com/taikoxyz/taiko-client/driver/journal"
+	"github.com/taikoxyz/taiko-client/driver/state"
+)
+
+// DriverServer represents a driver status server instance, which serves the driver's live sync
+// status through both HTTP and JSON-RPC.
+type DriverServer struct {
+	echo         *echo.Echo
+	rpcServer    *gethRPC.Server
+	state        *state.State
+	chainSyncer  *chainSyncer.L2ChainSyncer
+	reorgJournal *journal.ReorgJournal
+}
+
+// NewDriverServerOpts contains all configurations for creating a driver status server instance.
+type NewDriverServerOpts struct {
+	State        *state.State
+	ChainSyncer  *chainSyncer.L2ChainSyncer
+	ReorgJournal *journal.ReorgJournal
+}
+
+// New creates a new driver status server instance.
+func New(opts *NewDriverServerOpts) (*DriverServer, error) {
+	srv := &DriverServer{
+		echo:         echo.New(),
+		rpcServer:    gethRPC.NewServer(),
+		state:        opts.State,
+		chainSyncer:  opts.ChainSyncer,
+		reorgJournal: opts.ReorgJournal,
+	}
+
+	if err := srv.rpcServer.RegisterName("driver", &API{srv}); err != nil {
+		return nil, err
+	}
+
+	srv.echo.HideBanner = true
+	srv.configureMiddleware()
+	srv.configureRoutes()
+
+	return srv, nil
+}
+
+// Start starts the HTTP server.
+func (srv *DriverServer) Start(address string) error {
+	return srv.echo.Start(address)
+}
+
+// Shutdown shuts down the HTTP server.
+func (srv *DriverServer) Shutdown(ctx context.Context) error {
+	srv.rpcServer.Stop()
+	return srv.echo.Shutdown(ctx)
+}
+
+// Health endpoints for probes.
+func (srv *DriverServer) Health(c echo.Context) error {
+	return c.NoContent(http.StatusOK)
+}
+
+// LogSkipper implements the `middleware.Skipper` interface.
+func LogSkipper(c echo.Context) bool {
+	switch c.Request().URL.Path {
+	case "/healthz", "/synced":
+		return true
+	default:
+		return false
+	}
+}
+
+// configureMiddleware configures the server middlewares.
+func (srv *DriverServer) configureMiddleware() {
+	srv.echo.Use(middleware.RequestID())
+
+	srv.echo.Use(middleware.LoggerWithConfig(middleware.LoggerConfig{
+		Skipper: LogSkipper,
+		Format: `{"time":"${time_rfc3339_nano}","level":"INFO","message":{"id":"${id}","remote_ip":"${remote_ip}",` +
+			`"host":"${host}","method":"${method}","uri":"${uri}","user_agent":"${user_agent}",` +
+			`"response_status":${status},"error":"${error}","latency":${latency},"latency_human":"${latency_human}",` +
+			`"bytes_in":${bytes_in},"bytes_out":${bytes_out}}}` + "\n",
+		Output: os.Stdout,
+	}))
+}
+
+// configureRoutes contains all routes which will be used by driver status server.
+func (srv *DriverServer) configureRoutes() {
+	srv.echo.GET("/healthz", srv.Health)
+	srv.echo.GET("/status", srv.GetStatus)
+	srv.echo.GET("/synced", srv.GetSynced)
+	srv.echo.GET("/reorgs", srv.GetReorgs)
+	srv.echo.POST("/", echo.WrapHandler(srv.rpcServer))
+}
@@ -23,11 +23,42 @@ var (
 	DriverL1CurrentHeightGauge  = metrics.NewRegisteredGauge("driver/l1Current/height", nil)
 	DriverL2HeadIDGauge         = metrics.NewRegisteredGauge("driver/l2Head/id", nil)
 	DriverL2VerifiedHeightGauge = metrics.NewRegisteredGauge("driver/l2Verified/id", nil)
+	DriverL1ReorgCounter        = metrics.NewRegisteredCounter("driver/reorg/detected", nil)
+	DriverL1ReorgDepthHistogram = metrics.NewRegisteredHistogram(
+		"driver/reorg/depth",
+		nil,
+		metrics.NewExpDecaySample(1028, 0.015),
+	)
 
 	// Proposer
 	ProposerProposeEpochCounter    = metrics.NewRegisteredCounter("proposer/epoch", nil)
 	ProposerProposedTxListsCounter = metrics.NewRegisteredCounter("proposer/proposed/txLists", nil)
 	ProposerProposedTxsCounter     = metrics.NewRegisteredCounter("proposer/proposed/txs", nil)
+	ProposerProposedBlobsCounter   = metrics.NewRegisteredCounter("proposer/proposed/blobs", nil)
+	ProposerCalldataEpochCounter   = metrics.NewRegisteredCounter("proposer/epoch/calldata", nil)
+	ProposerBlobEpochCounter       = metrics.NewRegisteredCounter("proposer/epoch/blob", nil)
+	ProposerPendingTxsGauge        = metrics.NewRegisteredGauge("proposer/tx/pending", nil)
+	ProposerTxReplacedCounter      = metrics.NewRegisteredCounter("proposer/tx/replaced", nil)
+	ProposerTxCancelledCounter     = metrics.NewRegisteredCounter("proposer/tx/cancelled", nil)
+	// Proposer proposal scheduling
+	ProposerScheduleTxListFullCounter = metrics.NewRegisteredCounter("proposer/schedule/propose/txListFull", nil)
+	ProposerScheduleByteBudgetCounter = metrics.NewRegisteredCounter("proposer/schedule/propose/byteBudget", nil)
+	ProposerScheduleFeesLowCounter    = metrics.NewRegisteredCounter("proposer/schedule/propose/feesLow", nil)
+	ProposerScheduleMaxDelayCounter   = metrics.NewRegisteredCounter("proposer/schedule/propose/maxDelay", nil)
+	ProposerScheduleFeesHighCounter   = metrics.NewRegisteredCounter("proposer/schedule/hold/feesHigh", nil)
+	ProposerScheduleHeldGauge         = metrics.NewRegisteredGauge("proposer/schedule/held", nil)
+	ProposerSchedulePendingBytesGauge = metrics.NewRegisteredGauge("proposer/schedule/pendingBytes", nil)
+	// Proposer transaction inclusion policy
+	ProposerPolicyDeniedSenderCounter       = metrics.NewRegisteredCounter("proposer/policy/dropped/deniedSender", nil)
+	ProposerPolicyDeniedRecipientCounter    = metrics.NewRegisteredCounter("proposer/policy/dropped/deniedRecipient", nil)
+	ProposerPolicyTipTooLowCounter          = metrics.NewRegisteredCounter("proposer/policy/dropped/tipTooLow", nil)
+	ProposerPolicySenderQuotaCounter        = metrics.NewRegisteredCounter("proposer/policy/dropped/senderQuota", nil)
+	ProposerPolicyContractNotAllowedCounter = metrics.NewRegisteredCounter(
+		"proposer/policy/dropped/contractNotAllowed",
+		nil,
+	)
+	ProposerPolicyNonceGapCounter = metrics.NewRegisteredCounter("proposer/policy/dropped/nonceGap", nil)
+	ProposerPolicyReloadCounter   = metrics.NewRegisteredCounter("proposer/policy/reload", nil)
 
 	// Prover
 	ProverLatestVerifiedIDGauge      = metrics.NewRegisteredGauge("prover/latestVerified/id", nil)
@@ -42,6 +73,31 @@ var (
 	ProverSubmissionErrorCounter     = metrics.NewRegisteredCounter("prover/proof/submission/error", nil)
 	ProverSgxProofGeneratedCounter   = metrics.NewRegisteredCounter("prover/proof/sgx/generated", nil)
 	ProverPseProofGeneratedCounter   = metrics.NewRegisteredCounter("prover/proof/pse/generated", nil)
+	ProverLocalVerifyFailedCounter   = metrics.NewRegisteredCounter("prover/proof/verification/failed", nil)
+	ProverLocalVerifyRequeueCounter  = metrics.NewRegisteredCounter("prover/proof/verification/requeued", nil)
+	ProverBondBalanceGauge           = metrics.NewRegisteredGaugeFloat64("prover/bond/balance", nil)
+	ProverBondEscrowGauge            = metrics.NewRegisteredGaugeFloat64("prover/bond/escrow", nil)
+	ProverBondExposureGauge          = metrics.NewRegisteredGaugeFloat64("prover/bond/exposure", nil)
+	ProverBondInFlightBlocksGauge    = metrics.NewRegisteredGauge("prover/bond/inFlightBlocks", nil)
+	ProverBondLostCounter            = metrics.NewRegisteredCounter("prover/bond/lost", nil)
+	ProverBondAllowanceTopUpCounter  = metrics.NewRegisteredCounter("prover/bond/allowance/topUp", nil)
+	ProverLedgerFeesGauge            = metrics.NewRegisteredGaugeFloat64("prover/ledger/fees", nil)
+	ProverLedgerGasCostGauge         = metrics.NewRegisteredGaugeFloat64("prover/ledger/gasCost", nil)
+	ProverLedgerBackendCostGauge     = metrics.NewRegisteredGaugeFloat64("prover/ledger/backendCost", nil)
+	ProverLedgerBondLockedGauge      = metrics.NewRegisteredGaugeFloat64("prover/ledger/bond/locked", nil)
+	ProverLedgerBondLostGauge        = metrics.NewRegisteredGaugeFloat64("prover/ledger/bond/lost", nil)
+	ProverLedgerProfitGauge          = metrics.NewRegisteredGaugeFloat64("prover/ledger/profit", nil)
+	ProverLedgerSkippedCounter       = metrics.NewRegisteredCounter("prover/ledger/skipped", nil)
+	// Contest strategy decisions, failed executions and outcomes
+	ProverContestDecisionIgnoreCounter    = metrics.NewRegisteredCounter("prover/contest/decision/ignore", nil)
+	ProverContestDecisionContestCounter   = metrics.NewRegisteredCounter("prover/contest/decision/contest", nil)
+	ProverContestDecisionProveCounter     = metrics.NewRegisteredCounter("prover/contest/decision/prove", nil)
+	ProverContestFailedContestCounter     = metrics.NewRegisteredCounter("prover/contest/failed/contest", nil)
+	ProverContestFailedProveCounter       = metrics.NewRegisteredCounter("prover/contest/failed/prove", nil)
+	ProverContestOutcomeWonCounter        = metrics.NewRegisteredCounter("prover/contest/outcome/won", nil)
+	ProverContestOutcomeLostCounter       = metrics.NewRegisteredCounter("prover/contest/outcome/lost", nil)
+	ProverContestOutcomeOverriddenCounter = metrics.NewRegisteredCounter("prover/contest/outcome/overridden", nil)
+	ProverContestOutcomeVerifiedCounter   = metrics.NewRegisteredCounter("prover/contest/outcome/verified", nil)
 )
 
 // Serve starts the metrics server on the given address, will be closed when the given
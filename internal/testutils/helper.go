@@ -21,6 +21,7 @@ import (
 	"github.com/phayes/freeport"
 
 	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
 	"github.com/taikoxyz/taiko-client/prover/server"
 )
 
@@ -166,7 +167,7 @@ func NewTestProverServer(
 	s.Nil(err)
 
 	srv, err := server.New(&server.NewProverServerOpts{
-		ProverPrivateKey:         proverPrivKey,
+		ProverSigner:             signer.NewLocalSigner(proverPrivKey),
 		MinOptimisticTierFee:     common.Big1,
 		MinSgxTierFee:            common.Big1,
 		MinPseZkevmTierFee:       common.Big1,
@@ -0,0 +1,64 @@
+package blobsource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+)
+
+// Archive fetches blobs from a local directory, each blob is stored in a file named
+// by its versioned hash, e.g. `0x01...ff.blob`, which contains the raw blob bytes.
+type Archive struct {
+	dir string
+}
+
+// NewArchive creates a new Archive instance.
+func NewArchive(dir string) (*Archive, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open blob archive directory: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("blob archive path is not a directory: %s", dir)
+	}
+
+	return &Archive{dir: dir}, nil
+}
+
+// GetBlob implements the BlobSource interface.
+func (a *Archive) GetBlob(
+	_ context.Context,
+	_ *types.Header,
+	blobHash common.Hash,
+) (*kzg4844.Blob, error) {
+	data, err := os.ReadFile(a.path(blobHash))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: blobHash %s", ErrBlobNotFound, blobHash)
+		}
+		return nil, err
+	}
+
+	if len(data) != len(kzg4844.Blob{}) {
+		return nil, fmt.Errorf("invalid archived blob size: %d, blobHash %s", len(data), blobHash)
+	}
+
+	blob := kzg4844.Blob(data)
+	return &blob, nil
+}
+
+// Put stores the given blob into the archive directory.
+func (a *Archive) Put(blobHash common.Hash, blob *kzg4844.Blob) error {
+	return os.WriteFile(a.path(blobHash), blob[:], 0o600)
+}
+
+// path returns the archive file path of the given blob.
+func (a *Archive) path(blobHash common.Hash) string {
+	return filepath.Join(a.dir, blobHash.Hex()+".blob")
+}
@@ -0,0 +1,49 @@
+package blobsource
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchive(t *testing.T) {
+	dir := t.TempDir()
+
+	archive, err := NewArchive(dir)
+	require.Nil(t, err)
+
+	blob := newTestBlob(t, []byte("archive"))
+	blobHash, err := BlobHash(blob)
+	require.Nil(t, err)
+
+	_, err = archive.GetBlob(context.Background(), nil, blobHash)
+	require.True(t, errors.Is(err, ErrBlobNotFound))
+
+	require.Nil(t, archive.Put(blobHash, blob))
+
+	got, err := archive.GetBlob(context.Background(), nil, blobHash)
+	require.Nil(t, err)
+	require.Equal(t, blob, got)
+
+	// Invalid blob file size
+	invalidHash := common.HexToHash("0x01")
+	require.Nil(t, os.WriteFile(filepath.Join(dir, invalidHash.Hex()+".blob"), []byte{1}, 0o600))
+	_, err = archive.GetBlob(context.Background(), nil, invalidHash)
+	require.NotNil(t, err)
+	require.False(t, errors.Is(err, ErrBlobNotFound))
+}
+
+func TestNewArchiveInvalidDir(t *testing.T) {
+	_, err := NewArchive(filepath.Join(t.TempDir(), "notExist"))
+	require.NotNil(t, err)
+
+	file := filepath.Join(t.TempDir(), "file")
+	require.Nil(t, os.WriteFile(file, []byte{}, 0o600))
+	_, err = NewArchive(file)
+	require.NotNil(t, err)
+}
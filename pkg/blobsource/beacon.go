@@ -0,0 +1,172 @@
+package blobsource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+)
+
+// Beacon node API routes.
+const (
+	beaconGenesisRoute      = "/eth/v1/beacon/genesis"
+	beaconSpecRoute         = "/eth/v1/config/spec"
+	beaconBlobSidecarsRoute = "/eth/v1/beacon/blob_sidecars/%d"
+)
+
+// genesisResponse is the response of the beacon node genesis API.
+type genesisResponse struct {
+	Data struct {
+		GenesisTime string `json:"genesis_time"`
+	} `json:"data"`
+}
+
+// specResponse is the response of the beacon node config spec API.
+type specResponse struct {
+	Data struct {
+		SecondsPerSlot string `json:"SECONDS_PER_SLOT"`
+	} `json:"data"`
+}
+
+// blobSidecar is a blob sidecar returned by the beacon node.
+type blobSidecar struct {
+	Index         string        `json:"index"`
+	Blob          hexutil.Bytes `json:"blob"`
+	KZGCommitment hexutil.Bytes `json:"kzg_commitment"`
+	KZGProof      hexutil.Bytes `json:"kzg_proof"`
+}
+
+// blobSidecarsResponse is the response of the beacon node blob sidecars API.
+type blobSidecarsResponse struct {
+	Data []*blobSidecar `json:"data"`
+}
+
+// BeaconClient fetches blobs from a L1 beacon node through the `blob_sidecars` API.
+type BeaconClient struct {
+	endpoint string
+	client   *http.Client
+
+	// Beacon chain configurations, which are used to compute the slot of a L1 block.
+	mutex          sync.Mutex
+	genesisTime    uint64
+	secondsPerSlot uint64
+}
+
+// NewBeaconClient creates a new BeaconClient instance.
+func NewBeaconClient(endpoint string, timeout time.Duration) *BeaconClient {
+	return &BeaconClient{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+// GetBlob implements the BlobSource interface.
+func (c *BeaconClient) GetBlob(
+	ctx context.Context,
+	l1Header *types.Header,
+	blobHash common.Hash,
+) (*kzg4844.Blob, error) {
+	slot, err := c.timeToSlot(ctx, l1Header.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	var res blobSidecarsResponse
+	if err := c.get(ctx, fmt.Sprintf(beaconBlobSidecarsRoute, slot), &res); err != nil {
+		return nil, fmt.Errorf("failed to fetch blob sidecars of slot %d: %w", slot, err)
+	}
+
+	for _, sidecar := range res.Data {
+		if len(sidecar.KZGCommitment) != len(kzg4844.Commitment{}) || len(sidecar.Blob) != len(kzg4844.Blob{}) {
+			return nil, fmt.Errorf("invalid blob sidecar %s of slot %d", sidecar.Index, slot)
+		}
+
+		if commitmentToBlobHash(kzg4844.Commitment(sidecar.KZGCommitment)) != blobHash {
+			continue
+		}
+
+		blob := kzg4844.Blob(sidecar.Blob)
+		return &blob, nil
+	}
+
+	return nil, fmt.Errorf("%w: slot %d, blobHash %s", ErrBlobNotFound, slot, blobHash)
+}
+
+// timeToSlot converts the given L1 block timestamp to the beacon chain slot.
+func (c *BeaconClient) timeToSlot(ctx context.Context, timestamp uint64) (uint64, error) {
+	if err := c.loadConfigs(ctx); err != nil {
+		return 0, err
+	}
+
+	if timestamp < c.genesisTime {
+		return 0, fmt.Errorf("timestamp %d is before beacon genesis time %d", timestamp, c.genesisTime)
+	}
+
+	return (timestamp - c.genesisTime) / c.secondsPerSlot, nil
+}
+
+// loadConfigs fetches the beacon chain genesis time and slot duration, if they haven't been
+// fetched yet.
+func (c *BeaconClient) loadConfigs(ctx context.Context) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.secondsPerSlot != 0 {
+		return nil
+	}
+
+	var genesis genesisResponse
+	if err := c.get(ctx, beaconGenesisRoute, &genesis); err != nil {
+		return fmt.Errorf("failed to fetch beacon genesis: %w", err)
+	}
+	genesisTime, err := strconv.ParseUint(genesis.Data.GenesisTime, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid beacon genesis time: %w", err)
+	}
+
+	var spec specResponse
+	if err := c.get(ctx, beaconSpecRoute, &spec); err != nil {
+		return fmt.Errorf("failed to fetch beacon config spec: %w", err)
+	}
+	secondsPerSlot, err := strconv.ParseUint(spec.Data.SecondsPerSlot, 10, 64)
+	if err != nil || secondsPerSlot == 0 {
+		return fmt.Errorf("invalid beacon seconds per slot: %s", spec.Data.SecondsPerSlot)
+	}
+
+	c.genesisTime, c.secondsPerSlot = genesisTime, secondsPerSlot
+
+	return nil
+}
+
+// get sends a GET request to the beacon node, and decodes the JSON response.
+func (c *BeaconClient) get(ctx context.Context, route string, result interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+route, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrBlobNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected beacon node response status code: %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
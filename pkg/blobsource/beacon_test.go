@@ -0,0 +1,80 @@
+package blobsource
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconClientGetBlob(t *testing.T) {
+	var (
+		genesisTime = uint64(1000)
+		slot        = uint64(10)
+		other       = newTestBlob(t, []byte("other"))
+		blob        = newTestBlob(t, []byte("beacon"))
+	)
+
+	newSidecar := func(index int, blob *kzg4844.Blob) *blobSidecar {
+		commitment, err := kzg4844.BlobToCommitment(*blob)
+		require.Nil(t, err)
+		return &blobSidecar{Index: fmt.Sprint(index), Blob: blob[:], KZGCommitment: commitment[:]}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(beaconGenesisRoute, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"data":{"genesis_time":"%d"}}`, genesisTime)
+	})
+	mux.HandleFunc(beaconSpecRoute, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"SECONDS_PER_SLOT":"12"}}`))
+	})
+	mux.HandleFunc(fmt.Sprintf(beaconBlobSidecarsRoute, slot), func(w http.ResponseWriter, r *http.Request) {
+		require.Nil(t, json.NewEncoder(w).Encode(&blobSidecarsResponse{
+			Data: []*blobSidecar{newSidecar(0, other), newSidecar(1, blob)},
+		}))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := NewBeaconClient(server.URL+"/", 5*time.Second)
+
+	blobHash, err := BlobHash(blob)
+	require.Nil(t, err)
+
+	header := &types.Header{Number: big.NewInt(1), Time: genesisTime + slot*12 + 1}
+	got, err := client.GetBlob(context.Background(), header, blobHash)
+	require.Nil(t, err)
+	require.Equal(t, blob, got)
+
+	// Blob not in the slot.
+	missing, err := BlobHash(newTestBlob(t, []byte("missing")))
+	require.Nil(t, err)
+	_, err = client.GetBlob(context.Background(), header, missing)
+	require.True(t, errors.Is(err, ErrBlobNotFound))
+
+	// Slot without any sidecar.
+	_, err = client.GetBlob(context.Background(), &types.Header{Time: genesisTime}, blobHash)
+	require.True(t, errors.Is(err, ErrBlobNotFound))
+
+	// Before beacon genesis.
+	_, err = client.GetBlob(context.Background(), &types.Header{Time: genesisTime - 1}, blobHash)
+	require.NotNil(t, err)
+}
+
+func TestBlobSidecarJSON(t *testing.T) {
+	var sidecar blobSidecar
+	require.Nil(t, json.Unmarshal([]byte(`{"index":"1","blob":"0x0102","kzg_commitment":"0x03"}`), &sidecar))
+	require.Equal(t, "1", sidecar.Index)
+	require.Equal(t, hexutil.Bytes{1, 2}, sidecar.Blob)
+	require.Equal(t, hexutil.Bytes{3}, sidecar.KZGCommitment)
+}
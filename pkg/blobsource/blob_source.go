@@ -0,0 +1,103 @@
+package blobsource
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	ErrBlobNotFound = errors.New("blob not found")
+)
+
+// BlobSource is the interface for fetching EIP-4844 blobs, which were attached to the
+// L1 transactions in the given L1 block.
+type BlobSource interface {
+	GetBlob(ctx context.Context, l1Header *types.Header, blobHash common.Hash) (*kzg4844.Blob, error)
+}
+
+// New creates the blob source used to derive blob proposals, the local blob archive in the given
+// directory will be checked before the given L1 beacon node. Returns nil if neither is given.
+func New(archiveDir string, beaconEndpoint string, timeout time.Duration) (BlobSource, error) {
+	var sources []BlobSource
+	if archiveDir != "" {
+		archive, err := NewArchive(archiveDir)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, archive)
+	}
+	if beaconEndpoint != "" {
+		sources = append(sources, NewBeaconClient(beaconEndpoint, timeout))
+	}
+
+	if len(sources) == 0 {
+		log.Warn("No blob source configured, blocks proposed with blobs can not be derived")
+		return nil, nil
+	}
+
+	return WithFallback(sources...), nil
+}
+
+// BlobHash computes the versioned hash of the given blob.
+func BlobHash(blob *kzg4844.Blob) (common.Hash, error) {
+	commitment, err := kzg4844.BlobToCommitment(*blob)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to compute blob commitment: %w", err)
+	}
+
+	return commitmentToBlobHash(commitment), nil
+}
+
+// commitmentToBlobHash computes the versioned hash of the given KZG commitment.
+func commitmentToBlobHash(commitment kzg4844.Commitment) common.Hash {
+	hash := sha256.Sum256(commitment[:])
+	hash[0] = params.BlobTxHashVersion
+
+	return hash
+}
+
+// fallbackSource tries fetching the blob from the given sources one by one.
+type fallbackSource struct {
+	sources []BlobSource
+}
+
+// WithFallback creates a new blob source, which tries fetching the blob from the given
+// sources in order, until the blob is found.
+func WithFallback(sources ...BlobSource) BlobSource {
+	if len(sources) == 1 {
+		return sources[0]
+	}
+
+	return &fallbackSource{sources: sources}
+}
+
+// GetBlob implements the BlobSource interface.
+func (s *fallbackSource) GetBlob(
+	ctx context.Context,
+	l1Header *types.Header,
+	blobHash common.Hash,
+) (*kzg4844.Blob, error) {
+	var errs []error
+	for _, source := range s.sources {
+		blob, err := source.GetBlob(ctx, l1Header, blobHash)
+		if err == nil {
+			return blob, nil
+		}
+		errs = append(errs, err)
+	}
+
+	if len(errs) == 0 {
+		return nil, ErrBlobNotFound
+	}
+
+	return nil, errors.Join(errs...)
+}
@@ -0,0 +1,53 @@
+package blobsource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+func newTestBlob(t *testing.T, data []byte) *kzg4844.Blob {
+	blob, err := encoding.EncodeBlob(data)
+	require.Nil(t, err)
+	return blob
+}
+
+func TestBlobHash(t *testing.T) {
+	blob := newTestBlob(t, []byte("blob"))
+
+	commitment, err := kzg4844.BlobToCommitment(*blob)
+	require.Nil(t, err)
+
+	hash, err := BlobHash(blob)
+	require.Nil(t, err)
+	require.Equal(t, (&types.BlobTxSidecar{
+		Blobs:       []kzg4844.Blob{*blob},
+		Commitments: []kzg4844.Commitment{commitment},
+	}).BlobHashes()[0], hash)
+}
+
+func TestWithFallback(t *testing.T) {
+	var (
+		first  = NewStubSource()
+		second = NewStubSource()
+		blob   = newTestBlob(t, []byte("fallback"))
+	)
+
+	blobHash, err := second.Add(blob)
+	require.Nil(t, err)
+
+	source := WithFallback(first, second)
+
+	got, err := source.GetBlob(context.Background(), nil, blobHash)
+	require.Nil(t, err)
+	require.Equal(t, blob, got)
+
+	_, err = WithFallback(first).GetBlob(context.Background(), nil, blobHash)
+	require.True(t, errors.Is(err, ErrBlobNotFound))
+}
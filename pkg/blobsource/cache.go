@@ -0,0 +1,76 @@
+package blobsource
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+)
+
+// cachedBlob is a blob cached for reuse, along with its expiry timestamp.
+type cachedBlob struct {
+	blob   *kzg4844.Blob
+	expiry uint64
+}
+
+// Cache keeps the blobs which have been cached in protocol through `TaikoL1.BlobCached`, so
+// that the following proposals reusing these blobs can be derived without fetching them again.
+type Cache struct {
+	mutex sync.Mutex
+	blobs map[common.Hash]*cachedBlob
+}
+
+// NewCache creates a new Cache instance.
+func NewCache() *Cache {
+	return &Cache{blobs: make(map[common.Hash]*cachedBlob)}
+}
+
+// Add adds the given blob to the cache, the blob can be reused until the given expiry timestamp.
+func (c *Cache) Add(blobHash common.Hash, blob *kzg4844.Blob, expiry uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if cached, ok := c.blobs[blobHash]; ok && cached.expiry >= expiry {
+		return
+	}
+
+	c.blobs[blobHash] = &cachedBlob{blob: blob, expiry: expiry}
+}
+
+// Get returns the cached blob, if it's still reusable at the given timestamp, same as
+// `TaikoL1.isBlobReusable`.
+func (c *Cache) Get(blobHash common.Hash, timestamp uint64) (*kzg4844.Blob, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	cached, ok := c.blobs[blobHash]
+	if !ok || cached.expiry <= timestamp {
+		return nil, false
+	}
+
+	return cached.blob, true
+}
+
+// Prune removes all expired blobs at the given timestamp, and returns the number of removed blobs.
+func (c *Cache) Prune(timestamp uint64) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var pruned int
+	for blobHash, cached := range c.blobs {
+		if cached.expiry <= timestamp {
+			delete(c.blobs, blobHash)
+			pruned++
+		}
+	}
+
+	return pruned
+}
+
+// Len returns the number of cached blobs.
+func (c *Cache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return len(c.blobs)
+}
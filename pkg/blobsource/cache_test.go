@@ -0,0 +1,39 @@
+package blobsource
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	var (
+		cache    = NewCache()
+		blob     = newTestBlob(t, []byte("cache"))
+		blobHash = common.HexToHash("0x01")
+	)
+
+	_, ok := cache.Get(blobHash, 0)
+	require.False(t, ok)
+
+	cache.Add(blobHash, blob, 100)
+	got, ok := cache.Get(blobHash, 99)
+	require.True(t, ok)
+	require.Equal(t, blob, got)
+
+	// Not reusable after expiry.
+	_, ok = cache.Get(blobHash, 100)
+	require.False(t, ok)
+
+	// Cached again later, the expiry will be extended.
+	cache.Add(blobHash, blob, 200)
+	cache.Add(blobHash, blob, 150)
+	_, ok = cache.Get(blobHash, 180)
+	require.True(t, ok)
+
+	cache.Add(common.HexToHash("0x02"), blob, 300)
+	require.Equal(t, 2, cache.Len())
+	require.Equal(t, 1, cache.Prune(200))
+	require.Equal(t, 1, cache.Len())
+}
@@ -0,0 +1,54 @@
+package blobsource
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+)
+
+// StubSource is an in-memory blob source, which is used in tests.
+type StubSource struct {
+	mutex sync.RWMutex
+	blobs map[common.Hash]*kzg4844.Blob
+}
+
+// NewStubSource creates a new StubSource instance.
+func NewStubSource() *StubSource {
+	return &StubSource{blobs: make(map[common.Hash]*kzg4844.Blob)}
+}
+
+// Add adds the given blob to the source, and returns its versioned hash.
+func (s *StubSource) Add(blob *kzg4844.Blob) (common.Hash, error) {
+	blobHash, err := BlobHash(blob)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.blobs[blobHash] = blob
+
+	return blobHash, nil
+}
+
+// GetBlob implements the BlobSource interface.
+func (s *StubSource) GetBlob(
+	_ context.Context,
+	_ *types.Header,
+	blobHash common.Hash,
+) (*kzg4844.Blob, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	blob, ok := s.blobs[blobHash]
+	if !ok {
+		return nil, fmt.Errorf("%w: blobHash %s", ErrBlobNotFound, blobHash)
+	}
+
+	return blob, nil
+}
@@ -35,10 +35,13 @@ type Client struct {
 
 // ClientConfig contains all configs which will be used to initializing an
 // RPC client. If not providing L2EngineEndpoint or JwtSecret, then the L2Engine client
-// won't be initialized.
+// won't be initialized. The fallback endpoints are used when the primary endpoint of the
+// same chain is unhealthy.
 type ClientConfig struct {
 	L1Endpoint            string
+	L1FallbackEndpoints   []string
 	L2Endpoint            string
+	L2FallbackEndpoints   []string
 	L2CheckPoint          string
 	TaikoL1Address        common.Address
 	TaikoL2Address        common.Address
@@ -49,6 +52,7 @@ type ClientConfig struct {
 	RetryInterval         time.Duration
 	Timeout               time.Duration
 	BackOffMaxRetries     uint64
+	HealthCheck           *HealthCheckConfig
 }
 
 // NewClient initializes all RPC clients used by Taiko client software.
@@ -56,12 +60,24 @@ func NewClient(ctx context.Context, cfg *ClientConfig) (*Client, error) {
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, defaultTimeout)
 	defer cancel()
 
-	l1Client, err := NewEthClient(ctxWithTimeout, cfg.L1Endpoint, cfg.Timeout)
+	l1Client, err := NewFailoverEthClient(
+		ctxWithTimeout,
+		"l1",
+		append([]string{cfg.L1Endpoint}, cfg.L1FallbackEndpoints...),
+		cfg.Timeout,
+		cfg.HealthCheck,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	l2Client, err := NewEthClient(ctxWithTimeout, cfg.L2Endpoint, cfg.Timeout)
+	l2Client, err := NewFailoverEthClient(
+		ctxWithTimeout,
+		"l2",
+		append([]string{cfg.L2Endpoint}, cfg.L2FallbackEndpoints...),
+		cfg.Timeout,
+		cfg.HealthCheck,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -125,7 +141,7 @@ func NewClient(ctx context.Context, cfg *ClientConfig) (*Client, error) {
 
 	var l2CheckPoint *EthClient
 	if cfg.L2CheckPoint != "" {
-		l2CheckPoint, err = NewEthClient(ctxWithTimeout, cfg.L2CheckPoint, cfg.Timeout)
+		l2CheckPoint, err = NewFailoverEthClient(ctxWithTimeout, "l2CheckPoint", []string{cfg.L2CheckPoint}, cfg.Timeout, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,228 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/taikoxyz/taiko-client/internal/utils"
+)
+
+const (
+	// endpointResultsWindow is the number of the latest call results used to calculate the error rate
+	// of an endpoint.
+	endpointResultsWindow = 20
+	// endpointLatencyWeight is the weight of the latest sample in the latency moving average.
+	endpointLatencyWeight = 0.2
+)
+
+// HealthCheckConfig contains the configurations of the endpoints health check, an endpoint
+// is considered unhealthy if any of the following thresholds is exceeded.
+type HealthCheckConfig struct {
+	// Interval between two health checks.
+	Interval time.Duration
+	// Maximum number of blocks an endpoint's head can lag behind the highest known head.
+	MaxHeadLag uint64
+	// Maximum average latency of an endpoint, zero means no limit.
+	MaxLatency time.Duration
+	// Maximum ratio of the failed calls in the latest calls.
+	MaxErrorRate float64
+}
+
+// DefaultHealthCheckConfig is the default health check configurations.
+var DefaultHealthCheckConfig = &HealthCheckConfig{
+	Interval:     12 * time.Second,
+	MaxHeadLag:   3,
+	MaxLatency:   5 * time.Second,
+	MaxErrorRate: 0.5,
+}
+
+// endpointMetrics contains the metrics of a single RPC endpoint.
+type endpointMetrics struct {
+	head      metrics.Gauge
+	headLag   metrics.Gauge
+	latency   metrics.Gauge
+	errorRate metrics.GaugeFloat64
+	healthy   metrics.Gauge
+	requests  metrics.Counter
+	errors    metrics.Counter
+}
+
+// newEndpointMetrics registers the metrics of the endpoint with the given name.
+func newEndpointMetrics(name string) *endpointMetrics {
+	return &endpointMetrics{
+		head:      metrics.GetOrRegisterGauge(name+"/head", nil),
+		headLag:   metrics.GetOrRegisterGauge(name+"/headLag", nil),
+		latency:   metrics.GetOrRegisterGauge(name+"/latency", nil),
+		errorRate: metrics.GetOrRegisterGaugeFloat64(name+"/errorRate", nil),
+		healthy:   metrics.GetOrRegisterGauge(name+"/healthy", nil),
+		requests:  metrics.GetOrRegisterCounter(name+"/requests", nil),
+		errors:    metrics.GetOrRegisterCounter(name+"/errors", nil),
+	}
+}
+
+// endpoint is a single RPC endpoint of a chain, along with its health statistics.
+type endpoint struct {
+	name string
+	url  string
+
+	client     *rpc.Client
+	gethClient *gethclient.Client
+	ethClient  *ethclient.Client
+
+	mutex     sync.RWMutex
+	head      uint64
+	checked   bool
+	checkErr  error
+	latency   time.Duration
+	results   [endpointResultsWindow]bool
+	resultIdx int
+	resultLen int
+
+	metrics *endpointMetrics
+}
+
+// dialEndpoint connects to the given RPC endpoint.
+func dialEndpoint(ctx context.Context, name string, url string) (*endpoint, error) {
+	client, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial RPC endpoint %s: %w", name, err)
+	}
+
+	return newEndpoint(name, url, client), nil
+}
+
+// newEndpoint creates a new endpoint instance with the given RPC client.
+func newEndpoint(name string, url string, client *rpc.Client) *endpoint {
+	return &endpoint{
+		name:       name,
+		url:        url,
+		client:     client,
+		gethClient: gethclient.New(client),
+		ethClient:  ethclient.NewClient(client),
+		metrics:    newEndpointMetrics(name),
+	}
+}
+
+// recordCall records the result of a call to the endpoint.
+func (e *endpoint) recordCall(latency time.Duration, err error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.metrics.requests.Inc(1)
+	if err != nil {
+		e.metrics.errors.Inc(1)
+	}
+
+	if e.latency == 0 {
+		e.latency = latency
+	} else {
+		e.latency = time.Duration(
+			endpointLatencyWeight*float64(latency) + (1-endpointLatencyWeight)*float64(e.latency),
+		)
+	}
+
+	e.results[e.resultIdx] = err == nil
+	e.resultIdx = (e.resultIdx + 1) % endpointResultsWindow
+	if e.resultLen < endpointResultsWindow {
+		e.resultLen++
+	}
+}
+
+// recordCheck records the result of a health check of the endpoint.
+func (e *endpoint) recordCheck(head uint64, latency time.Duration, err error) {
+	e.recordCall(latency, err)
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.checked = true
+	e.checkErr = err
+	if err == nil {
+		e.head = head
+	}
+}
+
+// errorRate returns the ratio of the failed calls in the latest calls, the caller
+// should hold the lock.
+func (e *endpoint) errorRate() float64 {
+	if e.resultLen == 0 {
+		return 0
+	}
+
+	var failed int
+	for i := 0; i < e.resultLen; i++ {
+		if !e.results[i] {
+			failed++
+		}
+	}
+
+	return float64(failed) / float64(e.resultLen)
+}
+
+// isHealthy checks whether the endpoint is healthy with the given highest known head,
+// and updates the endpoint metrics.
+func (e *endpoint) isHealthy(maxHead uint64, cfg *HealthCheckConfig) bool {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	var (
+		headLag   uint64
+		errorRate = e.errorRate()
+	)
+	if maxHead > e.head {
+		headLag = maxHead - e.head
+	}
+
+	healthy := e.checkErr == nil &&
+		(!e.checked || headLag <= cfg.MaxHeadLag) &&
+		(cfg.MaxLatency == 0 || e.latency <= cfg.MaxLatency) &&
+		errorRate <= cfg.MaxErrorRate
+
+	e.metrics.head.Update(int64(e.head))
+	e.metrics.headLag.Update(int64(headLag))
+	e.metrics.latency.Update(e.latency.Milliseconds())
+	e.metrics.errorRate.Update(errorRate)
+	if healthy {
+		e.metrics.healthy.Update(1)
+	} else {
+		e.metrics.healthy.Update(0)
+	}
+
+	return healthy
+}
+
+// getHead returns the latest head checked of the endpoint.
+func (e *endpoint) getHead() uint64 {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	return e.head
+}
+
+// isEndpointError checks whether the given error is caused by the endpoint itself, such as
+// connection and HTTP errors, rather than the JSON-RPC error responses or the caller's context.
+func isEndpointError(ctx context.Context, err error) bool {
+	if err == nil || errors.Is(err, ethereum.NotFound) {
+		return false
+	}
+	if !utils.IsNil(ctx) && ctx.Err() != nil {
+		return false
+	}
+
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return true
+	}
+
+	var rpcErr rpc.Error
+	return !errors.As(err, &rpcErr)
+}
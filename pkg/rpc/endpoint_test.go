@@ -0,0 +1,71 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// testRPCError is a JSON-RPC error response.
+type testRPCError struct{}
+
+func (e *testRPCError) Error() string  { return "execution reverted" }
+func (e *testRPCError) ErrorCode() int { return 3 }
+
+func newTestEndpoint(t *testing.T) *endpoint {
+	client, err := rpc.DialContext(context.Background(), "http://localhost:1")
+	require.Nil(t, err)
+
+	return newEndpoint(t.Name(), "http://localhost:1", client)
+}
+
+func TestEndpointErrorRate(t *testing.T) {
+	e := newTestEndpoint(t)
+	require.Zero(t, e.errorRate())
+
+	for i := 0; i < endpointResultsWindow; i++ {
+		e.recordCall(time.Millisecond, errors.New("test"))
+	}
+	require.Equal(t, 1.0, e.errorRate())
+
+	for i := 0; i < endpointResultsWindow/2; i++ {
+		e.recordCall(time.Millisecond, nil)
+	}
+	require.Equal(t, 0.5, e.errorRate())
+}
+
+func TestEndpointIsHealthy(t *testing.T) {
+	cfg := &HealthCheckConfig{MaxHeadLag: 3, MaxLatency: time.Second, MaxErrorRate: 0.5}
+
+	e := newTestEndpoint(t)
+	require.True(t, e.isHealthy(100, cfg))
+
+	e.recordCheck(97, time.Millisecond, nil)
+	require.True(t, e.isHealthy(100, cfg))
+	require.False(t, e.isHealthy(101, cfg))
+
+	e.recordCheck(0, time.Millisecond, errors.New("test"))
+	require.False(t, e.isHealthy(97, cfg))
+	e.recordCheck(97, time.Millisecond, nil)
+	require.True(t, e.isHealthy(97, cfg))
+
+	e.recordCheck(97, time.Minute, nil)
+	require.False(t, e.isHealthy(97, cfg))
+}
+
+func TestIsEndpointError(t *testing.T) {
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.False(t, isEndpointError(context.Background(), nil))
+	require.False(t, isEndpointError(context.Background(), ethereum.NotFound))
+	require.False(t, isEndpointError(context.Background(), &testRPCError{}))
+	require.False(t, isEndpointError(canceledCtx, errors.New("test")))
+	require.True(t, isEndpointError(context.Background(), rpc.HTTPError{StatusCode: 503}))
+	require.True(t, isEndpointError(context.Background(), errors.New("connection refused")))
+}
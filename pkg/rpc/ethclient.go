@@ -2,59 +2,119 @@ package rpc
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
-type gethClient struct {
-	*gethclient.Client
-}
-
-type ethClient struct {
-	*ethclient.Client
-}
-
-// EthClient is a wrapper for go-ethereum eth client with a timeout attached.
+// EthClient is a wrapper for go-ethereum eth client with a timeout attached. It can connect to
+// multiple endpoints of the same chain, calls are routed to the healthiest endpoint, and fail
+// over to the other endpoints when an endpoint error occurs.
 type EthClient struct {
-	*rpc.Client
-	*gethClient
-	*ethClient
+	name      string
+	endpoints []*endpoint
+	timeout   time.Duration
+	healthCfg *HealthCheckConfig
+
+	mutex    sync.RWMutex
+	maxHead  uint64
+	best     *endpoint
+	bestSub  *endpoint
+	bestFeed event.Feed
 
-	timeout time.Duration
+	failoversCounter metrics.Counter
+	cancel           context.CancelFunc
+	wg               sync.WaitGroup
 }
 
+// NewEthClient creates a new EthClient instance with a single endpoint.
 func NewEthClient(ctx context.Context, url string, timeout time.Duration) (*EthClient, error) {
+	return NewFailoverEthClient(ctx, "eth", []string{url}, timeout, nil)
+}
+
+// NewFailoverEthClient creates a new EthClient instance with the given endpoints of the same chain,
+// the first endpoint has the highest priority. If more than one endpoint is given, the endpoints
+// will be health checked periodically, the name is used to label the logs and metrics.
+func NewFailoverEthClient(
+	ctx context.Context,
+	name string,
+	urls []string,
+	timeout time.Duration,
+	healthCfg *HealthCheckConfig,
+) (*EthClient, error) {
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("no RPC endpoint given for %s", name)
+	}
+
 	var timeoutVal = defaultTimeout
 	if timeout != 0 {
 		timeoutVal = timeout
 	}
+	if healthCfg == nil {
+		healthCfg = DefaultHealthCheckConfig
+	}
+	if healthCfg.Interval == 0 {
+		cfg := *healthCfg
+		cfg.Interval = DefaultHealthCheckConfig.Interval
+		healthCfg = &cfg
+	}
+
+	c := &EthClient{
+		name:             name,
+		timeout:          timeoutVal,
+		healthCfg:        healthCfg,
+		failoversCounter: metrics.GetOrRegisterCounter(fmt.Sprintf("rpc/%s/failovers", name), nil),
+	}
+
+	for i, url := range urls {
+		e, err := dialEndpoint(ctx, fmt.Sprintf("rpc/%s/%d", name, i), url)
+		if err != nil {
+			c.Close()
+			return nil, err
+		}
+		c.endpoints = append(c.endpoints, e)
+	}
+
+	c.best, c.bestSub = c.endpoints[0], c.endpoints[0]
+	if len(c.endpoints) > 1 {
+		c.checkHealth(ctx)
+
+		loopCtx, cancel := context.WithCancel(context.Background())
+		c.cancel = cancel
+		c.wg.Add(1)
+		go c.healthCheckLoop(loopCtx)
+	}
+
+	return c, nil
+}
 
-	client, err := rpc.DialContext(ctx, url)
-	if err != nil {
-		return nil, err
+// Close stops the health checks, and closes the connections to all endpoints.
+func (c *EthClient) Close() {
+	if c.cancel != nil {
+		c.cancel()
 	}
+	c.wg.Wait()
 
-	return &EthClient{
-		Client:     client,
-		gethClient: &gethClient{gethclient.New(client)},
-		ethClient:  &ethClient{ethclient.NewClient(client)},
-		timeout:    timeoutVal,
-	}, nil
+	for _, e := range c.endpoints {
+		e.client.Close()
+	}
 }
 
 // ChainID retrieves the current chain ID for transaction replay protection.
 func (c *EthClient) ChainID(ctx context.Context) (*big.Int, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.ChainID(ctxWithTimeout)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (*big.Int, error) {
+		return e.ethClient.ChainID(ctx)
+	})
 }
 
 // BlockByHash returns the given full block.
@@ -62,10 +122,9 @@ func (c *EthClient) ChainID(ctx context.Context) (*big.Int, error) {
 // Note that loading full blocks requires two requests. Use HeaderByHash
 // if you don't need all transactions or uncle headers.
 func (c *EthClient) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.BlockByHash(ctxWithTimeout, hash)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (*types.Block, error) {
+		return e.ethClient.BlockByHash(ctx, hash)
+	})
 }
 
 // BlockByNumber returns a block from the current canonical chain. If number is nil, the
@@ -74,43 +133,38 @@ func (c *EthClient) BlockByHash(ctx context.Context, hash common.Hash) (*types.B
 // Note that loading full blocks requires two requests. Use HeaderByNumber
 // if you don't need all transactions or uncle headers.
 func (c *EthClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.BlockByNumber(ctxWithTimeout, number)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (*types.Block, error) {
+		return e.ethClient.BlockByNumber(ctx, number)
+	})
 }
 
 // BlockNumber returns the most recent block number
 func (c *EthClient) BlockNumber(ctx context.Context) (uint64, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.BlockNumber(ctxWithTimeout)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (uint64, error) {
+		return e.ethClient.BlockNumber(ctx)
+	})
 }
 
 // PeerCount returns the number of p2p peers as reported by the net_peerCount method.
 func (c *EthClient) PeerCount(ctx context.Context) (uint64, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.PeerCount(ctxWithTimeout)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (uint64, error) {
+		return e.ethClient.PeerCount(ctx)
+	})
 }
 
 // HeaderByHash returns the block header with the given hash.
 func (c *EthClient) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.HeaderByHash(ctxWithTimeout, hash)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (*types.Header, error) {
+		return e.ethClient.HeaderByHash(ctx, hash)
+	})
 }
 
 // HeaderByNumber returns a block header from the current canonical chain. If number is
 // nil, the latest known header is returned.
 func (c *EthClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.HeaderByNumber(ctxWithTimeout, number)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (*types.Header, error) {
+		return e.ethClient.HeaderByNumber(ctx, number)
+	})
 }
 
 // TransactionByHash returns the transaction with the given hash.
@@ -118,10 +172,12 @@ func (c *EthClient) TransactionByHash(
 	ctx context.Context,
 	hash common.Hash,
 ) (tx *types.Transaction, isPending bool, err error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
+	err = c.failover(ctx, func(ctx context.Context, e *endpoint) error {
+		tx, isPending, err = e.ethClient.TransactionByHash(ctx, hash)
+		return err
+	})
 
-	return c.ethClient.TransactionByHash(ctxWithTimeout, hash)
+	return tx, isPending, err
 }
 
 // TransactionSender returns the sender address of the given transaction. The transaction
@@ -136,18 +192,16 @@ func (c *EthClient) TransactionSender(
 	block common.Hash,
 	index uint,
 ) (common.Address, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.TransactionSender(ctxWithTimeout, tx, block, index)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (common.Address, error) {
+		return e.ethClient.TransactionSender(ctx, tx, block, index)
+	})
 }
 
 // TransactionCount returns the total number of transactions in the given block.
 func (c *EthClient) TransactionCount(ctx context.Context, blockHash common.Hash) (uint, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.TransactionCount(ctxWithTimeout, blockHash)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (uint, error) {
+		return e.ethClient.TransactionCount(ctx, blockHash)
+	})
 }
 
 // TransactionInBlock returns a single transaction at index in the given block.
@@ -156,27 +210,24 @@ func (c *EthClient) TransactionInBlock(
 	blockHash common.Hash,
 	index uint,
 ) (*types.Transaction, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.TransactionInBlock(ctxWithTimeout, blockHash, index)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (*types.Transaction, error) {
+		return e.ethClient.TransactionInBlock(ctx, blockHash, index)
+	})
 }
 
 // SyncProgress retrieves the current progress of the sync algorithm. If there's
 // no sync currently running, it returns nil.
 func (c *EthClient) SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.SyncProgress(ctxWithTimeout)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (*ethereum.SyncProgress, error) {
+		return e.ethClient.SyncProgress(ctx)
+	})
 }
 
 // NetworkID returns the network ID for this client.
 func (c *EthClient) NetworkID(ctx context.Context) (*big.Int, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.NetworkID(ctxWithTimeout)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (*big.Int, error) {
+		return e.ethClient.NetworkID(ctx)
+	})
 }
 
 // BalanceAt returns the wei balance of the given account.
@@ -186,10 +237,9 @@ func (c *EthClient) BalanceAt(
 	account common.Address,
 	blockNumber *big.Int,
 ) (*big.Int, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.BalanceAt(ctxWithTimeout, account, blockNumber)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (*big.Int, error) {
+		return e.ethClient.BalanceAt(ctx, account, blockNumber)
+	})
 }
 
 // StorageAt returns the value of key in the contract storage of the given account.
@@ -200,10 +250,9 @@ func (c *EthClient) StorageAt(
 	key common.Hash,
 	blockNumber *big.Int,
 ) ([]byte, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.StorageAt(ctxWithTimeout, account, key, blockNumber)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) ([]byte, error) {
+		return e.ethClient.StorageAt(ctx, account, key, blockNumber)
+	})
 }
 
 // CodeAt returns the contract code of the given account.
@@ -213,10 +262,9 @@ func (c *EthClient) CodeAt(
 	account common.Address,
 	blockNumber *big.Int,
 ) ([]byte, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.CodeAt(ctxWithTimeout, account, blockNumber)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) ([]byte, error) {
+		return e.ethClient.CodeAt(ctx, account, blockNumber)
+	})
 }
 
 // NonceAt returns the account nonce of the given account.
@@ -226,18 +274,16 @@ func (c *EthClient) NonceAt(
 	account common.Address,
 	blockNumber *big.Int,
 ) (uint64, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.NonceAt(ctxWithTimeout, account, blockNumber)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (uint64, error) {
+		return e.ethClient.NonceAt(ctx, account, blockNumber)
+	})
 }
 
 // PendingBalanceAt returns the wei balance of the given account in the pending state.
 func (c *EthClient) PendingBalanceAt(ctx context.Context, account common.Address) (*big.Int, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.PendingBalanceAt(ctxWithTimeout, account)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (*big.Int, error) {
+		return e.ethClient.PendingBalanceAt(ctx, account)
+	})
 }
 
 // PendingStorageAt returns the value of key in the contract storage of the given account in the pending state.
@@ -246,35 +292,31 @@ func (c *EthClient) PendingStorageAt(
 	account common.Address,
 	key common.Hash,
 ) ([]byte, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.PendingStorageAt(ctxWithTimeout, account, key)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) ([]byte, error) {
+		return e.ethClient.PendingStorageAt(ctx, account, key)
+	})
 }
 
 // PendingCodeAt returns the contract code of the given account in the pending state.
 func (c *EthClient) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.PendingCodeAt(ctxWithTimeout, account)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) ([]byte, error) {
+		return e.ethClient.PendingCodeAt(ctx, account)
+	})
 }
 
 // PendingNonceAt returns the account nonce of the given account in the pending state.
 // This is the nonce that should be used for the next transaction.
 func (c *EthClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.PendingNonceAt(ctxWithTimeout, account)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (uint64, error) {
+		return e.ethClient.PendingNonceAt(ctx, account)
+	})
 }
 
 // PendingTransactionCount returns the total number of transactions in the pending state.
 func (c *EthClient) PendingTransactionCount(ctx context.Context) (uint, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.PendingTransactionCount(ctxWithTimeout)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (uint, error) {
+		return e.ethClient.PendingTransactionCount(ctx)
+	})
 }
 
 // CallContract executes a message call transaction, which is directly executed in the VM
@@ -288,10 +330,9 @@ func (c *EthClient) CallContract(
 	msg ethereum.CallMsg,
 	blockNumber *big.Int,
 ) ([]byte, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.CallContract(ctxWithTimeout, msg, blockNumber)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) ([]byte, error) {
+		return e.ethClient.CallContract(ctx, msg, blockNumber)
+	})
 }
 
 // CallContractAtHash is almost the same as CallContract except that it selects
@@ -301,37 +342,33 @@ func (c *EthClient) CallContractAtHash(
 	msg ethereum.CallMsg,
 	blockHash common.Hash,
 ) ([]byte, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.CallContractAtHash(ctxWithTimeout, msg, blockHash)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) ([]byte, error) {
+		return e.ethClient.CallContractAtHash(ctx, msg, blockHash)
+	})
 }
 
 // PendingCallContract executes a message call transaction using the EVM.
 // The state seen by the contract call is the pending state.
 func (c *EthClient) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.PendingCallContract(ctxWithTimeout, msg)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) ([]byte, error) {
+		return e.ethClient.PendingCallContract(ctx, msg)
+	})
 }
 
 // SuggestGasPrice retrieves the currently suggested gas price to allow a timely
 // execution of a transaction.
 func (c *EthClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.SuggestGasPrice(ctxWithTimeout)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (*big.Int, error) {
+		return e.ethClient.SuggestGasPrice(ctx)
+	})
 }
 
 // SuggestGasTipCap retrieves the currently suggested gas tip cap after 1559 to
 // allow a timely execution of a transaction.
 func (c *EthClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.SuggestGasTipCap(ctxWithTimeout)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (*big.Int, error) {
+		return e.ethClient.SuggestGasTipCap(ctx)
+	})
 }
 
 // FeeHistory retrieves the fee market history.
@@ -341,10 +378,9 @@ func (c *EthClient) FeeHistory(
 	lastBlock *big.Int,
 	rewardPercentiles []float64,
 ) (*ethereum.FeeHistory, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.FeeHistory(ctxWithTimeout, blockCount, lastBlock, rewardPercentiles)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (*ethereum.FeeHistory, error) {
+		return e.ethClient.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
+	})
 }
 
 // EstimateGas tries to estimate the gas needed to execute a specific transaction based on
@@ -352,10 +388,9 @@ func (c *EthClient) FeeHistory(
 // the true gas limit requirement as other transactions may be added or removed by miners,
 // but it should provide a basis for setting a reasonable default.
 func (c *EthClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
-
-	return c.ethClient.EstimateGas(ctxWithTimeout, msg)
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (uint64, error) {
+		return e.ethClient.EstimateGas(ctx, msg)
+	})
 }
 
 // SendTransaction injects a signed transaction into the pending pool for execution.
@@ -363,8 +398,93 @@ func (c *EthClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint
 // If the transaction was a contract creation use the TransactionReceipt method to get the
 // contract address after the transaction has been mined.
 func (c *EthClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
-	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
-	defer cancel()
+	return c.failover(ctx, func(ctx context.Context, e *endpoint) error {
+		return e.ethClient.SendTransaction(ctx, tx)
+	})
+}
+
+// TransactionReceipt returns the receipt of a transaction by transaction hash.
+// Note that the receipt is not available for pending transactions.
+func (c *EthClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (*types.Receipt, error) {
+		return e.ethClient.TransactionReceipt(ctx, txHash)
+	})
+}
+
+// FilterLogs executes a filter query.
+func (c *EthClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) ([]types.Log, error) {
+		return e.ethClient.FilterLogs(ctx, q)
+	})
+}
+
+// SubscribeFilterLogs subscribes to the results of a streaming filter query, with the best
+// endpoint which supports subscriptions.
+func (c *EthClient) SubscribeFilterLogs(
+	ctx context.Context,
+	q ethereum.FilterQuery,
+	ch chan<- types.Log,
+) (ethereum.Subscription, error) {
+	return c.subscribe(ctx, func(ctx context.Context, e *endpoint) (ethereum.Subscription, error) {
+		return e.ethClient.SubscribeFilterLogs(ctx, q, ch)
+	})
+}
+
+// SubscribeNewHead subscribes to notifications about the current blockchain head, with the best
+// endpoint which supports subscriptions.
+func (c *EthClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	return c.subscribe(ctx, func(ctx context.Context, e *endpoint) (ethereum.Subscription, error) {
+		return e.ethClient.SubscribeNewHead(ctx, ch)
+	})
+}
+
+// CallContext performs a JSON-RPC call with the given arguments.
+func (c *EthClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	return c.failover(ctx, func(ctx context.Context, e *endpoint) error {
+		return e.client.CallContext(ctx, result, method, args...)
+	})
+}
+
+// BatchCallContext sends all given requests as a single batch and waits for the server
+// to return a response for all of them.
+func (c *EthClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	return c.failover(ctx, func(ctx context.Context, e *endpoint) error {
+		return e.client.BatchCallContext(ctx, b)
+	})
+}
+
+// HeadL1Origin returns the latest L2 block's corresponding L1 origin.
+func (c *EthClient) HeadL1Origin(ctx context.Context) (*rawdb.L1Origin, error) {
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (*rawdb.L1Origin, error) {
+		return e.ethClient.HeadL1Origin(ctx)
+	})
+}
+
+// L1OriginByID returns the L2 block's corresponding L1 origin.
+func (c *EthClient) L1OriginByID(ctx context.Context, blockID *big.Int) (*rawdb.L1Origin, error) {
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (*rawdb.L1Origin, error) {
+		return e.ethClient.L1OriginByID(ctx, blockID)
+	})
+}
 
-	return c.ethClient.SendTransaction(ctxWithTimeout, tx)
+// GetProof returns the account and storage values of the specified account including the Merkle-proof.
+// The block number can be nil, in which case the value is taken from the latest known block.
+func (c *EthClient) GetProof(
+	ctx context.Context,
+	account common.Address,
+	keys []string,
+	blockNumber *big.Int,
+) (*gethclient.AccountResult, error) {
+	return callWithFailover(ctx, c, func(ctx context.Context, e *endpoint) (*gethclient.AccountResult, error) {
+		return e.gethClient.GetProof(ctx, account, keys, blockNumber)
+	})
+}
+
+// SetHead sets the current head of the local chain by block number.
+// Note, this is a destructive action and may severely damage your chain.
+// Use with extreme caution.
+func (c *EthClient) SetHead(ctx context.Context, number *big.Int) error {
+	return c.failover(ctx, func(ctx context.Context, e *endpoint) error {
+		return e.gethClient.SetHead(ctx, number)
+	})
 }
@@ -0,0 +1,276 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	errSubscriptionSwitched = errors.New("subscription endpoint switched")
+)
+
+// healthCheckLoop checks the health of all endpoints periodically.
+func (c *EthClient) healthCheckLoop(ctx context.Context) {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.healthCfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.checkHealth(ctx)
+		}
+	}
+}
+
+// checkHealth fetches the latest block number of all endpoints, and then updates the best endpoints.
+func (c *EthClient) checkHealth(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, e := range c.endpoints {
+		wg.Add(1)
+		go func(e *endpoint) {
+			defer wg.Done()
+
+			ctxWithTimeout, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+
+			start := time.Now()
+			head, err := e.ethClient.BlockNumber(ctxWithTimeout)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				log.Warn("RPC endpoint health check failed", "endpoint", e.name, "error", err)
+			}
+
+			e.recordCheck(head, time.Since(start), err)
+		}(e)
+	}
+	wg.Wait()
+
+	var maxHead uint64
+	for _, e := range c.endpoints {
+		if head := e.getHead(); head > maxHead {
+			maxHead = head
+		}
+	}
+
+	c.mutex.Lock()
+	c.maxHead = maxHead
+	c.mutex.Unlock()
+
+	c.updateBest()
+}
+
+// updateBest updates the best endpoints, and notifies the subscriptions if the best endpoint
+// which supports subscriptions changed.
+func (c *EthClient) updateBest() {
+	var (
+		best    = c.orderedEndpoints(false)[0]
+		bestSub *endpoint
+	)
+	if endpoints := c.orderedEndpoints(true); len(endpoints) != 0 {
+		bestSub = endpoints[0]
+	}
+
+	c.mutex.Lock()
+	prevBest, prevBestSub := c.best, c.bestSub
+	c.best, c.bestSub = best, bestSub
+	c.mutex.Unlock()
+
+	if best != prevBest {
+		log.Info("Switch to a new RPC endpoint", "previous", prevBest.name, "current", best.name)
+	}
+	if bestSub != nil && bestSub != prevBestSub {
+		c.bestFeed.Send(bestSub)
+	}
+}
+
+// orderedEndpoints returns the endpoints in the order of preference, the healthy ones come first and
+// then the unhealthy ones, both in the configured order. If subscription is true, only the endpoints
+// which support subscriptions will be returned.
+func (c *EthClient) orderedEndpoints(subscription bool) []*endpoint {
+	c.mutex.RLock()
+	maxHead := c.maxHead
+	c.mutex.RUnlock()
+
+	var healthy, unhealthy []*endpoint
+	for _, e := range c.endpoints {
+		if subscription && !e.client.SupportsSubscriptions() {
+			continue
+		}
+		if e.isHealthy(maxHead, c.healthCfg) {
+			healthy = append(healthy, e)
+		} else {
+			unhealthy = append(unhealthy, e)
+		}
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+// failover calls the given function with the endpoints in the order of preference, until there is
+// no endpoint error.
+func (c *EthClient) failover(ctx context.Context, call func(ctx context.Context, e *endpoint) error) error {
+	return c.failoverWith(ctx, c.orderedEndpoints(false), call)
+}
+
+// failoverWith calls the given function with the given endpoints in order, until there is
+// no endpoint error.
+func (c *EthClient) failoverWith(
+	ctx context.Context,
+	endpoints []*endpoint,
+	call func(ctx context.Context, e *endpoint) error,
+) error {
+	var err error
+	for i, e := range endpoints {
+		if err = c.callEndpoint(ctx, e, call); !isEndpointError(ctx, err) {
+			return err
+		}
+
+		if i < len(endpoints)-1 {
+			log.Warn("RPC endpoint call failed, fail over to the next endpoint", "endpoint", e.name, "error", err)
+			c.failoversCounter.Inc(1)
+		}
+	}
+
+	return err
+}
+
+// callEndpoint calls the given function with the given endpoint, and records the call result.
+func (c *EthClient) callEndpoint(
+	ctx context.Context,
+	e *endpoint,
+	call func(ctx context.Context, e *endpoint) error,
+) error {
+	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := call(ctxWithTimeout, e)
+	if isEndpointError(ctx, err) {
+		e.recordCall(time.Since(start), err)
+	} else {
+		e.recordCall(time.Since(start), nil)
+	}
+
+	return err
+}
+
+// callWithFailover calls the given function with failover, and returns its result.
+func callWithFailover[T any](
+	ctx context.Context,
+	c *EthClient,
+	call func(ctx context.Context, e *endpoint) (T, error),
+) (T, error) {
+	var result T
+	err := c.failover(ctx, func(ctx context.Context, e *endpoint) (err error) {
+		result, err = call(ctx, e)
+		return err
+	})
+
+	return result, err
+}
+
+// subscribe creates a subscription with the best endpoint which supports subscriptions, the
+// subscription will be terminated with an error once a better endpoint is available, so that
+// the resubscriptions will move to that endpoint.
+func (c *EthClient) subscribe(
+	ctx context.Context,
+	subscribe func(ctx context.Context, e *endpoint) (ethereum.Subscription, error),
+) (ethereum.Subscription, error) {
+	// If no endpoint supports subscriptions, let the primary endpoint return the error.
+	endpoints := c.orderedEndpoints(true)
+	if len(endpoints) == 0 {
+		endpoints = c.endpoints[:1]
+	}
+
+	var (
+		sub     ethereum.Subscription
+		current *endpoint
+	)
+	if err := c.failoverWith(ctx, endpoints, func(ctx context.Context, e *endpoint) (err error) {
+		current = e
+		sub, err = subscribe(ctx, e)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	if len(c.endpoints) == 1 {
+		return sub, nil
+	}
+
+	return newEndpointSubscription(c, current, sub), nil
+}
+
+// endpointSubscription wraps a subscription of an endpoint, which will be terminated with
+// an error when the best endpoint which supports subscriptions changed.
+type endpointSubscription struct {
+	sub      ethereum.Subscription
+	err      chan error
+	quit     chan struct{}
+	done     chan struct{}
+	quitOnce sync.Once
+}
+
+// newEndpointSubscription creates a new endpointSubscription instance.
+func newEndpointSubscription(c *EthClient, e *endpoint, sub ethereum.Subscription) *endpointSubscription {
+	s := &endpointSubscription{
+		sub:  sub,
+		err:  make(chan error, 1),
+		quit: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+
+	bestCh := make(chan *endpoint, 1)
+	bestSub := c.bestFeed.Subscribe(bestCh)
+
+	go func() {
+		defer close(s.done)
+		defer close(s.err)
+		defer bestSub.Unsubscribe()
+
+		for {
+			select {
+			case err := <-sub.Err():
+				if err != nil {
+					s.err <- err
+				}
+				return
+			case best := <-bestCh:
+				if best == e {
+					continue
+				}
+				log.Info("Move subscription to a new RPC endpoint", "previous", e.name, "current", best.name)
+				sub.Unsubscribe()
+				s.err <- errSubscriptionSwitched
+				return
+			case <-s.quit:
+				sub.Unsubscribe()
+				return
+			}
+		}
+	}()
+
+	return s
+}
+
+// Err implements the ethereum.Subscription interface.
+func (s *endpointSubscription) Err() <-chan error {
+	return s.err
+}
+
+// Unsubscribe implements the ethereum.Subscription interface.
+func (s *endpointSubscription) Unsubscribe() {
+	s.quitOnce.Do(func() { close(s.quit) })
+	<-s.done
+}
@@ -0,0 +1,144 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// testEthService is a mock of the `eth` namespace of an ethereum node.
+type testEthService struct {
+	head    atomic.Uint64
+	reverts atomic.Bool
+}
+
+func (s *testEthService) BlockNumber() (hexutil.Uint64, error) {
+	if s.reverts.Load() {
+		return 0, errors.New("execution reverted")
+	}
+	return hexutil.Uint64(s.head.Load()), nil
+}
+
+func (s *testEthService) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, ok := rpc.NotifierFromContext(ctx)
+	if !ok {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	return notifier.CreateSubscription(), nil
+}
+
+func newTestEthServer(t *testing.T, head uint64, ws bool) (*testEthService, *httptest.Server, string) {
+	service := &testEthService{}
+	service.head.Store(head)
+
+	rpcServer := rpc.NewServer()
+	require.Nil(t, rpcServer.RegisterName("eth", service))
+	t.Cleanup(rpcServer.Stop)
+
+	if ws {
+		server := httptest.NewServer(rpcServer.WebsocketHandler([]string{"*"}))
+		t.Cleanup(server.Close)
+		return service, server, "ws" + strings.TrimPrefix(server.URL, "http")
+	}
+
+	server := httptest.NewServer(rpcServer)
+	t.Cleanup(server.Close)
+	return service, server, server.URL
+}
+
+func newTestFailoverEthClient(t *testing.T, urls ...string) *EthClient {
+	client, err := NewFailoverEthClient(
+		context.Background(),
+		t.Name(),
+		urls,
+		time.Second,
+		&HealthCheckConfig{Interval: time.Hour, MaxHeadLag: 3, MaxErrorRate: 0.5},
+	)
+	require.Nil(t, err)
+	t.Cleanup(client.Close)
+
+	return client
+}
+
+func TestFailoverOnEndpointError(t *testing.T) {
+	_, primary, primaryURL := newTestEthServer(t, 10, false)
+	_, _, fallbackURL := newTestEthServer(t, 10, false)
+
+	client := newTestFailoverEthClient(t, primaryURL, fallbackURL)
+	require.Equal(t, client.endpoints[0], client.orderedEndpoints(false)[0])
+
+	primary.Close()
+
+	head, err := client.BlockNumber(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, uint64(10), head)
+}
+
+func TestNoFailoverOnRPCError(t *testing.T) {
+	service, _, primaryURL := newTestEthServer(t, 10, false)
+	_, _, fallbackURL := newTestEthServer(t, 10, false)
+
+	client := newTestFailoverEthClient(t, primaryURL, fallbackURL)
+	service.reverts.Store(true)
+
+	_, err := client.BlockNumber(context.Background())
+	require.ErrorContains(t, err, "execution reverted")
+}
+
+func TestFailoverOnHeadLag(t *testing.T) {
+	service, _, primaryURL := newTestEthServer(t, 10, false)
+	_, _, fallbackURL := newTestEthServer(t, 10, false)
+
+	client := newTestFailoverEthClient(t, primaryURL, fallbackURL)
+	require.Equal(t, client.endpoints[0], client.best)
+
+	service.head.Store(5)
+	client.checkHealth(context.Background())
+	require.Equal(t, client.endpoints[1], client.best)
+
+	head, err := client.BlockNumber(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, uint64(10), head)
+
+	service.head.Store(10)
+	client.checkHealth(context.Background())
+	require.Equal(t, client.endpoints[0], client.best)
+}
+
+func TestSubscriptionSwitchEndpoint(t *testing.T) {
+	service, _, primaryURL := newTestEthServer(t, 10, true)
+	_, _, httpURL := newTestEthServer(t, 10, false)
+	_, _, fallbackURL := newTestEthServer(t, 10, true)
+
+	client := newTestFailoverEthClient(t, primaryURL, httpURL, fallbackURL)
+	require.Equal(t, client.endpoints[0], client.bestSub)
+
+	sub, err := client.subscribe(
+		context.Background(),
+		func(ctx context.Context, e *endpoint) (ethereum.Subscription, error) {
+			return e.client.EthSubscribe(ctx, make(chan interface{}), "newHeads")
+		},
+	)
+	require.Nil(t, err)
+	defer sub.Unsubscribe()
+
+	service.head.Store(5)
+	client.checkHealth(context.Background())
+	require.Equal(t, client.endpoints[2], client.bestSub)
+
+	select {
+	case err := <-sub.Err():
+		require.ErrorIs(t, err, errSubscriptionSwitched)
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription not switched")
+	}
+}
@@ -755,3 +755,47 @@ func (c *Client) GetTiers(ctx context.Context) ([]*TierProviderTierWithID, error
 
 	return tiers, nil
 }
+
+// L1FeeHistory is the L1 fee market history, including the blob base fees if they are supported
+// by the L1 node, both the fee lists contain the fees of the next block as their last elements.
+type L1FeeHistory struct {
+	OldestBlock *big.Int
+	BaseFee     []*big.Int
+	BlobBaseFee []*big.Int
+}
+
+// GetL1FeeHistory fetches the L1 base fees and blob base fees of the latest given number of blocks,
+// since `ethereum.FeeHistory` doesn't support blob base fees yet, we call eth_feeHistory directly here.
+func (c *Client) GetL1FeeHistory(ctx context.Context, blockCount uint64) (*L1FeeHistory, error) {
+	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, defaultTimeout)
+	defer cancel()
+
+	var result struct {
+		OldestBlock *hexutil.Big   `json:"oldestBlock"`
+		BaseFee     []*hexutil.Big `json:"baseFeePerGas"`
+		BlobBaseFee []*hexutil.Big `json:"baseFeePerBlobGas"`
+	}
+	if err := c.L1.CallContext(
+		ctxWithTimeout,
+		&result,
+		"eth_feeHistory",
+		hexutil.Uint(blockCount),
+		"latest",
+		[]float64{},
+	); err != nil {
+		return nil, err
+	}
+
+	history := &L1FeeHistory{
+		OldestBlock: (*big.Int)(result.OldestBlock),
+		BaseFee:     make([]*big.Int, len(result.BaseFee)),
+	}
+	for i, fee := range result.BaseFee {
+		history.BaseFee[i] = (*big.Int)(fee)
+	}
+	for _, fee := range result.BlobBaseFee {
+		history.BlobBaseFee = append(history.BlobBaseFee, (*big.Int)(fee))
+	}
+
+	return history, nil
+}
@@ -118,6 +118,78 @@ func SubscribeTransitionContested(
 	})
 }
 
+// SubscribeTokenDeposited subscribes the protocol's TokenDeposited events.
+func SubscribeTokenDeposited(
+	taikoL1 *bindings.TaikoL1Client,
+	ch chan *bindings.TaikoL1ClientTokenDeposited,
+) event.Subscription {
+	return SubscribeEvent("TokenDeposited", func(ctx context.Context) (event.Subscription, error) {
+		sub, err := taikoL1.WatchTokenDeposited(nil, ch)
+		if err != nil {
+			log.Error("Create TaikoL1.TokenDeposited subscription error", "error", err)
+			return nil, err
+		}
+
+		defer sub.Unsubscribe()
+
+		return waitSubErr(ctx, sub)
+	})
+}
+
+// SubscribeTokenWithdrawn subscribes the protocol's TokenWithdrawn events.
+func SubscribeTokenWithdrawn(
+	taikoL1 *bindings.TaikoL1Client,
+	ch chan *bindings.TaikoL1ClientTokenWithdrawn,
+) event.Subscription {
+	return SubscribeEvent("TokenWithdrawn", func(ctx context.Context) (event.Subscription, error) {
+		sub, err := taikoL1.WatchTokenWithdrawn(nil, ch)
+		if err != nil {
+			log.Error("Create TaikoL1.TokenWithdrawn subscription error", "error", err)
+			return nil, err
+		}
+
+		defer sub.Unsubscribe()
+
+		return waitSubErr(ctx, sub)
+	})
+}
+
+// SubscribeTokenCredited subscribes the protocol's TokenCredited events.
+func SubscribeTokenCredited(
+	taikoL1 *bindings.TaikoL1Client,
+	ch chan *bindings.TaikoL1ClientTokenCredited,
+) event.Subscription {
+	return SubscribeEvent("TokenCredited", func(ctx context.Context) (event.Subscription, error) {
+		sub, err := taikoL1.WatchTokenCredited(nil, ch)
+		if err != nil {
+			log.Error("Create TaikoL1.TokenCredited subscription error", "error", err)
+			return nil, err
+		}
+
+		defer sub.Unsubscribe()
+
+		return waitSubErr(ctx, sub)
+	})
+}
+
+// SubscribeTokenDebited subscribes the protocol's TokenDebited events.
+func SubscribeTokenDebited(
+	taikoL1 *bindings.TaikoL1Client,
+	ch chan *bindings.TaikoL1ClientTokenDebited,
+) event.Subscription {
+	return SubscribeEvent("TokenDebited", func(ctx context.Context) (event.Subscription, error) {
+		sub, err := taikoL1.WatchTokenDebited(nil, ch)
+		if err != nil {
+			log.Error("Create TaikoL1.TokenDebited subscription error", "error", err)
+			return nil, err
+		}
+
+		defer sub.Unsubscribe()
+
+		return waitSubErr(ctx, sub)
+	})
+}
+
 // SubscribeChainHead subscribes the new chain heads.
 func SubscribeChainHead(
 	client *EthClient,
@@ -0,0 +1,56 @@
+package signer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalSignerSignMessage(t *testing.T) {
+	s := NewLocalSigner(testKey)
+
+	msg := []byte("HEART_BEAT")
+	sig, err := s.SignMessage(context.Background(), msg)
+	require.Nil(t, err)
+
+	pubKey, err := crypto.SigToPub(crypto.Keccak256(msg), sig)
+	require.Nil(t, err)
+	require.Equal(t, testAddr, crypto.PubkeyToAddress(*pubKey))
+}
+
+func TestNewKeystoreSigner(t *testing.T) {
+	var (
+		dir          = t.TempDir()
+		keystorePath = filepath.Join(dir, "keystore.json")
+		passwordFile = filepath.Join(dir, "password")
+		password     = "password"
+	)
+
+	keyJSON, err := keystore.EncryptKey(
+		&keystore.Key{Address: testAddr, PrivateKey: testKey},
+		password,
+		keystore.LightScryptN,
+		keystore.LightScryptP,
+	)
+	require.Nil(t, err)
+	require.Nil(t, os.WriteFile(keystorePath, keyJSON, 0o600))
+	require.Nil(t, os.WriteFile(passwordFile, []byte(password+"\n"), 0o600))
+
+	s, err := NewKeystoreSigner(keystorePath, passwordFile)
+	require.Nil(t, err)
+	require.Equal(t, testAddr, s.Address())
+
+	// Wrong password
+	require.Nil(t, os.WriteFile(passwordFile, []byte("wrong"), 0o600))
+	_, err = NewKeystoreSigner(keystorePath, passwordFile)
+	require.NotNil(t, err)
+
+	// Keystore not exist
+	_, err = NewKeystoreSigner(filepath.Join(dir, "notExist"), passwordFile)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,276 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/holiman/uint256"
+)
+
+// Web3Signer eth1 API routes.
+const (
+	web3SignerPublicKeysRoute = "/api/v1/eth1/publicKeys"
+	web3SignerSignRoute       = "/api/v1/eth1/sign/%s"
+)
+
+// web3SignerSignReq is the request body of the web3signer eth1 sign API.
+type web3SignerSignReq struct {
+	Data hexutil.Bytes `json:"data"`
+}
+
+// RemoteSigner signs transactions and messages through a remote web3signer, transactions are signed
+// by the `eth_signTransaction` JSON-RPC method, and messages are signed by the eth1 sign API, which
+// signs the keccak256 hash of the given data.
+type RemoteSigner struct {
+	endpoint string
+	address  common.Address
+	client   *http.Client
+	rpc      *gethRPC.Client
+
+	// Web3signer identifier of the signing key, which is the hex encoded public key.
+	mutex      sync.Mutex
+	identifier string
+}
+
+var _ Signer = (*RemoteSigner)(nil)
+
+// NewRemoteSigner creates a new RemoteSigner instance.
+func NewRemoteSigner(endpoint string, address common.Address, timeout time.Duration) (*RemoteSigner, error) {
+	if address == (common.Address{}) {
+		return nil, fmt.Errorf("empty remote signer address")
+	}
+
+	httpClient := &http.Client{Timeout: timeout}
+	endpoint = strings.TrimSuffix(endpoint, "/")
+
+	rpcClient, err := gethRPC.DialOptions(context.Background(), endpoint, gethRPC.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial remote signer: %w", err)
+	}
+
+	return &RemoteSigner{endpoint: endpoint, address: address, client: httpClient, rpc: rpcClient}, nil
+}
+
+// Address implements the Signer interface.
+func (s *RemoteSigner) Address() common.Address {
+	return s.address
+}
+
+// SignTx implements the Signer interface.
+func (s *RemoteSigner) SignTx(
+	ctx context.Context,
+	tx *types.Transaction,
+	chainID *big.Int,
+) (*types.Transaction, error) {
+	var raw hexutil.Bytes
+	if err := s.rpc.CallContext(ctx, &raw, "eth_signTransaction", s.toTxArgs(tx, chainID)); err != nil {
+		return nil, fmt.Errorf("failed to sign transaction remotely: %w", err)
+	}
+
+	signed := new(types.Transaction)
+	if err := signed.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode remotely signed transaction: %w", err)
+	}
+
+	if err := checkSignedTx(tx, signed, chainID, s.address); err != nil {
+		return nil, err
+	}
+
+	// The remote signer doesn't know the blob sidecar, so we attach it back here.
+	if sidecar := tx.BlobTxSidecar(); sidecar != nil {
+		v, r, sig := signed.RawSignatureValues()
+		signed = types.NewTx(&types.BlobTx{
+			ChainID:    uint256.MustFromBig(signed.ChainId()),
+			Nonce:      signed.Nonce(),
+			GasTipCap:  uint256.MustFromBig(signed.GasTipCap()),
+			GasFeeCap:  uint256.MustFromBig(signed.GasFeeCap()),
+			Gas:        signed.Gas(),
+			To:         *signed.To(),
+			Value:      uint256.MustFromBig(signed.Value()),
+			Data:       signed.Data(),
+			AccessList: signed.AccessList(),
+			BlobFeeCap: uint256.MustFromBig(signed.BlobGasFeeCap()),
+			BlobHashes: signed.BlobHashes(),
+			Sidecar:    sidecar,
+			V:          uint256.MustFromBig(v),
+			R:          uint256.MustFromBig(r),
+			S:          uint256.MustFromBig(sig),
+		})
+	}
+
+	return signed, nil
+}
+
+// toTxArgs converts the given transaction to the `eth_signTransaction` arguments.
+func (s *RemoteSigner) toTxArgs(tx *types.Transaction, chainID *big.Int) map[string]interface{} {
+	args := map[string]interface{}{
+		"from":    s.address,
+		"gas":     hexutil.Uint64(tx.Gas()),
+		"nonce":   hexutil.Uint64(tx.Nonce()),
+		"value":   (*hexutil.Big)(tx.Value()),
+		"data":    hexutil.Bytes(tx.Data()),
+		"chainId": (*hexutil.Big)(chainID),
+	}
+	if tx.To() != nil {
+		args["to"] = tx.To()
+	}
+
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args["gasPrice"] = (*hexutil.Big)(tx.GasPrice())
+	default:
+		args["type"] = hexutil.Uint64(tx.Type())
+		args["maxFeePerGas"] = (*hexutil.Big)(tx.GasFeeCap())
+		args["maxPriorityFeePerGas"] = (*hexutil.Big)(tx.GasTipCap())
+		if len(tx.AccessList()) != 0 {
+			args["accessList"] = tx.AccessList()
+		}
+	}
+
+	if tx.Type() == types.BlobTxType {
+		args["maxFeePerBlobGas"] = (*hexutil.Big)(tx.BlobGasFeeCap())
+		args["blobVersionedHashes"] = tx.BlobHashes()
+	}
+
+	return args
+}
+
+// SignMessage implements the Signer interface.
+func (s *RemoteSigner) SignMessage(ctx context.Context, msg []byte) ([]byte, error) {
+	identifier, err := s.getIdentifier(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(&web3SignerSignReq{Data: msg})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.request(ctx, http.MethodPost, fmt.Sprintf(web3SignerSignRoute, identifier), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign message remotely: %w", err)
+	}
+
+	sig, err := hexutil.Decode(strings.Trim(strings.TrimSpace(string(res)), "\""))
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signature: %w", err)
+	}
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid remote signature length: %d", len(sig))
+	}
+	// Web3signer returns the signature with V in {27, 28}.
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(crypto.Keccak256(msg), sig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover remote signature: %w", err)
+	}
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != s.address {
+		return nil, fmt.Errorf("remote signature signer mismatch, expected %s, got %s", s.address, signer)
+	}
+
+	return sig, nil
+}
+
+// getIdentifier finds the web3signer identifier of the signing account.
+func (s *RemoteSigner) getIdentifier(ctx context.Context) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.identifier != "" {
+		return s.identifier, nil
+	}
+
+	res, err := s.request(ctx, http.MethodGet, web3SignerPublicKeysRoute, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch remote signer public keys: %w", err)
+	}
+
+	var publicKeys []string
+	if err := json.Unmarshal(res, &publicKeys); err != nil {
+		return "", fmt.Errorf("invalid remote signer public keys: %w", err)
+	}
+
+	for _, publicKey := range publicKeys {
+		address, err := publicKeyToAddress(publicKey)
+		if err != nil {
+			return "", err
+		}
+		if address == s.address {
+			s.identifier = publicKey
+			return s.identifier, nil
+		}
+	}
+
+	return "", fmt.Errorf("no public key of %s found in remote signer", s.address)
+}
+
+// request sends a HTTP request to the web3signer, and returns the response body.
+func (s *RemoteSigner) request(ctx context.Context, method string, route string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.endpoint+route, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	res, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected remote signer response status code: %d, %s", resp.StatusCode, res)
+	}
+
+	return res, nil
+}
+
+// publicKeyToAddress converts the given hex encoded public key to an address, both the compressed
+// and the uncompressed (with or without the 0x04 prefix) formats are supported.
+func publicKeyToAddress(publicKey string) (common.Address, error) {
+	b, err := hexutil.Decode(publicKey)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid remote signer public key %s: %w", publicKey, err)
+	}
+
+	switch len(b) {
+	case 33:
+		pubKey, err := crypto.DecompressPubkey(b)
+		if err != nil {
+			return common.Address{}, fmt.Errorf("invalid remote signer public key %s: %w", publicKey, err)
+		}
+		return crypto.PubkeyToAddress(*pubKey), nil
+	case 64:
+		b = append([]byte{4}, b...)
+	}
+
+	pubKey, err := crypto.UnmarshalPubkey(b)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid remote signer public key %s: %w", publicKey, err)
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
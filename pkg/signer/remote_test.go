@@ -0,0 +1,209 @@
+package signer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+// testTxArgs is the `eth_signTransaction` arguments received by the mock web3signer.
+type testTxArgs struct {
+	To                   *common.Address `json:"to"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	Value                *hexutil.Big    `json:"value"`
+	Data                 hexutil.Bytes   `json:"data"`
+	ChainID              *hexutil.Big    `json:"chainId"`
+	Type                 hexutil.Uint64  `json:"type"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	MaxFeePerBlobGas     *hexutil.Big    `json:"maxFeePerBlobGas"`
+	BlobVersionedHashes  []common.Hash   `json:"blobVersionedHashes"`
+}
+
+// newTestWeb3Signer starts a mock web3signer, which signs with the test key.
+func newTestWeb3Signer(t *testing.T) *httptest.Server {
+	publicKey := hexutil.Encode(crypto.FromECDSAPub(&testKey.PublicKey)[1:])
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(web3SignerPublicKeysRoute, func(w http.ResponseWriter, r *http.Request) {
+		require.Nil(t, json.NewEncoder(w).Encode([]string{publicKey}))
+	})
+	mux.HandleFunc(fmt.Sprintf(web3SignerSignRoute, publicKey), func(w http.ResponseWriter, r *http.Request) {
+		var req web3SignerSignReq
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+
+		sig, err := crypto.Sign(crypto.Keccak256(req.Data), testKey)
+		require.Nil(t, err)
+		sig[crypto.RecoveryIDOffset] += 27
+
+		_, _ = w.Write([]byte(hexutil.Encode(sig)))
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params []*testTxArgs   `json:"params"`
+		}
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "eth_signTransaction", req.Method)
+
+		args := req.Params[0]
+		var txData types.TxData
+		if args.Type == types.BlobTxType {
+			txData = &types.BlobTx{
+				ChainID:    uint256.MustFromBig(args.ChainID.ToInt()),
+				Nonce:      uint64(args.Nonce),
+				GasTipCap:  uint256.MustFromBig(args.MaxPriorityFeePerGas.ToInt()),
+				GasFeeCap:  uint256.MustFromBig(args.MaxFeePerGas.ToInt()),
+				Gas:        uint64(args.Gas),
+				To:         *args.To,
+				Value:      uint256.MustFromBig(args.Value.ToInt()),
+				Data:       args.Data,
+				BlobFeeCap: uint256.MustFromBig(args.MaxFeePerBlobGas.ToInt()),
+				BlobHashes: args.BlobVersionedHashes,
+			}
+		} else {
+			txData = &types.DynamicFeeTx{
+				ChainID:   args.ChainID.ToInt(),
+				Nonce:     uint64(args.Nonce),
+				GasTipCap: args.MaxPriorityFeePerGas.ToInt(),
+				GasFeeCap: args.MaxFeePerGas.ToInt(),
+				Gas:       uint64(args.Gas),
+				To:        args.To,
+				Value:     args.Value.ToInt(),
+				Data:      args.Data,
+			}
+		}
+
+		signed, err := types.SignNewTx(testKey, types.LatestSignerForChainID(args.ChainID.ToInt()), txData)
+		require.Nil(t, err)
+		raw, err := signed.MarshalBinary()
+		require.Nil(t, err)
+
+		require.Nil(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  hexutil.Bytes(raw),
+		}))
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestRemoteSignerSignMessage(t *testing.T) {
+	server := newTestWeb3Signer(t)
+	defer server.Close()
+
+	s, err := NewRemoteSigner(server.URL, testAddr, 5*time.Second)
+	require.Nil(t, err)
+
+	msg := []byte("HEART_BEAT")
+	sig, err := s.SignMessage(context.Background(), msg)
+	require.Nil(t, err)
+
+	expected, err := NewLocalSigner(testKey).SignMessage(context.Background(), msg)
+	require.Nil(t, err)
+	require.Equal(t, expected, sig)
+
+	// Unknown account
+	s, err = NewRemoteSigner(server.URL, common.HexToAddress("0x01"), 5*time.Second)
+	require.Nil(t, err)
+	_, err = s.SignMessage(context.Background(), msg)
+	require.NotNil(t, err)
+}
+
+func TestRemoteSignerSignTx(t *testing.T) {
+	server := newTestWeb3Signer(t)
+	defer server.Close()
+
+	s, err := NewRemoteSigner(server.URL, testAddr, 5*time.Second)
+	require.Nil(t, err)
+
+	to := common.HexToAddress("0x02")
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   testChain,
+		Nonce:     1,
+		GasTipCap: common.Big1,
+		GasFeeCap: common.Big2,
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(100),
+		Data:      []byte{1, 2, 3},
+	})
+
+	signed, err := s.SignTx(context.Background(), tx, testChain)
+	require.Nil(t, err)
+
+	expected, err := NewLocalSigner(testKey).SignTx(context.Background(), tx, testChain)
+	require.Nil(t, err)
+	require.Equal(t, expected.Hash(), signed.Hash())
+}
+
+func TestRemoteSignerSignBlobTx(t *testing.T) {
+	server := newTestWeb3Signer(t)
+	defer server.Close()
+
+	s, err := NewRemoteSigner(server.URL, testAddr, 5*time.Second)
+	require.Nil(t, err)
+
+	var blob kzg4844.Blob
+	commitment, err := kzg4844.BlobToCommitment(blob)
+	require.Nil(t, err)
+	proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+	require.Nil(t, err)
+	sidecar := &types.BlobTxSidecar{
+		Blobs:       []kzg4844.Blob{blob},
+		Commitments: []kzg4844.Commitment{commitment},
+		Proofs:      []kzg4844.Proof{proof},
+	}
+
+	tx := types.NewTx(&types.BlobTx{
+		ChainID:    uint256.MustFromBig(testChain),
+		Nonce:      1,
+		GasTipCap:  uint256.NewInt(1),
+		GasFeeCap:  uint256.NewInt(2),
+		Gas:        21000,
+		To:         common.HexToAddress("0x02"),
+		Value:      uint256.NewInt(0),
+		BlobFeeCap: uint256.NewInt(3),
+		BlobHashes: sidecar.BlobHashes(),
+		Sidecar:    sidecar,
+	})
+
+	signed, err := s.SignTx(context.Background(), tx, testChain)
+	require.Nil(t, err)
+	require.NotNil(t, signed.BlobTxSidecar())
+
+	expected, err := NewLocalSigner(testKey).SignTx(context.Background(), tx, testChain)
+	require.Nil(t, err)
+	require.Equal(t, expected.Hash(), signed.Hash())
+}
+
+func TestPublicKeyToAddress(t *testing.T) {
+	for _, publicKey := range [][]byte{
+		crypto.FromECDSAPub(&testKey.PublicKey),
+		crypto.FromECDSAPub(&testKey.PublicKey)[1:],
+		crypto.CompressPubkey(&testKey.PublicKey),
+	} {
+		address, err := publicKeyToAddress(hexutil.Encode(publicKey))
+		require.Nil(t, err)
+		require.Equal(t, testAddr, address)
+	}
+
+	_, err := publicKeyToAddress("0x01")
+	require.NotNil(t, err)
+}
@@ -0,0 +1,107 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	errNoSigner       = errors.New("no signer configured, a private key, a keystore or a remote signer is required")
+	errMultipleSigner = errors.New("only one of the private key, the keystore and the remote signer can be configured")
+)
+
+// Signer signs transactions and messages on behalf of a L1 account.
+type Signer interface {
+	// Address returns the address of the signing account.
+	Address() common.Address
+	// SignTx signs the given transaction with the latest signer of the given chain ID.
+	SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	// SignMessage signs the keccak256 hash of the given message, and returns the signature
+	// in the [R || S || V] format, where V is 0 or 1, same as `crypto.Sign`.
+	SignMessage(ctx context.Context, msg []byte) ([]byte, error)
+}
+
+// Config contains the configurations to create a signer, only one of the private key, the keystore
+// and the remote signer can be set.
+type Config struct {
+	PrivateKey     *ecdsa.PrivateKey
+	KeystorePath   string
+	PasswordFile   string
+	RemoteEndpoint string
+	RemoteAddress  common.Address
+	Timeout        time.Duration
+}
+
+// New creates a new signer based on the given configurations.
+func New(cfg *Config) (Signer, error) {
+	var configured int
+	for _, set := range []bool{cfg.PrivateKey != nil, cfg.KeystorePath != "", cfg.RemoteEndpoint != ""} {
+		if set {
+			configured++
+		}
+	}
+	if configured == 0 {
+		return nil, errNoSigner
+	}
+	if configured > 1 {
+		return nil, errMultipleSigner
+	}
+
+	switch {
+	case cfg.PrivateKey != nil:
+		return NewLocalSigner(cfg.PrivateKey), nil
+	case cfg.KeystorePath != "":
+		return NewKeystoreSigner(cfg.KeystorePath, cfg.PasswordFile)
+	default:
+		return NewRemoteSigner(cfg.RemoteEndpoint, cfg.RemoteAddress, cfg.Timeout)
+	}
+}
+
+// NewTransactor creates a bind.TransactOpts instance, which signs the transactions with the given signer.
+func NewTransactor(ctx context.Context, s Signer, chainID *big.Int) (*bind.TransactOpts, error) {
+	if chainID == nil {
+		return nil, bind.ErrNoChainID
+	}
+
+	return &bind.TransactOpts{
+		From: s.Address(),
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != s.Address() {
+				return nil, bind.ErrNotAuthorized
+			}
+			return s.SignTx(ctx, tx, chainID)
+		},
+		Context: ctx,
+	}, nil
+}
+
+// checkSignedTx checks whether the given signed transaction is signed by the expected account,
+// and has the same content as the original one.
+func checkSignedTx(
+	tx *types.Transaction,
+	signed *types.Transaction,
+	chainID *big.Int,
+	address common.Address,
+) error {
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+	if err != nil {
+		return fmt.Errorf("failed to recover signed transaction sender: %w", err)
+	}
+	if sender != address {
+		return fmt.Errorf("signed transaction sender mismatch, expected %s, got %s", address, sender)
+	}
+
+	if types.LatestSignerForChainID(chainID).Hash(tx) != types.LatestSignerForChainID(chainID).Hash(signed) {
+		return fmt.Errorf("signed transaction content mismatch, tx %s", signed.Hash())
+	}
+
+	return nil
+}
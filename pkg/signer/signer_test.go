@@ -0,0 +1,56 @@
+package signer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testKey, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	testAddr   = crypto.PubkeyToAddress(testKey.PublicKey)
+	testChain  = common.Big32
+)
+
+func TestNew(t *testing.T) {
+	_, err := New(&Config{})
+	require.ErrorIs(t, err, errNoSigner)
+
+	_, err = New(&Config{PrivateKey: testKey, RemoteEndpoint: "http://localhost:9000"})
+	require.ErrorIs(t, err, errMultipleSigner)
+
+	s, err := New(&Config{PrivateKey: testKey})
+	require.Nil(t, err)
+	require.Equal(t, testAddr, s.Address())
+
+	_, err = New(&Config{RemoteEndpoint: "http://localhost:9000"})
+	require.NotNil(t, err)
+
+	s, err = New(&Config{RemoteEndpoint: "http://localhost:9000", RemoteAddress: testAddr})
+	require.Nil(t, err)
+	require.Equal(t, testAddr, s.Address())
+}
+
+func TestNewTransactor(t *testing.T) {
+	opts, err := NewTransactor(context.Background(), NewLocalSigner(testKey), testChain)
+	require.Nil(t, err)
+	require.Equal(t, testAddr, opts.From)
+
+	tx := types.NewTx(&types.DynamicFeeTx{ChainID: testChain, Nonce: 1, Gas: 21000})
+	signed, err := opts.Signer(testAddr, tx)
+	require.Nil(t, err)
+
+	sender, err := types.Sender(types.LatestSignerForChainID(testChain), signed)
+	require.Nil(t, err)
+	require.Equal(t, testAddr, sender)
+
+	_, err = opts.Signer(common.HexToAddress("0x01"), tx)
+	require.NotNil(t, err)
+
+	_, err = NewTransactor(context.Background(), NewLocalSigner(testKey), nil)
+	require.NotNil(t, err)
+}
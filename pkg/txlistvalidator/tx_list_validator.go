@@ -1,8 +1,11 @@
 package txlistvalidator
 
 import (
+	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -10,6 +13,10 @@ import (
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
 )
 
+// DefaultMaxTransactionsPerBlock is the maximum number of transactions in a L2 block,
+// Brecht recommends to hardcore 79, may be unrequired as proof system changes.
+const DefaultMaxTransactionsPerBlock = uint64(79)
+
 // InvalidTxListReason represents a reason why a transactions list is invalid.
 type InvalidTxListReason uint8
 
@@ -17,13 +24,91 @@ type InvalidTxListReason uint8
 const (
 	HintNone InvalidTxListReason = iota
 	HintOK
+	HintBinaryTooLarge
+	HintBinaryNotDecodable
+	HintTooManyTxs
+	HintBlobNotDecodable
+	HintBlobTxListOutOfRange
+	HintBlockGasLimitTooLarge
+	HintTxTypeNotAllowed
+	HintTxInvalidChainID
+	HintTxInvalidSig
+	HintTxGasLimitTooSmall
+	HintTxFeeCapTooLow
 )
 
+// String implements the fmt.Stringer interface.
+func (r InvalidTxListReason) String() string {
+	switch r {
+	case HintNone:
+		return "none"
+	case HintOK:
+		return "ok"
+	case HintBinaryTooLarge:
+		return "binary too large"
+	case HintBinaryNotDecodable:
+		return "binary not decodable"
+	case HintTooManyTxs:
+		return "too many transactions"
+	case HintBlobNotDecodable:
+		return "blob not decodable"
+	case HintBlobTxListOutOfRange:
+		return "transactions list out of blob range"
+	case HintBlockGasLimitTooLarge:
+		return "block gas limit too large"
+	case HintTxTypeNotAllowed:
+		return "transaction type not allowed"
+	case HintTxInvalidChainID:
+		return "invalid transaction chain ID"
+	case HintTxInvalidSig:
+		return "invalid transaction signature"
+	case HintTxGasLimitTooSmall:
+		return "transaction gas limit too small"
+	case HintTxFeeCapTooLow:
+		return "transaction fee cap too low"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (r InvalidTxListReason) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (r *InvalidTxListReason) UnmarshalText(text []byte) error {
+	for reason := HintNone; reason <= HintTxFeeCapTooLow; reason++ {
+		if reason.String() == string(text) {
+			*r = reason
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown invalid transactions list reason: %s", text)
+}
+
+// TxDiagnostic describes a transaction in a valid transactions list which the L2 execution engine
+// is expected to skip, the Hint* values from HintBlockGasLimitTooLarge on are used as the reasons.
+type TxDiagnostic struct {
+	Index  int                 `json:"index"`
+	Hash   common.Hash         `json:"hash"`
+	Reason InvalidTxListReason `json:"reason"`
+}
+
+// allowedTxTypes are the transaction types which can be included in a L2 block.
+var allowedTxTypes = map[uint8]bool{
+	types.LegacyTxType:     true,
+	types.AccessListTxType: true,
+	types.DynamicFeeTxType: true,
+}
+
 type TxListValidator struct {
 	blockMaxGasLimit        uint64
 	maxTransactionsPerBlock uint64
 	maxBytesPerTxList       uint64
 	chainID                 *big.Int
+	signer                  types.Signer
 }
 
 // NewTxListValidator creates a new TxListValidator instance based on giving configurations.
@@ -38,6 +123,7 @@ func NewTxListValidator(
 		blockMaxGasLimit:        blockMaxGasLimit,
 		maxBytesPerTxList:       maxBytesPerTxList,
 		chainID:                 chainID,
+		signer:                  types.LatestSignerForChainID(chainID),
 	}
 }
 
@@ -46,40 +132,112 @@ func NewTxListValidator(
 func (v *TxListValidator) ValidateTxList(
 	blockID *big.Int,
 	proposeBlockTxInput []byte,
-) (txListBytes []byte, hint InvalidTxListReason, txIdx int, err error) {
+) (txListBytes []byte, hint InvalidTxListReason, err error) {
 	if txListBytes, err = encoding.UnpackTxListBytes(proposeBlockTxInput); err != nil {
-		return nil, HintNone, 0, err
+		return nil, HintNone, err
 	}
 
+	return txListBytes, v.ValidateTxListBytes(blockID, txListBytes), nil
+}
+
+// ValidateTxListBytes checks whether the given transactions list bytes are valid, it's used
+// when the transactions list is not in the TaikoL1.proposeBlock transaction's input data,
+// e.g. it's read from a blob.
+func (v *TxListValidator) ValidateTxListBytes(
+	blockID *big.Int,
+	txListBytes []byte,
+) InvalidTxListReason {
 	if len(txListBytes) == 0 {
-		return txListBytes, HintOK, 0, nil
+		return HintOK
 	}
 
-	hint, txIdx = v.isTxListValid(blockID, txListBytes)
-
-	return txListBytes, hint, txIdx, nil
+	return v.isTxListValid(blockID, txListBytes)
 }
 
-// isTxListValid checks whether the transaction list is valid.
-func (v *TxListValidator) isTxListValid(blockID *big.Int, txListBytes []byte) (hint InvalidTxListReason, txIdx int) {
+// isTxListValid checks whether the transaction list is valid, only the checks on the whole list
+// are done here, since an invalid list is replaced with an empty block during derivation, the invalid
+// transactions in a valid list are reported by DiagnoseTxList instead.
+func (v *TxListValidator) isTxListValid(blockID *big.Int, txListBytes []byte) InvalidTxListReason {
 	if len(txListBytes) > int(v.maxBytesPerTxList) {
 		log.Info("Transactions list binary too large", "length", len(txListBytes), "blockID", blockID)
-		return HintNone, 0
+		return HintBinaryTooLarge
 	}
 
 	var txs types.Transactions
 	if err := rlp.DecodeBytes(txListBytes, &txs); err != nil {
 		log.Info("Failed to decode transactions list bytes", "blockID", blockID, "error", err)
-		return HintNone, 0
+		return HintBinaryNotDecodable
 	}
 
 	log.Debug("Transactions list decoded", "blockID", blockID, "length", len(txs))
 
 	if txs.Len() > int(v.maxTransactionsPerBlock) {
 		log.Info("Too many transactions", "blockID", blockID, "count", txs.Len())
-		return HintNone, 0
+		return HintTooManyTxs
 	}
 
 	log.Info("Transaction list is valid", "blockID", blockID)
-	return HintOK, 0
+	return HintOK
+}
+
+// DiagnoseTxList checks each transaction in the given valid transactions list, and returns the ones
+// the L2 execution engine is expected to skip. The diagnostics don't affect the validity of the list,
+// like the execution engine, the fee caps are checked against the given block base fee if it's not nil.
+func (v *TxListValidator) DiagnoseTxList(
+	blockID *big.Int,
+	txListBytes []byte,
+	baseFee *big.Int,
+) []*TxDiagnostic {
+	var txs types.Transactions
+	if err := rlp.DecodeBytes(txListBytes, &txs); err != nil {
+		return nil
+	}
+
+	var (
+		diagnostics []*TxDiagnostic
+		sumGasLimit uint64
+	)
+	for i, tx := range txs {
+		reason := v.isTxValid(tx, baseFee)
+		if reason == HintOK {
+			if sumGasLimit += tx.Gas(); sumGasLimit < tx.Gas() || sumGasLimit > v.blockMaxGasLimit {
+				sumGasLimit -= tx.Gas()
+				reason = HintBlockGasLimitTooLarge
+			}
+		}
+		if reason == HintOK {
+			continue
+		}
+
+		log.Info("Transaction expected to be skipped", "blockID", blockID, "index", i, "hash", tx.Hash(), "reason", reason)
+		diagnostics = append(diagnostics, &TxDiagnostic{Index: i, Hash: tx.Hash(), Reason: reason})
+	}
+
+	return diagnostics
+}
+
+// isTxValid checks whether the given transaction can be included in a L2 block.
+func (v *TxListValidator) isTxValid(tx *types.Transaction, baseFee *big.Int) InvalidTxListReason {
+	if !allowedTxTypes[tx.Type()] {
+		return HintTxTypeNotAllowed
+	}
+
+	if tx.Protected() && tx.ChainId().Cmp(v.chainID) != 0 {
+		return HintTxInvalidChainID
+	}
+
+	if _, err := types.Sender(v.signer, tx); err != nil {
+		return HintTxInvalidSig
+	}
+
+	intrinsicGas, err := core.IntrinsicGas(tx.Data(), tx.AccessList(), tx.To() == nil, true, true, true)
+	if err != nil || tx.Gas() < intrinsicGas {
+		return HintTxGasLimitTooSmall
+	}
+
+	if baseFee != nil && tx.GasFeeCapIntCmp(baseFee) < 0 {
+		return HintTxFeeCapTooLow
+	}
+
+	return HintOK
 }
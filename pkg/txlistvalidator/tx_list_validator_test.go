@@ -12,11 +12,12 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 )
 
 var (
-	maxBlocksGasLimit = uint64(50)
+	maxBlocksGasLimit = uint64(21000 * 10)
 	maxBlockNumTxs    = uint64(11)
 	maxTxlistBytes    = uint64(10000)
 	chainID           = genesis.Config.ChainID
@@ -40,11 +41,43 @@ func TestValidateTxList(t *testing.T) {
 	)
 
 	// Binary is not unpackable
-	txListBytes, _, _, err := v.ValidateTxList(common.Big0, randBytes(5))
+	txListBytes, _, err := v.ValidateTxList(common.Big0, randBytes(5))
 	require.Empty(t, txListBytes)
 	require.NotNil(t, err)
 }
 
+func TestValidateTxListBytes(t *testing.T) {
+	v := NewTxListValidator(
+		maxBlocksGasLimit,
+		maxBlockNumTxs,
+		maxTxlistBytes,
+		chainID,
+	)
+
+	// Empty transactions list
+	require.Equal(t, HintOK, v.ValidateTxListBytes(common.Big0, []byte{}))
+
+	// Binary is not decodable
+	require.Equal(t, HintBinaryNotDecodable, v.ValidateTxListBytes(common.Big0, randBytes(5)))
+
+	// Invalid transactions don't make the list invalid
+	require.Equal(t, HintOK, v.ValidateTxListBytes(common.Big0, rlpEncodedTransactionBytes(1, false)))
+}
+
+func TestInvalidTxListReasonText(t *testing.T) {
+	for reason := HintNone; reason <= HintTxFeeCapTooLow; reason++ {
+		text, err := reason.MarshalText()
+		require.Nil(t, err)
+
+		var decoded InvalidTxListReason
+		require.Nil(t, decoded.UnmarshalText(text))
+		require.Equal(t, reason, decoded)
+	}
+
+	var decoded InvalidTxListReason
+	require.NotNil(t, decoded.UnmarshalText([]byte("unknown")))
+}
+
 func TestIsTxListValid(t *testing.T) {
 	v := NewTxListValidator(
 		maxBlocksGasLimit,
@@ -57,50 +90,119 @@ func TestIsTxListValid(t *testing.T) {
 		blockID     *big.Int
 		txListBytes []byte
 		wantReason  InvalidTxListReason
-		wantTxIdx   int
 	}{
 		{
 			"txListBytes binary too large",
 			chainID,
 			randBytes(maxTxlistBytes + 1),
-			HintNone,
-			0,
+			HintBinaryTooLarge,
 		},
 		{
 			"txListBytes not decodable to rlp",
 			chainID,
 			randBytes(0),
-			HintNone,
-			0,
+			HintBinaryNotDecodable,
 		},
 		{
 			"txListBytes too many transactions",
 			chainID,
 			rlpEncodedTransactionBytes(int(maxBlockNumTxs)+1, true),
-			HintNone,
-			0,
+			HintTooManyTxs,
 		},
 		{
 			"success empty tx list",
 			chainID,
 			rlpEncodedTransactionBytes(0, true),
 			HintOK,
-			0,
 		},
 		{
 			"success non-empty tx list",
 			chainID,
 			rlpEncodedTransactionBytes(1, true),
 			HintOK,
-			0,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			reason, txIdx := v.isTxListValid(tt.blockID, tt.txListBytes)
-			require.Equal(t, tt.wantReason, reason)
-			require.Equal(t, tt.wantTxIdx, txIdx)
+			require.Equal(t, tt.wantReason, v.isTxListValid(tt.blockID, tt.txListBytes))
+		})
+	}
+}
+
+func TestDiagnoseTxList(t *testing.T) {
+	v := NewTxListValidator(
+		maxBlocksGasLimit,
+		maxBlockNumTxs,
+		maxTxlistBytes,
+		chainID,
+	)
+	tests := []struct {
+		name            string
+		txListBytes     []byte
+		wantDiagnostics []*TxDiagnostic
+	}{
+		{
+			"txListBytes gas limit sum too large",
+			rlpEncodedTransactionBytes(int(maxBlockNumTxs), true),
+			[]*TxDiagnostic{{Index: 10, Reason: HintBlockGasLimitTooLarge}},
+		},
+		{
+			"transaction not signed",
+			rlpEncodedTransactionBytes(1, false),
+			[]*TxDiagnostic{{Index: 0, Reason: HintTxInvalidSig}},
+		},
+		{
+			"transaction with invalid chain ID",
+			rlpEncodedTxs(signTx(t, new(big.Int).Add(chainID, common.Big1), &types.DynamicFeeTx{
+				To:        &testAddr,
+				Gas:       21000,
+				GasFeeCap: common.Big256,
+			})),
+			[]*TxDiagnostic{{Index: 0, Reason: HintTxInvalidChainID}},
+		},
+		{
+			"transaction type not allowed",
+			rlpEncodedTxs(signTx(t, chainID, &types.BlobTx{
+				ChainID:   uint256.MustFromBig(chainID),
+				Gas:       21000,
+				GasFeeCap: uint256.NewInt(256),
+			})),
+			[]*TxDiagnostic{{Index: 0, Reason: HintTxTypeNotAllowed}},
+		},
+		{
+			"transaction gas limit too small",
+			rlpEncodedTxs(
+				signTx(t, chainID, &types.LegacyTx{To: &testAddr, Gas: 21000, GasPrice: common.Big256}),
+				signTx(t, chainID, &types.LegacyTx{To: &testAddr, Gas: 21000, GasPrice: common.Big256, Data: []byte{1}}),
+			),
+			[]*TxDiagnostic{{Index: 1, Reason: HintTxGasLimitTooSmall}},
+		},
+		{
+			"transaction fee cap too low",
+			rlpEncodedTxs(signTx(t, chainID, &types.DynamicFeeTx{To: &testAddr, Gas: 21000, GasFeeCap: common.Big2})),
+			[]*TxDiagnostic{{Index: 0, Reason: HintTxFeeCapTooLow}},
+		},
+		{
+			"txListBytes not decodable to rlp",
+			randBytes(0),
+			nil,
+		},
+		{
+			"no invalid transaction",
+			rlpEncodedTransactionBytes(2, true),
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diagnostics := v.DiagnoseTxList(common.Big0, tt.txListBytes, common.Big3)
+			require.Len(t, diagnostics, len(tt.wantDiagnostics))
+			for i, want := range tt.wantDiagnostics {
+				require.Equal(t, want.Index, diagnostics[i].Index)
+				require.Equal(t, want.Reason, diagnostics[i].Reason)
+			}
 		})
 	}
 }
@@ -115,12 +217,12 @@ func rlpEncodedTransactionBytes(l int, signed bool) []byte {
 				To:       &testAddr,
 				GasPrice: common.Big256,
 				Value:    common.Big1,
-				Gas:      10,
+				Gas:      21000,
 			}
 
 			tx = types.MustSignNewTx(testKey, types.LatestSigner(genesis.Config), txData)
 		} else {
-			tx = types.NewTransaction(1, testAddr, common.Big1, 10, common.Big256, nil)
+			tx = types.NewTransaction(1, testAddr, common.Big1, 21000, common.Big256, nil)
 		}
 		txs = append(
 			txs,
@@ -131,6 +233,17 @@ func rlpEncodedTransactionBytes(l int, signed bool) []byte {
 	return b
 }
 
+func signTx(t *testing.T, chainID *big.Int, txData types.TxData) *types.Transaction {
+	tx, err := types.SignNewTx(testKey, types.LatestSignerForChainID(chainID), txData)
+	require.Nil(t, err)
+	return tx
+}
+
+func rlpEncodedTxs(txs ...*types.Transaction) []byte {
+	b, _ := rlp.EncodeToBytes(types.Transactions(txs))
+	return b
+}
+
 func randBytes(l uint64) []byte {
 	b := make([]byte, l)
 	if _, err := rand.Read(b); err != nil {
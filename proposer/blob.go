@@ -0,0 +1,350 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+)
+
+var (
+	errBlobNotSupported = errors.New("L1 head has no excess blob gas, blobs are not supported")
+	// blobFeeCapMultiplier is the multiplier applied to the current blob base fee, to make sure
+	// the blob transaction can still be included if the blob base fee goes up in the next few blocks.
+	blobFeeCapMultiplier = big.NewInt(2)
+)
+
+// blobProposeOptions contains the blob related options of a TaikoL1.proposeBlock transaction.
+type blobProposeOptions struct {
+	// Sidecar is the blob sidecar which will be attached to the transaction, if it's nil,
+	// the proposal will reuse the blob cached in protocol.
+	Sidecar       *types.BlobTxSidecar
+	BlobHash      common.Hash
+	Offset        uint64
+	Size          uint64
+	CacheForReuse bool
+}
+
+// packedTxList is a transactions list packed into a blob.
+type packedTxList struct {
+	txListBytes []byte
+	txNum       uint
+	offset      uint64
+}
+
+// packedBlob is a blob containing several transactions lists.
+type packedBlob struct {
+	sidecar *types.BlobTxSidecar
+	hash    common.Hash
+	txLists []*packedTxList
+}
+
+// packTxListsIntoBlobs packs the given transactions lists into as few blobs as possible, the
+// transactions lists will keep their original order.
+func packTxListsIntoBlobs(txListsBytes [][]byte, txNums []uint) ([]*packedBlob, error) {
+	var (
+		blobs   []*packedBlob
+		data    []byte
+		txLists []*packedTxList
+	)
+
+	seal := func() error {
+		if len(txLists) == 0 {
+			return nil
+		}
+		sidecar, hash, err := newBlobSidecar(data)
+		if err != nil {
+			return err
+		}
+		blobs = append(blobs, &packedBlob{sidecar: sidecar, hash: hash, txLists: txLists})
+		data, txLists = nil, nil
+		return nil
+	}
+
+	for i, txListBytes := range txListsBytes {
+		if len(txListBytes) > encoding.BlobMaxDataSize {
+			return nil, fmt.Errorf("transactions list too large for a blob: %d", len(txListBytes))
+		}
+		if len(data)+len(txListBytes) > encoding.BlobMaxDataSize {
+			if err := seal(); err != nil {
+				return nil, err
+			}
+		}
+
+		txLists = append(txLists, &packedTxList{
+			txListBytes: txListBytes,
+			txNum:       txNums[i],
+			offset:      uint64(len(data)),
+		})
+		data = append(data, txListBytes...)
+	}
+
+	if err := seal(); err != nil {
+		return nil, err
+	}
+
+	return blobs, nil
+}
+
+// newBlobSidecar encodes the given data into a blob, and creates the corresponding sidecar with
+// the KZG commitment and proof.
+func newBlobSidecar(data []byte) (*types.BlobTxSidecar, common.Hash, error) {
+	blob, err := encoding.EncodeBlob(data)
+	if err != nil {
+		return nil, common.Hash{}, err
+	}
+
+	commitment, err := kzg4844.BlobToCommitment(*blob)
+	if err != nil {
+		return nil, common.Hash{}, fmt.Errorf("failed to compute blob commitment: %w", err)
+	}
+
+	proof, err := kzg4844.ComputeBlobProof(*blob, commitment)
+	if err != nil {
+		return nil, common.Hash{}, fmt.Errorf("failed to compute blob proof: %w", err)
+	}
+
+	sidecar := &types.BlobTxSidecar{
+		Blobs:       []kzg4844.Blob{*blob},
+		Commitments: []kzg4844.Commitment{commitment},
+		Proofs:      []kzg4844.Proof{proof},
+	}
+
+	return sidecar, sidecar.BlobHashes()[0], nil
+}
+
+// calldataGas returns the intrinsic calldata gas cost of the given bytes.
+func calldataGas(data []byte) uint64 {
+	var gas uint64
+	for _, b := range data {
+		if b == 0 {
+			gas += params.TxDataZeroGas
+		} else {
+			gas += params.TxDataNonZeroGasEIP2028
+		}
+	}
+	return gas
+}
+
+// getL1Fees fetches the current L1 base fee and blob base fee.
+func (p *Proposer) getL1Fees(ctx context.Context) (*big.Int, *big.Int, error) {
+	head, err := p.rpc.L1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if head.ExcessBlobGas == nil {
+		return head.BaseFee, nil, errBlobNotSupported
+	}
+
+	return head.BaseFee, eip4844.CalcBlobFee(*head.ExcessBlobGas), nil
+}
+
+// shouldProposeWithBlobs decides whether the given transactions lists should be proposed
+// with blobs in the current epoch, by comparing the L1 calldata cost with the blob cost.
+func (p *Proposer) shouldProposeWithBlobs(ctx context.Context, txListsBytes [][]byte) (bool, error) {
+	if !p.BlobAllowed || !p.protocolConfigs.BlobAllowedForDA {
+		return false, nil
+	}
+
+	baseFee, blobBaseFee, err := p.getL1Fees(ctx)
+	if err != nil {
+		if errors.Is(err, errBlobNotSupported) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	var (
+		totalSize   uint64
+		totalGas    uint64
+		blobsNeeded uint64
+		blobSize    uint64
+	)
+	for _, txListBytes := range txListsBytes {
+		if len(txListBytes) > encoding.BlobMaxDataSize {
+			return false, nil
+		}
+		if blobsNeeded == 0 || blobSize+uint64(len(txListBytes)) > uint64(encoding.BlobMaxDataSize) {
+			blobsNeeded++
+			blobSize = 0
+		}
+		blobSize += uint64(len(txListBytes))
+		totalSize += uint64(len(txListBytes))
+		totalGas += calldataGas(txListBytes)
+	}
+
+	var (
+		calldataCost = new(big.Int).Mul(baseFee, new(big.Int).SetUint64(totalGas))
+		blobCost     = new(big.Int).Mul(
+			blobBaseFee,
+			new(big.Int).SetUint64(blobsNeeded*params.BlobTxBlobGasPerBlob),
+		)
+		useBlobs = blobCost.Cmp(calldataCost) < 0
+	)
+
+	log.Info(
+		"L1 data availability cost",
+		"txLists", len(txListsBytes),
+		"totalSize", totalSize,
+		"baseFee", baseFee,
+		"blobBaseFee", blobBaseFee,
+		"calldataCost", calldataCost,
+		"blobs", blobsNeeded,
+		"blobCost", blobCost,
+		"useBlobs", useBlobs,
+	)
+
+	return useBlobs, nil
+}
+
+// proposeTxListsWithBlobs packs the given transactions lists into blobs and proposes them, the first
+// proposal of each blob carries the blob and caches it in protocol, the following proposals will reuse
+// the cached blob with different offsets.
+func (p *Proposer) proposeTxListsWithBlobs(
+	ctx context.Context,
+	txListsBytes [][]byte,
+	txNums []uint,
+) error {
+	blobs, err := packTxListsIntoBlobs(txListsBytes, txNums)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: the L1 transaction pool doesn't accept both blob and non-blob transactions from the same
+	// account at the same time, so we propose the transactions lists one by one here.
+	for _, blob := range blobs {
+		log.Info("Propose transactions lists with blob", "blobHash", blob.hash, "txLists", len(blob.txLists))
+
+		for i, txList := range blob.txLists {
+			opts := &blobProposeOptions{
+				BlobHash:      blob.hash,
+				Offset:        txList.offset,
+				Size:          uint64(len(txList.txListBytes)),
+				CacheForReuse: i == 0 && len(blob.txLists) > 1,
+			}
+			if i == 0 {
+				opts.Sidecar = blob.sidecar
+			}
+
+			if err := p.proposeTxList(ctx, txList.txListBytes, txList.txNum, nil, opts); err != nil {
+				return fmt.Errorf("failed to propose transactions with blob: %w", err)
+			}
+		}
+
+		metrics.ProposerProposedBlobsCounter.Inc(1)
+	}
+
+	return nil
+}
+
+// sendBlobTx sends a TaikoL1.proposeBlock transaction with the given blob sidecar attached. If sending
+// the signed transaction fails, it's still returned along with the error, since the L1 node may have
+// accepted it.
+func (p *Proposer) sendBlobTx(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	encodedParams []byte,
+	sidecar *types.BlobTxSidecar,
+	isReplacement bool,
+) (*types.Transaction, error) {
+	// An empty txList means the transactions list will be read from the blob.
+	data, err := encoding.TaikoL1ABI.Pack("proposeBlock", encodedParams, []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee, blobBaseFee, err := p.getL1Fees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		blobHashes = sidecar.BlobHashes()
+		gasFeeCap  = new(big.Int).Add(opts.GasTipCap, new(big.Int).Mul(baseFee, common.Big2))
+		blobFeeCap = new(big.Int).Mul(blobBaseFee, blobFeeCapMultiplier)
+		value      = opts.Value
+	)
+	if isReplacement {
+		// A replacement blob transaction also needs to bump its blob fee cap.
+		blobFeeCap = new(big.Int).Mul(blobFeeCap, new(big.Int).SetUint64(p.ProposeBlockTxReplacementMultiplier))
+	}
+	if value == nil {
+		value = common.Big0
+	}
+
+	gasLimit := opts.GasLimit
+	if gasLimit == 0 {
+		if gasLimit, err = p.estimateBlobTxGas(ctx, data, value, blobHashes, blobFeeCap); err != nil {
+			return nil, encoding.TryParsingCustomError(err)
+		}
+	}
+
+	nonce := opts.Nonce
+	if nonce == nil {
+		pendingNonce, err := p.rpc.L1.PendingNonceAt(ctx, p.proposerAddress)
+		if err != nil {
+			return nil, err
+		}
+		nonce = new(big.Int).SetUint64(pendingNonce)
+	}
+
+	tx, err := p.signer.SignTx(ctx, types.NewTx(&types.BlobTx{
+		ChainID:    uint256.MustFromBig(p.rpc.L1ChainID),
+		Nonce:      nonce.Uint64(),
+		GasTipCap:  uint256.MustFromBig(opts.GasTipCap),
+		GasFeeCap:  uint256.MustFromBig(gasFeeCap),
+		Gas:        gasLimit,
+		To:         p.TaikoL1Address,
+		Value:      uint256.MustFromBig(value),
+		Data:       data,
+		BlobFeeCap: uint256.MustFromBig(blobFeeCap),
+		BlobHashes: blobHashes,
+		Sidecar:    sidecar,
+	}), p.rpc.L1ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.rpc.L1.SendTransaction(ctx, tx); err != nil {
+		return tx, err
+	}
+
+	return tx, nil
+}
+
+// estimateBlobTxGas estimates the gas limit of a blob transaction, since ethereum.CallMsg doesn't
+// support blob fields yet, we call eth_estimateGas directly here.
+func (p *Proposer) estimateBlobTxGas(
+	ctx context.Context,
+	data []byte,
+	value *big.Int,
+	blobHashes []common.Hash,
+	blobFeeCap *big.Int,
+) (uint64, error) {
+	var gas hexutil.Uint64
+	if err := p.rpc.L1.CallContext(ctx, &gas, "eth_estimateGas", map[string]interface{}{
+		"from":                p.proposerAddress,
+		"to":                  p.TaikoL1Address,
+		"data":                hexutil.Bytes(data),
+		"value":               (*hexutil.Big)(value),
+		"blobVersionedHashes": blobHashes,
+		"maxFeePerBlobGas":    (*hexutil.Big)(blobFeeCap),
+	}); err != nil {
+		return 0, err
+	}
+
+	return uint64(gas), nil
+}
@@ -0,0 +1,54 @@
+package proposer
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/internal/testutils"
+)
+
+func TestPackTxListsIntoBlobs(t *testing.T) {
+	var (
+		halfBlob     = encoding.BlobMaxDataSize / 2
+		txListsBytes = [][]byte{
+			testutils.RandomBytes(halfBlob),
+			testutils.RandomBytes(halfBlob),
+			testutils.RandomBytes(halfBlob),
+		}
+		txNums = []uint{1, 2, 3}
+	)
+
+	blobs, err := packTxListsIntoBlobs(txListsBytes, txNums)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(blobs))
+
+	require.Equal(t, 2, len(blobs[0].txLists))
+	require.Equal(t, uint64(0), blobs[0].txLists[0].offset)
+	require.Equal(t, uint64(halfBlob), blobs[0].txLists[1].offset)
+	require.Equal(t, uint(2), blobs[0].txLists[1].txNum)
+	require.Equal(t, 1, len(blobs[1].txLists))
+	require.Equal(t, uint64(0), blobs[1].txLists[0].offset)
+
+	for _, blob := range blobs {
+		require.Equal(t, blob.sidecar.BlobHashes()[0], blob.hash)
+
+		data, err := encoding.DecodeBlob(&blob.sidecar.Blobs[0])
+		require.Nil(t, err)
+		for _, txList := range blob.txLists {
+			require.Equal(t, txList.txListBytes, data[txList.offset:txList.offset+uint64(len(txList.txListBytes))])
+		}
+	}
+}
+
+func TestPackTxListsIntoBlobsTooLarge(t *testing.T) {
+	_, err := packTxListsIntoBlobs([][]byte{testutils.RandomBytes(encoding.BlobMaxDataSize + 1)}, []uint{1})
+	require.NotNil(t, err)
+}
+
+func TestCalldataGas(t *testing.T) {
+	require.Equal(t, uint64(0), calldataGas([]byte{}))
+	require.Equal(t, params.TxDataZeroGas+params.TxDataNonZeroGasEIP2028, calldataGas([]byte{0, 1}))
+}
@@ -14,6 +14,8 @@ import (
 
 	"github.com/taikoxyz/taiko-client/cmd/flags"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
+	policy "github.com/taikoxyz/taiko-client/proposer/tx_policy"
 )
 
 // Config contains all configurations to initialize a Taiko proposer.
@@ -21,6 +23,7 @@ type Config struct {
 	*rpc.ClientConfig
 	AssignmentHookAddress               common.Address
 	L1ProposerPrivKey                   *ecdsa.PrivateKey
+	L1ProposerSigner                    signer.Signer
 	ExtraData                           string
 	ProposeInterval                     *time.Duration
 	LocalAddresses                      []common.Address
@@ -30,6 +33,8 @@ type Config struct {
 	ProposeBlockTxGasLimit              uint64
 	ProposeBlockTxReplacementMultiplier uint64
 	WaitReceiptTimeout                  time.Duration
+	TxResubmissionTimeout               time.Duration
+	TxStuckTimeout                      time.Duration
 	ProposeBlockTxGasTipCap             *big.Int
 	ProverEndpoints                     []*url.URL
 	OptimisticTierFee                   *big.Int
@@ -38,17 +43,51 @@ type Config struct {
 	SgxAndPseZkevmTierFee               *big.Int
 	TierFeePriceBump                    *big.Int
 	MaxTierFeePriceBumps                uint64
+	FeeToken                            common.Address
+	FeeTokenApproveAmount               *big.Int
+	ProverReputation                    bool
+	ProverReputationDBPath              string
+	ProverReliabilityWeight             float64
+	ProverAuction                       bool
+	ProverAuctionDeadline               time.Duration
 	IncludeParentMetaHash               bool
+	BlobAllowed                         bool
+	DryRun                              bool
+	ProposalScheduling                  bool
+	SchedulerCheckInterval              time.Duration
+	SchedulerFeeHistoryBlocks           uint64
+	SchedulerFeeThreshold               uint64
+	SchedulerMaxDelay                   time.Duration
+	SchedulerMaxPendingBytes            uint64
+	SchedulerMaxBaseFee                 *big.Int
+	SchedulerMaxBlobBaseFee             *big.Int
+	TxPolicy                            *policy.Config
 }
 
 // NewConfigFromCliContext initializes a Config instance from
 // command line flags.
 func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
-	l1ProposerPrivKey, err := crypto.ToECDSA(
-		common.Hex2Bytes(c.String(flags.L1ProposerPrivKey.Name)),
-	)
+	var l1ProposerPrivKey *ecdsa.PrivateKey
+	if c.IsSet(flags.L1ProposerPrivKey.Name) {
+		privKey, err := crypto.ToECDSA(
+			common.Hex2Bytes(c.String(flags.L1ProposerPrivKey.Name)),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid L1 proposer private key: %w", err)
+		}
+		l1ProposerPrivKey = privKey
+	}
+
+	l1ProposerSigner, err := signer.New(&signer.Config{
+		PrivateKey:     l1ProposerPrivKey,
+		KeystorePath:   c.String(flags.L1ProposerKeystore.Name),
+		PasswordFile:   c.String(flags.L1ProposerKeystorePassword.Name),
+		RemoteEndpoint: c.String(flags.L1ProposerRemoteSigner.Name),
+		RemoteAddress:  common.HexToAddress(c.String(flags.L1ProposerRemoteSignerAddress.Name)),
+		Timeout:        c.Duration(flags.RPCTimeout.Name),
+	})
 	if err != nil {
-		return nil, fmt.Errorf("invalid L1 proposer private key: %w", err)
+		return nil, fmt.Errorf("invalid L1 proposer signer: %w", err)
 	}
 
 	// Proposing configuration
@@ -96,18 +135,78 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		proverEndpoints = append(proverEndpoints, endpoint)
 	}
 
+	var feeToken common.Address
+	if c.IsSet(flags.ProposerFeeToken.Name) {
+		if !common.IsHexAddress(c.String(flags.ProposerFeeToken.Name)) {
+			return nil, fmt.Errorf("invalid fee token address: %s", c.String(flags.ProposerFeeToken.Name))
+		}
+		feeToken = common.HexToAddress(c.String(flags.ProposerFeeToken.Name))
+	}
+
+	var feeTokenApproveAmount *big.Int
+	if c.IsSet(flags.ProposerFeeTokenApproveAmount.Name) {
+		amount, ok := new(big.Int).SetString(c.String(flags.ProposerFeeTokenApproveAmount.Name), 10)
+		if !ok {
+			return nil, fmt.Errorf(
+				"invalid fee token approve amount: %s",
+				c.String(flags.ProposerFeeTokenApproveAmount.Name),
+			)
+		}
+		feeTokenApproveAmount = amount
+	}
+
+	if c.Bool(flags.ProverReputation.Name) && feeToken != (common.Address{}) {
+		return nil, fmt.Errorf("reputation-based prover selection only supports ETH prover fees")
+	}
+	if c.Bool(flags.ProverAuction.Name) && feeToken != (common.Address{}) {
+		return nil, fmt.Errorf("prover assignment auction only supports ETH prover fees")
+	}
+	if c.Bool(flags.ProverAuction.Name) && c.Bool(flags.ProverReputation.Name) {
+		return nil, fmt.Errorf("prover assignment auction can not be used with reputation-based prover selection")
+	}
+
+	if c.Bool(flags.ProposalScheduling.Name) {
+		if c.IsSet(flags.ProposeInterval.Name) {
+			return nil, fmt.Errorf(
+				"--%s can not be used with --%s, the pool is checked every --%s instead",
+				flags.ProposalScheduling.Name,
+				flags.ProposeInterval.Name,
+				flags.ProposalSchedulingCheckInterval.Name,
+			)
+		}
+		if c.Uint64(flags.ProposalSchedulingFeeHistoryBlocks.Name) == 0 {
+			return nil, fmt.Errorf("invalid --%s value: 0", flags.ProposalSchedulingFeeHistoryBlocks.Name)
+		}
+		if c.Duration(flags.ProposalSchedulingCheckInterval.Name) <= 0 {
+			return nil, fmt.Errorf(
+				"invalid --%s value: %s",
+				flags.ProposalSchedulingCheckInterval.Name,
+				c.Duration(flags.ProposalSchedulingCheckInterval.Name),
+			)
+		}
+	}
+
 	return &Config{
 		ClientConfig: &rpc.ClientConfig{
-			L1Endpoint:        c.String(flags.L1WSEndpoint.Name),
-			L2Endpoint:        c.String(flags.L2HTTPEndpoint.Name),
-			TaikoL1Address:    common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
-			TaikoL2Address:    common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
-			TaikoTokenAddress: common.HexToAddress(c.String(flags.TaikoTokenAddress.Name)),
-			RetryInterval:     c.Duration(flags.BackOffRetryInterval.Name),
-			Timeout:           c.Duration(flags.RPCTimeout.Name),
+			L1Endpoint:          c.String(flags.L1WSEndpoint.Name),
+			L1FallbackEndpoints: c.StringSlice(flags.L1FallbackEndpoints.Name),
+			L2Endpoint:          c.String(flags.L2HTTPEndpoint.Name),
+			L2FallbackEndpoints: c.StringSlice(flags.L2FallbackEndpoints.Name),
+			TaikoL1Address:      common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
+			TaikoL2Address:      common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
+			TaikoTokenAddress:   common.HexToAddress(c.String(flags.TaikoTokenAddress.Name)),
+			RetryInterval:       c.Duration(flags.BackOffRetryInterval.Name),
+			Timeout:             c.Duration(flags.RPCTimeout.Name),
+			HealthCheck: &rpc.HealthCheckConfig{
+				Interval:     c.Duration(flags.RPCHealthCheckInterval.Name),
+				MaxHeadLag:   c.Uint64(flags.RPCMaxHeadLag.Name),
+				MaxLatency:   c.Duration(flags.RPCMaxLatency.Name),
+				MaxErrorRate: c.Float64(flags.RPCMaxErrorRate.Name),
+			},
 		},
 		AssignmentHookAddress:               common.HexToAddress(c.String(flags.ProposerAssignmentHookAddress.Name)),
 		L1ProposerPrivKey:                   l1ProposerPrivKey,
+		L1ProposerSigner:                    l1ProposerSigner,
 		ExtraData:                           c.String(flags.ExtraData.Name),
 		ProposeInterval:                     proposingInterval,
 		LocalAddresses:                      localAddresses,
@@ -117,6 +216,8 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		ProposeBlockTxGasLimit:              c.Uint64(flags.ProposeBlockTxGasLimit.Name),
 		ProposeBlockTxReplacementMultiplier: proposeBlockTxReplacementMultiplier,
 		WaitReceiptTimeout:                  c.Duration(flags.WaitReceiptTimeout.Name),
+		TxResubmissionTimeout:               c.Duration(flags.ProposeBlockTxResubmissionTimeout.Name),
+		TxStuckTimeout:                      c.Duration(flags.ProposeBlockTxStuckTimeout.Name),
 		ProposeBlockTxGasTipCap:             proposeBlockTxGasTipCap,
 		ProverEndpoints:                     proverEndpoints,
 		OptimisticTierFee:                   new(big.Int).SetUint64(c.Uint64(flags.OptimisticTierFee.Name)),
@@ -125,6 +226,30 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		SgxAndPseZkevmTierFee:               new(big.Int).SetUint64(c.Uint64(flags.SgxAndPseZkevmTierFee.Name)),
 		TierFeePriceBump:                    new(big.Int).SetUint64(c.Uint64(flags.TierFeePriceBump.Name)),
 		MaxTierFeePriceBumps:                c.Uint64(flags.MaxTierFeePriceBumps.Name),
+		FeeToken:                            feeToken,
+		FeeTokenApproveAmount:               feeTokenApproveAmount,
+		ProverReputation:                    c.Bool(flags.ProverReputation.Name),
+		ProverReputationDBPath:              c.String(flags.ProverReputationDBPath.Name),
+		ProverReliabilityWeight:             c.Float64(flags.ProverReliabilityWeight.Name),
+		ProverAuction:                       c.Bool(flags.ProverAuction.Name),
+		ProverAuctionDeadline:               c.Duration(flags.ProverAuctionDeadline.Name),
 		IncludeParentMetaHash:               c.Bool(flags.ProposeBlockIncludeParentMetaHash.Name),
+		BlobAllowed:                         c.Bool(flags.BlobAllowed.Name),
+		DryRun:                              c.Bool(flags.DryRun.Name),
+		ProposalScheduling:                  c.Bool(flags.ProposalScheduling.Name),
+		SchedulerCheckInterval:              c.Duration(flags.ProposalSchedulingCheckInterval.Name),
+		SchedulerFeeHistoryBlocks:           c.Uint64(flags.ProposalSchedulingFeeHistoryBlocks.Name),
+		SchedulerFeeThreshold:               c.Uint64(flags.ProposalSchedulingFeeThreshold.Name),
+		SchedulerMaxDelay:                   c.Duration(flags.ProposalSchedulingMaxDelay.Name),
+		SchedulerMaxPendingBytes:            c.Uint64(flags.ProposalSchedulingMaxPendingBytes.Name),
+		SchedulerMaxBaseFee:                 new(big.Int).SetUint64(c.Uint64(flags.ProposalSchedulingMaxBaseFee.Name)),
+		SchedulerMaxBlobBaseFee:             new(big.Int).SetUint64(c.Uint64(flags.ProposalSchedulingMaxBlobBaseFee.Name)),
+		TxPolicy: &policy.Config{
+			DenySendersFile:      c.String(flags.TxPolicyDenySenders.Name),
+			DenyRecipientsFile:   c.String(flags.TxPolicyDenyRecipients.Name),
+			AllowedContractsFile: c.String(flags.TxPolicyAllowedContracts.Name),
+			MinTip:               new(big.Int).SetUint64(c.Uint64(flags.TxPolicyMinTip.Name)),
+			MaxTxsPerSender:      c.Uint64(flags.TxPolicyMaxTxsPerSender.Name),
+		},
 	}, nil
 }
@@ -50,13 +50,20 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContext() {
 		s.Equal(uint64(5), c.ProposeBlockTxReplacementMultiplier)
 		s.Equal(5*time.Second, c.Timeout)
 		s.Equal(10*time.Second, c.WaitReceiptTimeout)
+		s.Equal(30*time.Second, c.TxResubmissionTimeout)
 		s.Equal(uint64(tierFee), c.OptimisticTierFee.Uint64())
 		s.Equal(uint64(tierFee), c.SgxTierFee.Uint64())
 		s.Equal(uint64(tierFee), c.PseZkevmTierFee.Uint64())
 		s.Equal(uint64(tierFee), c.SgxAndPseZkevmTierFee.Uint64())
 		s.Equal(uint64(15), c.TierFeePriceBump.Uint64())
 		s.Equal(uint64(5), c.MaxTierFeePriceBumps)
+		s.Equal(common.Address{}, c.FeeToken)
+		s.Equal("1000000", c.FeeTokenApproveAmount.String())
+		s.True(c.ProverReputation)
+		s.Equal(0.5, c.ProverReliabilityWeight)
 		s.Equal(true, c.IncludeParentMetaHash)
+		s.Equal(uint64(1), c.TxPolicy.MinTip.Uint64())
+		s.Equal(uint64(16), c.TxPolicy.MaxTxsPerSender)
 
 		for i, e := range strings.Split(proverEndpoints, ",") {
 			s.Equal(c.ProverEndpoints[i].String(), e)
@@ -79,6 +86,7 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContext() {
 		"--" + flags.ProposeBlockTxReplacementMultiplier.Name, "5",
 		"--" + flags.RPCTimeout.Name, rpcTimeout,
 		"--" + flags.WaitReceiptTimeout.Name, "10s",
+		"--" + flags.ProposeBlockTxResubmissionTimeout.Name, "30s",
 		"--" + flags.ProposeBlockTxGasTipCap.Name, "100000",
 		"--" + flags.ProposeBlockTxGasLimit.Name, "100000",
 		"--" + flags.ProverEndpoints.Name, proverEndpoints,
@@ -88,10 +96,31 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContext() {
 		"--" + flags.SgxAndPseZkevmTierFee.Name, fmt.Sprint(tierFee),
 		"--" + flags.TierFeePriceBump.Name, "15",
 		"--" + flags.MaxTierFeePriceBumps.Name, "5",
+		"--" + flags.ProposerFeeTokenApproveAmount.Name, "1000000",
+		"--" + flags.ProverReputation.Name,
+		"--" + flags.ProverReliabilityWeight.Name, "0.5",
 		"--" + flags.ProposeBlockIncludeParentMetaHash.Name, "true",
+		"--" + flags.TxPolicyMinTip.Name, "1",
+		"--" + flags.TxPolicyMaxTxsPerSender.Name, "16",
 	}))
 }
 
+func (s *ProposerTestSuite) TestNewConfigFromCliContextSchedulerErr() {
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextSchedulerErr",
+		"--" + flags.ProposalScheduling.Name,
+		"--" + flags.ProposalSchedulingFeeHistoryBlocks.Name, "0",
+	}), "invalid --scheduler.feeHistoryBlocks value")
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextSchedulerErr",
+		"--" + flags.ProposalScheduling.Name,
+		"--" + flags.ProposeInterval.Name, proposeInterval,
+	}), "can not be used with --epoch.interval")
+}
+
 func (s *ProposerTestSuite) TestNewConfigFromCliContextPrivKeyErr() {
 	app := s.SetupApp()
 
@@ -155,11 +184,34 @@ func (s *ProposerTestSuite) SetupApp() *cli.App {
 		&cli.Uint64Flag{Name: flags.ProposeBlockTxReplacementMultiplier.Name},
 		&cli.DurationFlag{Name: flags.RPCTimeout.Name},
 		&cli.DurationFlag{Name: flags.WaitReceiptTimeout.Name},
+		&cli.DurationFlag{Name: flags.ProposeBlockTxResubmissionTimeout.Name},
+		&cli.DurationFlag{Name: flags.ProposeBlockTxStuckTimeout.Name},
 		&cli.Uint64Flag{Name: flags.ProposeBlockTxGasTipCap.Name},
 		&cli.Uint64Flag{Name: flags.ProposeBlockTxGasLimit.Name},
 		&cli.Uint64Flag{Name: flags.TierFeePriceBump.Name},
 		&cli.Uint64Flag{Name: flags.MaxTierFeePriceBumps.Name},
+		&cli.StringFlag{Name: flags.ProposerFeeToken.Name},
+		&cli.StringFlag{Name: flags.ProposerFeeTokenApproveAmount.Name},
+		&cli.BoolFlag{Name: flags.ProverReputation.Name},
+		&cli.StringFlag{Name: flags.ProverReputationDBPath.Name},
+		&cli.Float64Flag{Name: flags.ProverReliabilityWeight.Name},
+		&cli.BoolFlag{Name: flags.ProverAuction.Name},
+		&cli.DurationFlag{Name: flags.ProverAuctionDeadline.Name},
+		&cli.StringFlag{Name: flags.TxPolicyDenySenders.Name},
+		&cli.StringFlag{Name: flags.TxPolicyDenyRecipients.Name},
+		&cli.StringFlag{Name: flags.TxPolicyAllowedContracts.Name},
+		&cli.Uint64Flag{Name: flags.TxPolicyMinTip.Name},
+		&cli.Uint64Flag{Name: flags.TxPolicyMaxTxsPerSender.Name},
+		&cli.BoolFlag{Name: flags.ProposalScheduling.Name},
+		&cli.DurationFlag{Name: flags.ProposalSchedulingCheckInterval.Name},
+		&cli.Uint64Flag{Name: flags.ProposalSchedulingFeeHistoryBlocks.Name},
+		&cli.Uint64Flag{Name: flags.ProposalSchedulingFeeThreshold.Name},
+		&cli.DurationFlag{Name: flags.ProposalSchedulingMaxDelay.Name},
+		&cli.Uint64Flag{Name: flags.ProposalSchedulingMaxPendingBytes.Name},
+		&cli.Uint64Flag{Name: flags.ProposalSchedulingMaxBaseFee.Name},
+		&cli.Uint64Flag{Name: flags.ProposalSchedulingMaxBlobBaseFee.Name},
 		&cli.BoolFlag{Name: flags.ProposeBlockIncludeParentMetaHash.Name},
+		&cli.BoolFlag{Name: flags.DryRun.Name},
 		&cli.StringFlag{Name: flags.ProposerAssignmentHookAddress.Name},
 	}
 	app.Action = func(ctx *cli.Context) error {
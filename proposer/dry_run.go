@@ -0,0 +1,242 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+// SimulationResult is the result of simulating a TaikoL1.proposeBlock transaction, without
+// broadcasting it.
+type SimulationResult struct {
+	TxListSize    int
+	TxNum         uint
+	UseBlob       bool
+	Prover        common.Address
+	TierFees      []encoding.TierFee
+	ProverFee     *big.Int
+	GasLimit      uint64
+	BaseFee       *big.Int
+	GasTipCap     *big.Int
+	BlobBaseFee   *big.Int
+	L1Cost        *big.Int
+	EstimatedCost *big.Int
+	Err           error
+}
+
+// SimulateOp fetches the transactions lists from L2 execution engine's tx pool, and simulates
+// proposing them, nothing is broadcast.
+func (p *Proposer) SimulateOp(ctx context.Context) ([]*SimulationResult, error) {
+	txListsBytes, txNums, err := p.fetchTxListsBytes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.simulateTxLists(ctx, txListsBytes, txNums)
+}
+
+// simulateTxLists simulates proposing the given transactions lists, each transactions list is
+// simulated as a separate proposal, and the results are logged.
+func (p *Proposer) simulateTxLists(
+	ctx context.Context,
+	txListsBytes [][]byte,
+	txNums []uint,
+) ([]*SimulationResult, error) {
+	useBlobs, err := p.shouldProposeWithBlobs(ctx, txListsBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee, blobBaseFee, err := p.getL1Fees(ctx)
+	if err != nil && (useBlobs || !errors.Is(err, errBlobNotSupported)) {
+		return nil, fmt.Errorf("failed to get L1 fees: %w", err)
+	}
+	if !useBlobs {
+		blobBaseFee = nil
+	}
+
+	var (
+		results   = make([]*SimulationResult, len(txListsBytes))
+		totalCost = new(big.Int)
+	)
+	for i, txListBytes := range txListsBytes {
+		results[i] = p.simulateTxList(ctx, txListBytes, txNums[i], baseFee, blobBaseFee)
+
+		log.Info(
+			"Simulated proposing transactions",
+			"txListSize", results[i].TxListSize,
+			"txs", results[i].TxNum,
+			"useBlob", results[i].UseBlob,
+			"prover", results[i].Prover,
+			"tierFees", results[i].TierFees,
+			"proverFee", results[i].ProverFee,
+			"gasLimit", results[i].GasLimit,
+			"baseFee", results[i].BaseFee,
+			"gasTipCap", results[i].GasTipCap,
+			"blobBaseFee", results[i].BlobBaseFee,
+			"l1Cost", results[i].L1Cost,
+			"estimatedCost", results[i].EstimatedCost,
+			"error", results[i].Err,
+		)
+
+		if results[i].EstimatedCost != nil {
+			totalCost.Add(totalCost, results[i].EstimatedCost)
+		}
+	}
+
+	log.Info("Simulated proposing epoch", "txLists", len(txListsBytes), "useBlobs", useBlobs, "totalCost", totalCost)
+
+	return results, nil
+}
+
+// simulateTxList requests a dry-run prover assignment for the given transactions list, and estimates
+// the gas of the TaikoL1.proposeBlock transaction. A dry-run assignment is only valid in the L1 block
+// it's signed in, so it can't be submitted, and the gas is estimated in that block.
+func (p *Proposer) simulateTxList(
+	ctx context.Context,
+	txListBytes []byte,
+	txNum uint,
+	baseFee *big.Int,
+	blobBaseFee *big.Int,
+) *SimulationResult {
+	var (
+		result = &SimulationResult{
+			TxListSize:  len(txListBytes),
+			TxNum:       txNum,
+			UseBlob:     blobBaseFee != nil,
+			BaseFee:     baseFee,
+			BlobBaseFee: blobBaseFee,
+		}
+		txListHash = crypto.Keccak256Hash(txListBytes)
+		blobOpts   *blobProposeOptions
+	)
+	if result.UseBlob {
+		sidecar, blobHash, err := newBlobSidecar(txListBytes)
+		if err != nil {
+			result.Err = err
+			return result
+		}
+		blobOpts = &blobProposeOptions{Sidecar: sidecar, BlobHash: blobHash, Size: uint64(len(txListBytes))}
+		txListHash = blobHash
+	}
+
+	assignment, proverAddress, fee, err := p.proverSelector.DryRunAssignProver(ctx, p.tierFees, txListHash)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.Prover, result.TierFees, result.ProverFee = proverAddress, assignment.TierFees, fee
+
+	opts, err := getTxOpts(ctx, p.rpc.L1, p.signer, p.rpc.L1ChainID, fee)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.GasTipCap = opts.GasTipCap
+
+	encodedParams, err := p.encodeBlockParams(ctx, assignment, proverAddress, blobOpts)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	var (
+		data       []byte
+		blobHashes []common.Hash
+		blobFeeCap *big.Int
+	)
+	if result.UseBlob {
+		data, err = encoding.TaikoL1ABI.Pack("proposeBlock", encodedParams, []byte{})
+		blobHashes = blobOpts.Sidecar.BlobHashes()
+		blobFeeCap = new(big.Int).Mul(blobBaseFee, blobFeeCapMultiplier)
+	} else {
+		data, err = encoding.TaikoL1ABI.Pack("proposeBlock", encodedParams, txListBytes)
+	}
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	if result.GasLimit, err = p.estimateGasAt(
+		ctx,
+		assignment.MaxProposedIn,
+		data,
+		fee,
+		blobHashes,
+		blobFeeCap,
+	); err != nil {
+		result.Err = encoding.TryParsingCustomError(err)
+		return result
+	}
+
+	result.L1Cost, result.EstimatedCost = estimateProposingCost(
+		result.GasLimit,
+		baseFee,
+		opts.GasTipCap,
+		blobBaseFee,
+		fee,
+	)
+
+	return result
+}
+
+// estimateGasAt estimates the gas limit of a TaikoL1.proposeBlock transaction in the given L1 block,
+// or in the latest L1 block if it's zero. Since ethereum.CallMsg neither supports blob fields nor
+// a block number, we call eth_estimateGas directly here.
+func (p *Proposer) estimateGasAt(
+	ctx context.Context,
+	blockNumber uint64,
+	data []byte,
+	value *big.Int,
+	blobHashes []common.Hash,
+	blobFeeCap *big.Int,
+) (uint64, error) {
+	args := map[string]interface{}{
+		"from":  p.proposerAddress,
+		"to":    p.TaikoL1Address,
+		"data":  hexutil.Bytes(data),
+		"value": (*hexutil.Big)(value),
+	}
+	if len(blobHashes) != 0 {
+		args["blobVersionedHashes"] = blobHashes
+		args["maxFeePerBlobGas"] = (*hexutil.Big)(blobFeeCap)
+	}
+
+	block := "latest"
+	if blockNumber != 0 {
+		block = hexutil.EncodeUint64(blockNumber)
+	}
+
+	var gas hexutil.Uint64
+	if err := p.rpc.L1.CallContext(ctx, &gas, "eth_estimateGas", args, block); err != nil {
+		return 0, err
+	}
+
+	return uint64(gas), nil
+}
+
+// estimateProposingCost estimates the L1 cost of a TaikoL1.proposeBlock transaction, and its total
+// cost including the prover fee, the blob base fee is nil if the transaction carries no blob.
+func estimateProposingCost(
+	gasLimit uint64,
+	baseFee *big.Int,
+	gasTipCap *big.Int,
+	blobBaseFee *big.Int,
+	proverFee *big.Int,
+) (*big.Int, *big.Int) {
+	l1Cost := new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), new(big.Int).Add(baseFee, gasTipCap))
+	if blobBaseFee != nil {
+		l1Cost.Add(l1Cost, new(big.Int).Mul(blobBaseFee, new(big.Int).SetUint64(params.BlobTxBlobGasPerBlob)))
+	}
+
+	return l1Cost, new(big.Int).Add(l1Cost, proverFee)
+}
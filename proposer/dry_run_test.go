@@ -0,0 +1,221 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/internal/testutils"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
+)
+
+var (
+	testDryRunGas           = uint64(200_000)
+	testDryRunGasTipCap     = big.NewInt(2)
+	testDryRunMaxProposedIn = uint64(10)
+)
+
+// testDryRunEthService is a mock of the `eth` namespace of a L1 node.
+type testDryRunEthService struct {
+	estimateErr error
+	args        map[string]interface{}
+	block       string
+}
+
+func (s *testDryRunEthService) EstimateGas(args map[string]interface{}, block string) (hexutil.Uint64, error) {
+	s.args, s.block = args, block
+	if s.estimateErr != nil {
+		return 0, s.estimateErr
+	}
+	return hexutil.Uint64(testDryRunGas), nil
+}
+
+func (s *testDryRunEthService) MaxPriorityFeePerGas() (*hexutil.Big, error) {
+	return (*hexutil.Big)(testDryRunGasTipCap), nil
+}
+
+// testProverSelector is a ProverSelector which only supports dry-run assignments.
+type testProverSelector struct {
+	prover common.Address
+	fee    *big.Int
+}
+
+func (s *testProverSelector) AssignProver(
+	context.Context,
+	[]encoding.TierFee,
+	common.Hash,
+) (*encoding.ProverAssignment, common.Address, *big.Int, error) {
+	return nil, common.Address{}, nil, errors.New("real assignment requested in a dry run")
+}
+
+func (s *testProverSelector) DryRunAssignProver(
+	_ context.Context,
+	tierFees []encoding.TierFee,
+	_ common.Hash,
+) (*encoding.ProverAssignment, common.Address, *big.Int, error) {
+	return &encoding.ProverAssignment{
+		MaxProposedIn: testDryRunMaxProposedIn,
+		TierFees:      tierFees,
+		Signature:     testutils.RandomBytes(crypto.SignatureLength),
+	}, s.prover, s.fee, nil
+}
+
+func (s *testProverSelector) ProverEndpoints() []*url.URL { return nil }
+
+func newTestDryRunProposer(t *testing.T, service *testDryRunEthService) *Proposer {
+	rpcServer := gethRPC.NewServer()
+	require.Nil(t, rpcServer.RegisterName("eth", service))
+	t.Cleanup(rpcServer.Stop)
+
+	server := httptest.NewServer(rpcServer)
+	t.Cleanup(server.Close)
+
+	l1, err := rpc.NewEthClient(context.Background(), server.URL, time.Second)
+	require.Nil(t, err)
+	t.Cleanup(l1.Close)
+
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	return &Proposer{
+		rpc: &rpc.Client{L1: l1, L1ChainID: common.Big1},
+		Config: &Config{
+			ClientConfig: &rpc.ClientConfig{TaikoL1Address: common.HexToAddress("0x01")},
+		},
+		signer:          signer.NewLocalSigner(key),
+		proposerAddress: crypto.PubkeyToAddress(key.PublicKey),
+		tierFees:        []encoding.TierFee{{Tier: 100, Fee: common.Big1}},
+		proverSelector:  &testProverSelector{prover: common.HexToAddress("0x02"), fee: big.NewInt(1000)},
+	}
+}
+
+func TestSimulateTxList(t *testing.T) {
+	var (
+		baseFee     = big.NewInt(10)
+		blobBaseFee = big.NewInt(3)
+		txListBytes = testutils.RandomBytes(1024)
+	)
+
+	tests := []struct {
+		name        string
+		blobBaseFee *big.Int
+		estimateErr error
+		l1Cost      *big.Int
+	}{
+		{
+			"without blobs",
+			nil,
+			nil,
+			big.NewInt(int64(testDryRunGas) * 12),
+		},
+		{
+			"with blobs",
+			blobBaseFee,
+			nil,
+			big.NewInt(int64(testDryRunGas)*12 + 3*params.BlobTxBlobGasPerBlob),
+		},
+		{
+			"estimation failed",
+			nil,
+			errors.New("execution reverted"),
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &testDryRunEthService{estimateErr: tt.estimateErr}
+			p := newTestDryRunProposer(t, service)
+
+			result := p.simulateTxList(context.Background(), txListBytes, 3, baseFee, tt.blobBaseFee)
+			require.Equal(t, len(txListBytes), result.TxListSize)
+			require.Equal(t, uint(3), result.TxNum)
+			require.Equal(t, tt.blobBaseFee != nil, result.UseBlob)
+			require.Equal(t, common.HexToAddress("0x02"), result.Prover)
+			require.Equal(t, p.tierFees, result.TierFees)
+			require.Equal(t, big.NewInt(1000), result.ProverFee)
+			require.Equal(t, testDryRunGasTipCap, result.GasTipCap)
+
+			// The gas is estimated in the L1 block the dry-run assignment is valid in.
+			require.Equal(t, hexutil.EncodeUint64(testDryRunMaxProposedIn), service.block)
+			require.Equal(t, tt.blobBaseFee != nil, service.args["blobVersionedHashes"] != nil)
+			require.Equal(t, tt.blobBaseFee != nil, service.args["maxFeePerBlobGas"] != nil)
+
+			if tt.estimateErr != nil {
+				require.NotNil(t, result.Err)
+				require.Nil(t, result.EstimatedCost)
+				return
+			}
+
+			require.Nil(t, result.Err)
+			require.Equal(t, testDryRunGas, result.GasLimit)
+			require.Equal(t, tt.l1Cost, result.L1Cost)
+			require.Equal(t, new(big.Int).Add(tt.l1Cost, big.NewInt(1000)), result.EstimatedCost)
+		})
+	}
+}
+
+func TestEstimateProposingCost(t *testing.T) {
+	tests := []struct {
+		name        string
+		gasLimit    uint64
+		baseFee     *big.Int
+		gasTipCap   *big.Int
+		blobBaseFee *big.Int
+		proverFee   *big.Int
+		l1Cost      *big.Int
+		cost        *big.Int
+	}{
+		{
+			"without blobs",
+			100_000,
+			big.NewInt(10),
+			big.NewInt(2),
+			nil,
+			big.NewInt(1000),
+			big.NewInt(1_200_000),
+			big.NewInt(1_201_000),
+		},
+		{
+			"with blobs",
+			100_000,
+			big.NewInt(10),
+			big.NewInt(2),
+			big.NewInt(3),
+			big.NewInt(1000),
+			big.NewInt(1_200_000 + 3*params.BlobTxBlobGasPerBlob),
+			big.NewInt(1_201_000 + 3*params.BlobTxBlobGasPerBlob),
+		},
+		{
+			"zero prover fee",
+			100_000,
+			big.NewInt(10),
+			big.NewInt(0),
+			nil,
+			common.Big0,
+			big.NewInt(1_000_000),
+			big.NewInt(1_000_000),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l1Cost, cost := estimateProposingCost(tt.gasLimit, tt.baseFee, tt.gasTipCap, tt.blobBaseFee, tt.proverFee)
+			require.Equal(t, tt.l1Cost, l1Cost)
+			require.Equal(t, tt.cost, cost)
+		})
+	}
+}
@@ -0,0 +1,590 @@
+package nonce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
+)
+
+var (
+	errTxCancelled      = errors.New("transaction cancelled by a self-transfer")
+	errNonceConsumed    = errors.New("nonce consumed by another transaction")
+	errUnsupportedTx    = errors.New("unsupported transaction type for replacement")
+	waitPollingInterval = 3 * time.Second
+	// Default timeouts, used when they are not configured.
+	defaultResubmissionTimeout = 1 * time.Minute
+	defaultStuckTimeout        = 10 * time.Minute
+)
+
+// Config contains all configurations of the nonce manager.
+type Config struct {
+	// Gas tip multiplier when replacing a pending transaction.
+	ReplacementMultiplier *big.Int
+	// Maximum gas tip cap of the replacement transactions, not applied to the cancellations.
+	MaxGasTipCap *big.Int
+	// Time to wait before replacing a pending transaction with a higher gas tip.
+	ResubmissionTimeout time.Duration
+	// Time after which a pending transaction is considered stuck, and cancelled by a self-transfer.
+	StuckTimeout time.Duration
+}
+
+// PendingTx is a transaction sent by the nonce manager which hasn't been mined yet, all its
+// replacements are kept, since any of them can be mined.
+type PendingTx struct {
+	Nonce      uint64
+	Txs        []*types.Transaction
+	SentAt     time.Time
+	LastSentAt time.Time
+	Cancelled  bool
+}
+
+// newPendingTx creates a new PendingTx instance for the given sent transaction.
+func newPendingTx(tx *types.Transaction, cancelled bool) *PendingTx {
+	now := time.Now()
+	return &PendingTx{Nonce: tx.Nonce(), Txs: []*types.Transaction{tx}, SentAt: now, LastSentAt: now, Cancelled: cancelled}
+}
+
+// latest returns the latest replacement of the pending transaction.
+func (p *PendingTx) latest() *types.Transaction {
+	return p.Txs[len(p.Txs)-1]
+}
+
+// Manager assigns the nonces of an L1 account to its outgoing transactions, and tracks the sent
+// transactions until they are mined: a pending transaction gets its gas tip bumped each time the
+// resubmission timeout is reached, and a stuck one is cancelled by a self-transfer, so that it
+// doesn't block the following transactions.
+type Manager struct {
+	rpc     *rpc.Client
+	signer  signer.Signer
+	address common.Address
+	cfg     *Config
+
+	initialized bool
+	nextNonce   uint64
+	pending     map[uint64]*PendingTx
+	mutex       sync.Mutex
+}
+
+// New creates a new nonce manager instance for the given signer's account.
+func New(cli *rpc.Client, s signer.Signer, cfg *Config) *Manager {
+	if cfg.ResubmissionTimeout == 0 {
+		cfg.ResubmissionTimeout = defaultResubmissionTimeout
+	}
+	if cfg.StuckTimeout == 0 {
+		cfg.StuckTimeout = defaultStuckTimeout
+	}
+
+	return &Manager{
+		rpc:     cli,
+		signer:  s,
+		address: s.Address(),
+		cfg:     cfg,
+		pending: make(map[uint64]*PendingTx),
+	}
+}
+
+// Start keeps replacing the pending transactions until the given context is done.
+func (m *Manager) Start(ctx context.Context) {
+	if err := m.Reconcile(ctx); err != nil {
+		log.Warn("Failed to reconcile pending transactions", "address", m.address, "error", err)
+	}
+
+	ticker := time.NewTicker(m.cfg.ResubmissionTimeout)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.resubmit(ctx); err != nil {
+				log.Warn("Failed to resubmit pending transactions", "address", m.address, "error", err)
+			}
+		}
+	}
+}
+
+// Reconcile reconciles the nonce manager with the account's transactions in the L1 node's mempool,
+// which may have been sent before a restart, or by another process. The transactions found are tracked
+// as pending transactions, and the next nonce is moved forward to the account's pending nonce.
+func (m *Manager) Reconcile(ctx context.Context) error {
+	confirmed, err := m.rpc.L1.NonceAt(ctx, m.address, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get confirmed nonce: %w", err)
+	}
+	pendingNonce, err := m.rpc.L1.PendingNonceAt(ctx, m.address)
+	if err != nil {
+		return fmt.Errorf("failed to get pending nonce: %w", err)
+	}
+
+	var found []*types.Transaction
+	for nonce := confirmed; nonce < pendingNonce; nonce++ {
+		tx, err := rpc.GetPendingTxByNonce(ctx, m.rpc, m.address, nonce)
+		if err != nil {
+			return fmt.Errorf("failed to get pending transaction by nonce %d: %w", nonce, err)
+		}
+		if tx != nil {
+			found = append(found, tx)
+		}
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, tx := range found {
+		if _, ok := m.pending[tx.Nonce()]; ok {
+			continue
+		}
+
+		log.Info("Pending transaction reconciled", "address", m.address, "nonce", tx.Nonce(), "hash", tx.Hash())
+
+		m.pending[tx.Nonce()] = newPendingTx(tx, false)
+	}
+
+	if !m.initialized || pendingNonce > m.nextNonce {
+		m.nextNonce = pendingNonce
+	}
+	m.initialized = true
+	m.prune(confirmed)
+
+	return nil
+}
+
+// Acquire reserves the next nonce of the account, the reserved nonce must be either tracked by
+// calling Track after its transaction is sent, or released by calling Release.
+func (m *Manager) Acquire(ctx context.Context) (uint64, error) {
+	m.mutex.Lock()
+	initialized := m.initialized
+	m.mutex.Unlock()
+
+	if !initialized {
+		if err := m.Reconcile(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	nonce := m.nextNonce
+	m.nextNonce++
+
+	return nonce, nil
+}
+
+// Release gives back a reserved nonce whose transaction wasn't sent. If it's the last reserved nonce,
+// it will be reserved again by the next transaction, otherwise it becomes a gap before the following
+// transactions, and is filled by a self-transfer.
+func (m *Manager) Release(ctx context.Context, nonce uint64) error {
+	m.mutex.Lock()
+	if nonce+1 == m.nextNonce {
+		m.nextNonce--
+		m.mutex.Unlock()
+		return nil
+	}
+	m.mutex.Unlock()
+
+	log.Info("Filling the nonce gap with a self-transfer", "address", m.address, "nonce", nonce)
+
+	tx, err := m.sendCancellation(ctx, nonce, nil)
+	if err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.pending[nonce] = newPendingTx(tx, true)
+	metrics.ProposerPendingTxsGauge.Update(int64(len(m.pending)))
+
+	return nil
+}
+
+// Track starts tracking the given sent transaction, until it or one of its replacements is mined.
+func (m *Manager) Track(tx *types.Transaction) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if p, ok := m.pending[tx.Nonce()]; ok {
+		p.Txs = append(p.Txs, tx)
+		p.LastSentAt = time.Now()
+		return
+	}
+
+	m.pending[tx.Nonce()] = newPendingTx(tx, false)
+	metrics.ProposerPendingTxsGauge.Update(int64(len(m.pending)))
+}
+
+// Pending returns the nonces of all the pending transactions in ascending order.
+func (m *Manager) Pending() []uint64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	nonces := make([]uint64, 0, len(m.pending))
+	for nonce := range m.pending {
+		nonces = append(nonces, nonce)
+	}
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+	return nonces
+}
+
+// WaitMined waits until the given transaction, or one of its replacements, is mined, and returns
+// the receipt. An error is returned if the mined transaction is reverted or is a cancellation, or
+// the nonce is consumed by another transaction.
+func (m *Manager) WaitMined(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	ticker := time.NewTicker(waitPollingInterval)
+	defer ticker.Stop()
+
+	for ; true; <-ticker.C {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		txs := m.replacements(tx)
+		if mined, receipt := m.findReceipt(ctx, txs); receipt != nil {
+			m.remove(tx.Nonce())
+			return receipt, m.checkReceipt(mined, receipt)
+		}
+
+		confirmed, err := m.rpc.L1.NonceAt(ctx, m.address, nil)
+		if err != nil {
+			log.Debug("Failed to fetch confirmed nonce", "address", m.address, "error", err)
+			continue
+		}
+		if confirmed <= tx.Nonce() {
+			continue
+		}
+
+		// The nonce has been consumed, check the receipts once more, in case one of the transactions
+		// is mined right after the last check.
+		mined, receipt := m.findReceipt(ctx, m.replacements(tx))
+		m.remove(tx.Nonce())
+		if receipt == nil {
+			return nil, errNonceConsumed
+		}
+
+		return receipt, m.checkReceipt(mined, receipt)
+	}
+
+	return nil, fmt.Errorf("failed to wait for transaction %s", tx.Hash())
+}
+
+// FindMined returns the first mined transaction among the given ones and their tracked replacements,
+// and its receipt, or nil if none of them has been mined.
+func (m *Manager) FindMined(ctx context.Context, txs []*types.Transaction) (*types.Transaction, *types.Receipt) {
+	var candidates []*types.Transaction
+	for _, tx := range txs {
+		candidates = append(candidates, m.replacements(tx)...)
+	}
+
+	return m.findReceipt(ctx, candidates)
+}
+
+// replacements returns the given transaction and all its tracked replacements.
+func (m *Manager) replacements(tx *types.Transaction) []*types.Transaction {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	p, ok := m.pending[tx.Nonce()]
+	if !ok {
+		return []*types.Transaction{tx}
+	}
+
+	txs := []*types.Transaction{tx}
+	for _, replacement := range p.Txs {
+		if replacement.Hash() != tx.Hash() {
+			txs = append(txs, replacement)
+		}
+	}
+
+	return txs
+}
+
+// findReceipt returns the first mined transaction among the given ones, and its receipt.
+func (m *Manager) findReceipt(ctx context.Context, txs []*types.Transaction) (*types.Transaction, *types.Receipt) {
+	for _, tx := range txs {
+		receipt, err := m.rpc.L1.TransactionReceipt(ctx, tx.Hash())
+		if err != nil {
+			log.Debug("Failed to fetch transaction receipt", "hash", tx.Hash(), "error", err)
+			continue
+		}
+
+		return tx, receipt
+	}
+
+	return nil, nil
+}
+
+// checkReceipt checks whether the given mined transaction succeeded, and is not a cancellation.
+func (m *Manager) checkReceipt(tx *types.Transaction, receipt *types.Receipt) error {
+	if m.isCancellation(tx) {
+		return errTxCancelled
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction reverted, hash: %s", tx.Hash())
+	}
+
+	return nil
+}
+
+// isCancellation checks whether the given transaction is a self-transfer cancellation.
+func (m *Manager) isCancellation(tx *types.Transaction) bool {
+	return tx.To() != nil && *tx.To() == m.address && len(tx.Data()) == 0
+}
+
+// resubmit checks all the pending transactions, the stuck ones are cancelled by self-transfers,
+// and the ones not mined after the resubmission timeout are replaced with higher gas tips.
+func (m *Manager) resubmit(ctx context.Context) error {
+	confirmed, err := m.rpc.L1.NonceAt(ctx, m.address, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get confirmed nonce: %w", err)
+	}
+
+	m.mutex.Lock()
+	m.prune(confirmed)
+	var pending []*PendingTx
+	for _, p := range m.pending {
+		if time.Since(p.LastSentAt) >= m.cfg.ResubmissionTimeout {
+			pending = append(pending, &PendingTx{
+				Nonce:     p.Nonce,
+				Txs:       []*types.Transaction{p.latest()},
+				SentAt:    p.SentAt,
+				Cancelled: p.Cancelled,
+			})
+		}
+	}
+	m.mutex.Unlock()
+
+	sort.Slice(pending, func(i, j int) bool { return pending[i].Nonce < pending[j].Nonce })
+
+	for _, p := range pending {
+		var (
+			tx        *types.Transaction
+			cancelled = p.Cancelled
+		)
+		if !p.Cancelled && time.Since(p.SentAt) >= m.cfg.StuckTimeout {
+			log.Warn(
+				"Cancelling stuck transaction with a self-transfer",
+				"address", m.address,
+				"nonce", p.Nonce,
+				"hash", p.latest().Hash(),
+				"pendingFor", time.Since(p.SentAt),
+			)
+			if tx, err = m.sendCancellation(ctx, p.Nonce, p.latest()); err != nil {
+				log.Warn("Failed to cancel stuck transaction", "nonce", p.Nonce, "error", err)
+				continue
+			}
+			cancelled = true
+			metrics.ProposerTxCancelledCounter.Inc(1)
+		} else {
+			if tx, err = m.sendReplacement(ctx, p.latest(), p.Cancelled); err != nil {
+				log.Warn("Failed to replace pending transaction", "nonce", p.Nonce, "error", err)
+				continue
+			}
+			metrics.ProposerTxReplacedCounter.Inc(1)
+		}
+
+		m.mutex.Lock()
+		if tracked, ok := m.pending[p.Nonce]; ok {
+			tracked.Txs = append(tracked.Txs, tx)
+			tracked.LastSentAt = time.Now()
+			tracked.Cancelled = cancelled
+		}
+		m.mutex.Unlock()
+	}
+
+	return nil
+}
+
+// sendReplacement replaces the given transaction with a same one with a higher gas tip, the
+// cancellations are not limited by the maximum gas tip cap.
+func (m *Manager) sendReplacement(
+	ctx context.Context,
+	original *types.Transaction,
+	isCancellation bool,
+) (*types.Transaction, error) {
+	maxGasTipCap := m.cfg.MaxGasTipCap
+	if isCancellation {
+		maxGasTipCap = nil
+	}
+
+	opts, err := rpc.IncreaseGasTipCap(
+		ctx,
+		m.rpc,
+		&bind.TransactOpts{Nonce: new(big.Int).SetUint64(original.Nonce()), GasTipCap: original.GasTipCap()},
+		m.address,
+		m.cfg.ReplacementMultiplier,
+		maxGasTipCap,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := newReplacementTx(original, opts.GasTipCap, m.cfg.ReplacementMultiplier)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info(
+		"Replacing pending transaction",
+		"address", m.address,
+		"nonce", original.Nonce(),
+		"original", original.Hash(),
+		"gasTipCap", tx.GasTipCap(),
+		"gasFeeCap", tx.GasFeeCap(),
+	)
+
+	return m.send(ctx, tx)
+}
+
+// sendCancellation sends a self-transfer with the given nonce, if an original transaction is given,
+// the self-transfer replaces it with a higher gas tip.
+func (m *Manager) sendCancellation(
+	ctx context.Context,
+	nonce uint64,
+	original *types.Transaction,
+) (*types.Transaction, error) {
+	if original != nil && original.Type() == types.BlobTxType {
+		// The L1 transaction pool doesn't allow a blob transaction to be replaced by a non-blob one.
+		return nil, fmt.Errorf("can not cancel blob transaction %s", original.Hash())
+	}
+
+	gasTipCap, err := m.rpc.L1.SuggestGasTipCap(ctx)
+	if err != nil {
+		if !rpc.IsMaxPriorityFeePerGasNotFoundError(err) {
+			return nil, err
+		}
+		gasTipCap = rpc.FallbackGasTipCap
+	}
+	if original != nil {
+		opts, err := rpc.IncreaseGasTipCap(
+			ctx,
+			m.rpc,
+			&bind.TransactOpts{Nonce: new(big.Int).SetUint64(nonce), GasTipCap: original.GasTipCap()},
+			m.address,
+			m.cfg.ReplacementMultiplier,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if opts.GasTipCap.Cmp(gasTipCap) > 0 {
+			gasTipCap = opts.GasTipCap
+		}
+	}
+
+	head, err := m.rpc.L1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	gasFeeCap := new(big.Int).Add(gasTipCap, new(big.Int).Mul(head.BaseFee, common.Big2))
+	if original != nil {
+		if bumped := new(big.Int).Mul(original.GasFeeCap(), m.cfg.ReplacementMultiplier); bumped.Cmp(gasFeeCap) > 0 {
+			gasFeeCap = bumped
+		}
+	}
+
+	return m.send(ctx, types.NewTx(&types.DynamicFeeTx{
+		ChainID:   m.rpc.L1ChainID,
+		Nonce:     nonce,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		Gas:       params.TxGas,
+		To:        &m.address,
+		Value:     common.Big0,
+	}))
+}
+
+// send signs and sends the given transaction.
+func (m *Manager) send(ctx context.Context, tx *types.Transaction) (*types.Transaction, error) {
+	signed, err := m.signer.SignTx(ctx, tx, m.rpc.L1ChainID)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.rpc.L1.SendTransaction(ctx, signed); err != nil {
+		return nil, err
+	}
+
+	return signed, nil
+}
+
+// prune removes the pending transactions whose nonces have been confirmed.
+func (m *Manager) prune(confirmed uint64) {
+	for nonce := range m.pending {
+		if nonce < confirmed {
+			delete(m.pending, nonce)
+		}
+	}
+	metrics.ProposerPendingTxsGauge.Update(int64(len(m.pending)))
+}
+
+// remove stops tracking the transaction with the given nonce.
+func (m *Manager) remove(nonce uint64) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	delete(m.pending, nonce)
+	metrics.ProposerPendingTxsGauge.Update(int64(len(m.pending)))
+}
+
+// newReplacementTx creates an unsigned copy of the given transaction with the given gas tip, the
+// gas fee cap and the blob fee cap are bumped by the given multiplier.
+func newReplacementTx(tx *types.Transaction, gasTipCap *big.Int, multiplier *big.Int) (*types.Transaction, error) {
+	gasFeeCap := new(big.Int).Mul(tx.GasFeeCap(), multiplier)
+	if gasFeeCap.Cmp(gasTipCap) < 0 {
+		gasFeeCap = new(big.Int).Set(gasTipCap)
+	}
+
+	switch tx.Type() {
+	case types.DynamicFeeTxType:
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    tx.ChainId(),
+			Nonce:      tx.Nonce(),
+			GasTipCap:  gasTipCap,
+			GasFeeCap:  gasFeeCap,
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		}), nil
+	case types.BlobTxType:
+		// The sidecar is not available for the transactions reconciled from the mempool.
+		if tx.BlobTxSidecar() == nil {
+			return nil, fmt.Errorf("blob sidecar of transaction %s not available", tx.Hash())
+		}
+
+		return types.NewTx(&types.BlobTx{
+			ChainID:    uint256.MustFromBig(tx.ChainId()),
+			Nonce:      tx.Nonce(),
+			GasTipCap:  uint256.MustFromBig(gasTipCap),
+			GasFeeCap:  uint256.MustFromBig(gasFeeCap),
+			Gas:        tx.Gas(),
+			To:         *tx.To(),
+			Value:      uint256.MustFromBig(tx.Value()),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+			BlobFeeCap: uint256.MustFromBig(new(big.Int).Mul(tx.BlobGasFeeCap(), multiplier)),
+			BlobHashes: tx.BlobHashes(),
+			Sidecar:    tx.BlobTxSidecar(),
+		}), nil
+	default:
+		return nil, errUnsupportedTx
+	}
+}
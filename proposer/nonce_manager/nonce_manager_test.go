@@ -0,0 +1,193 @@
+package nonce
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
+)
+
+// testReceiptService is a mock of the `eth` namespace of a L1 node, which only knows the receipts
+// of the mined transactions.
+type testReceiptService struct {
+	receipts map[common.Hash]*types.Receipt
+}
+
+func (s *testReceiptService) GetTransactionReceipt(hash common.Hash) (*types.Receipt, error) {
+	return s.receipts[hash], nil
+}
+
+func newTestManager(t *testing.T) *Manager {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	s, err := signer.New(&signer.Config{PrivateKey: key})
+	require.Nil(t, err)
+
+	m := New(nil, s, &Config{ReplacementMultiplier: common.Big2})
+	m.initialized = true
+	m.nextNonce = 10
+
+	return m
+}
+
+func TestAcquireRelease(t *testing.T) {
+	m := newTestManager(t)
+
+	for i := uint64(0); i < 3; i++ {
+		nonce, err := m.Acquire(context.Background())
+		require.Nil(t, err)
+		require.Equal(t, 10+i, nonce)
+	}
+
+	// The released nonces are reserved again, if they are the last reserved ones.
+	require.Nil(t, m.Release(context.Background(), 12))
+	require.Nil(t, m.Release(context.Background(), 11))
+
+	nonce, err := m.Acquire(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, uint64(11), nonce)
+}
+
+func TestTrack(t *testing.T) {
+	m := newTestManager(t)
+	require.Equal(t, defaultResubmissionTimeout, m.cfg.ResubmissionTimeout)
+	require.Equal(t, defaultStuckTimeout, m.cfg.StuckTimeout)
+
+	tx := types.NewTx(&types.DynamicFeeTx{Nonce: 11, GasTipCap: common.Big1, GasFeeCap: common.Big2})
+	replacement := types.NewTx(&types.DynamicFeeTx{Nonce: 11, GasTipCap: common.Big2, GasFeeCap: common.Big3})
+
+	m.Track(types.NewTx(&types.DynamicFeeTx{Nonce: 10}))
+	m.Track(tx)
+	m.Track(replacement)
+	require.Equal(t, []uint64{10, 11}, m.Pending())
+
+	txs := m.replacements(tx)
+	require.Len(t, txs, 2)
+	require.Equal(t, replacement.Hash(), txs[1].Hash())
+
+	m.prune(11)
+	require.Equal(t, []uint64{11}, m.Pending())
+	m.remove(11)
+	require.Empty(t, m.Pending())
+	require.Len(t, m.replacements(tx), 1)
+}
+
+func TestFindMined(t *testing.T) {
+	var (
+		m           = newTestManager(t)
+		service     = &testReceiptService{receipts: make(map[common.Hash]*types.Receipt)}
+		tx          = types.NewTx(&types.DynamicFeeTx{Nonce: 11, GasTipCap: common.Big1, GasFeeCap: common.Big2})
+		replacement = types.NewTx(&types.DynamicFeeTx{Nonce: 11, GasTipCap: common.Big2, GasFeeCap: common.Big3})
+	)
+
+	rpcServer := gethRPC.NewServer()
+	require.Nil(t, rpcServer.RegisterName("eth", service))
+	t.Cleanup(rpcServer.Stop)
+
+	server := httptest.NewServer(rpcServer)
+	t.Cleanup(server.Close)
+
+	l1, err := rpc.NewEthClient(context.Background(), server.URL, time.Second)
+	require.Nil(t, err)
+	t.Cleanup(l1.Close)
+	m.rpc = &rpc.Client{L1: l1}
+
+	mined, receipt := m.FindMined(context.Background(), []*types.Transaction{tx})
+	require.Nil(t, mined)
+	require.Nil(t, receipt)
+
+	// A tracked replacement of the given transaction is mined.
+	m.Track(tx)
+	m.Track(replacement)
+	service.receipts[replacement.Hash()] = &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		TxHash:      replacement.Hash(),
+		BlockNumber: common.Big1,
+		Logs:        []*types.Log{},
+	}
+
+	mined, receipt = m.FindMined(context.Background(), []*types.Transaction{tx})
+	require.Equal(t, replacement.Hash(), mined.Hash())
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+}
+
+func TestIsCancellation(t *testing.T) {
+	m := newTestManager(t)
+	other := common.HexToAddress("0x01")
+
+	require.True(t, m.isCancellation(types.NewTx(&types.DynamicFeeTx{To: &m.address})))
+	require.False(t, m.isCancellation(types.NewTx(&types.DynamicFeeTx{To: &m.address, Data: []byte{1}})))
+	require.False(t, m.isCancellation(types.NewTx(&types.DynamicFeeTx{To: &other})))
+	require.False(t, m.isCancellation(types.NewTx(&types.DynamicFeeTx{})))
+
+	cancellation := types.NewTx(&types.DynamicFeeTx{To: &m.address})
+	require.ErrorIs(t, m.checkReceipt(cancellation, new(types.Receipt)), errTxCancelled)
+	require.ErrorContains(
+		t,
+		m.checkReceipt(types.NewTx(&types.DynamicFeeTx{To: &other}), &types.Receipt{Status: types.ReceiptStatusFailed}),
+		"transaction reverted",
+	)
+	require.Nil(
+		t,
+		m.checkReceipt(types.NewTx(&types.DynamicFeeTx{To: &other}), &types.Receipt{Status: types.ReceiptStatusSuccessful}),
+	)
+}
+
+func TestNewReplacementTx(t *testing.T) {
+	to := common.HexToAddress("0x01")
+
+	tx, err := newReplacementTx(types.NewTx(&types.DynamicFeeTx{
+		ChainID:   common.Big1,
+		Nonce:     1,
+		GasTipCap: big.NewInt(10),
+		GasFeeCap: big.NewInt(100),
+		Gas:       21000,
+		To:        &to,
+		Data:      []byte{1},
+	}), big.NewInt(20), common.Big2)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), tx.Nonce())
+	require.Equal(t, big.NewInt(20), tx.GasTipCap())
+	require.Equal(t, big.NewInt(200), tx.GasFeeCap())
+	require.Equal(t, []byte{1}, tx.Data())
+
+	// The gas fee cap is never lower than the gas tip cap.
+	tx, err = newReplacementTx(types.NewTx(&types.DynamicFeeTx{
+		GasTipCap: big.NewInt(10),
+		GasFeeCap: big.NewInt(10),
+	}), big.NewInt(1000), common.Big2)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(1000), tx.GasFeeCap())
+
+	blobTx := &types.BlobTx{
+		ChainID:    uint256.NewInt(1),
+		GasTipCap:  uint256.NewInt(10),
+		GasFeeCap:  uint256.NewInt(100),
+		To:         to,
+		BlobFeeCap: uint256.NewInt(5),
+	}
+	_, err = newReplacementTx(types.NewTx(blobTx), big.NewInt(20), common.Big2)
+	require.ErrorContains(t, err, "blob sidecar")
+
+	blobTx.Sidecar = &types.BlobTxSidecar{Blobs: []kzg4844.Blob{{}}}
+	tx, err = newReplacementTx(types.NewTx(blobTx), big.NewInt(20), common.Big2)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(10), tx.BlobGasFeeCap())
+	require.NotNil(t, tx.BlobTxSidecar())
+
+	_, err = newReplacementTx(types.NewTx(&types.LegacyTx{GasPrice: common.Big1}), big.NewInt(20), common.Big2)
+	require.ErrorIs(t, err, errUnsupportedTx)
+}
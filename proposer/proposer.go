@@ -3,13 +3,15 @@ package proposer
 import (
 	"bytes"
 	"context"
-	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"math/big"
 	"math/rand"
+	"os"
+	"os/signal"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -19,6 +21,9 @@ import (
 	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/leveldb"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/urfave/cli/v2"
@@ -28,7 +33,10 @@ import (
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-client/internal/metrics"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
+	nonce "github.com/taikoxyz/taiko-client/proposer/nonce_manager"
 	selector "github.com/taikoxyz/taiko-client/proposer/prover_selector"
+	policy "github.com/taikoxyz/taiko-client/proposer/tx_policy"
 )
 
 var (
@@ -45,7 +53,8 @@ type Proposer struct {
 	rpc *rpc.Client
 
 	*Config
-	// Private keys and account addresses
+	// Signer and account addresses
+	signer          signer.Signer
 	proposerAddress common.Address
 
 	proposingTimer *time.Timer
@@ -54,7 +63,18 @@ type Proposer struct {
 	tierFees []encoding.TierFee
 
 	// Prover selector
-	proverSelector selector.ProverSelector
+	proverSelector    selector.ProverSelector
+	reputationTracker *selector.ReputationTracker
+	reputationDB      ethdb.KeyValueStore
+
+	// Transaction inclusion policy
+	txPolicy *policy.Engine
+
+	// Proposing transactions nonce manager
+	nonceManager *nonce.Manager
+
+	// L1 gas price aware proposal scheduler
+	scheduler *proposalScheduler
 
 	// Protocol configurations
 	protocolConfigs *bindings.TaikoDataConfig
@@ -79,7 +99,12 @@ func (p *Proposer) InitFromCli(ctx context.Context, c *cli.Context) error {
 
 // InitFromConfig initializes the proposer instance based on the given configurations.
 func (p *Proposer) InitFromConfig(ctx context.Context, cfg *Config) (err error) {
-	p.proposerAddress = crypto.PubkeyToAddress(cfg.L1ProposerPrivKey.PublicKey)
+	if p.signer = cfg.L1ProposerSigner; p.signer == nil {
+		if p.signer, err = signer.New(&signer.Config{PrivateKey: cfg.L1ProposerPrivKey}); err != nil {
+			return fmt.Errorf("invalid L1 proposer signer: %w", err)
+		}
+	}
+	p.proposerAddress = p.signer.Address()
 	p.wg = sync.WaitGroup{}
 	p.ctx = ctx
 	p.Config = cfg
@@ -105,31 +130,171 @@ func (p *Proposer) InitFromConfig(ctx context.Context, cfg *Config) (err error)
 		return err
 	}
 
-	if p.proverSelector, err = selector.NewETHFeeEOASelector(
-		&protocolConfigs,
-		p.rpc,
-		cfg.TaikoL1Address,
-		cfg.AssignmentHookAddress,
-		p.tierFees,
-		cfg.TierFeePriceBump,
-		cfg.ProverEndpoints,
-		cfg.MaxTierFeePriceBumps,
-		proverAssignmentTimeout,
-		requestProverServerTimeout,
-	); err != nil {
-		return err
+	p.nonceManager = nonce.New(p.rpc, p.signer, &nonce.Config{
+		ReplacementMultiplier: new(big.Int).SetUint64(cfg.ProposeBlockTxReplacementMultiplier),
+		MaxGasTipCap:          cfg.ProposeBlockTxGasTipCap,
+		ResubmissionTimeout:   cfg.TxResubmissionTimeout,
+		StuckTimeout:          cfg.TxStuckTimeout,
+	})
+
+	if cfg.ProposalScheduling {
+		p.scheduler = &proposalScheduler{
+			rpc:               p.rpc,
+			feeHistoryBlocks:  cfg.SchedulerFeeHistoryBlocks,
+			feeThreshold:      cfg.SchedulerFeeThreshold,
+			maxDelay:          cfg.SchedulerMaxDelay,
+			maxPendingBytes:   cfg.SchedulerMaxPendingBytes,
+			maxBaseFee:        cfg.SchedulerMaxBaseFee,
+			maxBlobBaseFee:    cfg.SchedulerMaxBlobBaseFee,
+			maxTxListBytes:    p.protocolConfigs.BlockMaxTxListBytes.Uint64(),
+			blobFeeConsidered: cfg.BlobAllowed && p.protocolConfigs.BlobAllowedForDA,
+		}
 	}
 
-	return nil
+	if cfg.TxPolicy != nil {
+		if p.txPolicy, err = policy.New(cfg.TxPolicy, p.rpc.L2ChainID); err != nil {
+			return fmt.Errorf("failed to load transaction inclusion policy: %w", err)
+		}
+	}
+
+	return p.initProverSelector(cfg)
+}
+
+// initProverSelector initializes the prover selector based on the given configurations.
+func (p *Proposer) initProverSelector(cfg *Config) (err error) {
+	switch {
+	case cfg.FeeToken != (common.Address{}):
+		p.proverSelector, err = selector.NewERC20FeeSelector(
+			p.protocolConfigs,
+			p.rpc,
+			cfg.TaikoL1Address,
+			cfg.AssignmentHookAddress,
+			cfg.FeeToken,
+			p.signer,
+			cfg.FeeTokenApproveAmount,
+			p.tierFees,
+			cfg.TierFeePriceBump,
+			cfg.ProverEndpoints,
+			cfg.MaxTierFeePriceBumps,
+			proverAssignmentTimeout,
+			requestProverServerTimeout,
+		)
+	case cfg.ProverAuction:
+		p.proverSelector, err = selector.NewAuctionSelector(
+			p.protocolConfigs,
+			p.rpc,
+			cfg.TaikoL1Address,
+			cfg.AssignmentHookAddress,
+			cfg.ProverAuctionDeadline,
+			p.tierFees,
+			cfg.TierFeePriceBump,
+			cfg.ProverEndpoints,
+			cfg.MaxTierFeePriceBumps,
+			proverAssignmentTimeout,
+			requestProverServerTimeout,
+		)
+	case cfg.ProverReputation:
+		if cfg.ProverReputationDBPath != "" {
+			if p.reputationDB, err = leveldb.New(
+				cfg.ProverReputationDBPath,
+				16,
+				16, // Minimum number of files handles is 16 in leveldb.
+				"taiko",
+				false,
+			); err != nil {
+				return err
+			}
+		} else {
+			p.reputationDB = memorydb.New()
+		}
+
+		p.reputationTracker = selector.NewReputationTracker(
+			p.reputationDB,
+			p.rpc,
+			p.protocolConfigs.LivenessBond,
+			p.tiers,
+		)
+		p.proverSelector, err = selector.NewReputationSelector(
+			p.protocolConfigs,
+			p.rpc,
+			cfg.TaikoL1Address,
+			cfg.AssignmentHookAddress,
+			p.reputationTracker,
+			cfg.ProverReliabilityWeight,
+			p.tierFees,
+			cfg.TierFeePriceBump,
+			cfg.ProverEndpoints,
+			cfg.MaxTierFeePriceBumps,
+			proverAssignmentTimeout,
+			requestProverServerTimeout,
+		)
+	default:
+		p.proverSelector, err = selector.NewETHFeeEOASelector(
+			p.protocolConfigs,
+			p.rpc,
+			cfg.TaikoL1Address,
+			cfg.AssignmentHookAddress,
+			p.tierFees,
+			cfg.TierFeePriceBump,
+			cfg.ProverEndpoints,
+			cfg.MaxTierFeePriceBumps,
+			proverAssignmentTimeout,
+			requestProverServerTimeout,
+		)
+	}
+
+	return err
 }
 
 // Start starts the proposer's main loop.
 func (p *Proposer) Start() error {
+	if p.reputationTracker != nil {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.reputationTracker.Start(p.ctx)
+		}()
+	}
+
+	if p.txPolicy != nil {
+		p.wg.Add(1)
+		go p.reloadTxPolicyLoop()
+	}
+
+	// No transaction is sent in dry-run mode, so there is nothing to track.
+	if !p.DryRun {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.nonceManager.Start(p.ctx)
+		}()
+	}
+
 	p.wg.Add(1)
 	go p.eventLoop()
 	return nil
 }
 
+// reloadTxPolicyLoop reloads the transaction inclusion policy each time a SIGHUP is received.
+func (p *Proposer) reloadTxPolicyLoop() {
+	defer p.wg.Done()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+	defer signal.Stop(sigCh)
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-sigCh:
+			if err := p.txPolicy.Reload(); err != nil {
+				log.Error("Failed to reload transaction inclusion policy", "error", err)
+			}
+		}
+	}
+}
+
 // eventLoop starts the main loop of Taiko proposer.
 func (p *Proposer) eventLoop() {
 	defer func() {
@@ -149,6 +314,9 @@ func (p *Proposer) eventLoop() {
 			metrics.ProposerProposeEpochCounter.Inc(1)
 			// attempt propose operation
 			if err := p.ProposeOp(p.ctx); err != nil {
+				if errors.Is(err, errProposalHeld) {
+					continue
+				}
 				if !errors.Is(err, errNoNewTxs) {
 					log.Error("Proposing operation error", "error", err)
 					continue
@@ -177,6 +345,12 @@ func (p *Proposer) eventLoop() {
 // Close closes the proposer instance.
 func (p *Proposer) Close(ctx context.Context) {
 	p.wg.Wait()
+
+	if p.reputationDB != nil {
+		if err := p.reputationDB.Close(); err != nil {
+			log.Error("Failed to close prover reputation database", "error", err)
+		}
+	}
 }
 
 // ProposeOp performs a proposing operation, fetching transactions
@@ -187,16 +361,105 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 		return p.CustomProposeOpHook()
 	}
 
+	txListsBytes, txNums, err := p.fetchTxListsBytes(ctx)
+	if err != nil {
+		// A hold only covers the pending transactions, so it ends once the pool is empty.
+		if errors.Is(err, errNoNewTxs) && p.scheduler != nil {
+			p.scheduler.reset()
+		}
+		return err
+	}
+
+	if p.scheduler != nil {
+		propose, err := p.scheduler.shouldPropose(ctx, txListsBytes)
+		if err != nil {
+			return fmt.Errorf("failed to schedule proposal: %w", err)
+		}
+		if !propose {
+			return errProposalHeld
+		}
+	}
+
+	if p.DryRun {
+		_, err := p.simulateTxLists(ctx, txListsBytes, txNums)
+		return err
+	}
+
+	// Top up the fee token allowance first, since the proposing transactions nonces are decided below,
+	// the approval transaction is not sent through the nonce manager, so reconcile it afterwards.
+	if feeSelector, ok := p.proverSelector.(*selector.ERC20FeeSelector); ok {
+		if err := feeSelector.ApproveFeeToken(ctx); err != nil {
+			return fmt.Errorf("failed to approve fee token: %w", err)
+		}
+		if err := p.nonceManager.Reconcile(ctx); err != nil {
+			return fmt.Errorf("failed to reconcile proposer nonce: %w", err)
+		}
+	}
+
+	useBlobs, err := p.shouldProposeWithBlobs(ctx, txListsBytes)
+	if err != nil {
+		return fmt.Errorf("failed to compare L1 data availability costs: %w", err)
+	}
+
+	if useBlobs {
+		metrics.ProposerBlobEpochCounter.Inc(1)
+		if err := p.proposeTxListsWithBlobs(ctx, txListsBytes, txNums); err != nil {
+			return err
+		}
+	} else {
+		metrics.ProposerCalldataEpochCounter.Inc(1)
+
+		// Reserve the nonces in order first, so that the proposing transactions sent in parallel
+		// have continuous nonces.
+		nonces := make([]uint64, len(txListsBytes))
+		for i := range txListsBytes {
+			if nonces[i], err = p.nonceManager.Acquire(ctx); err != nil {
+				return fmt.Errorf("failed to acquire proposer nonce: %w", err)
+			}
+		}
+
+		log.Info("Proposer account information", "nonces", nonces)
+
+		g := new(errgroup.Group)
+		for i, txListBytes := range txListsBytes {
+			func(i int, txListBytes []byte) {
+				g.Go(func() error {
+					if err := p.ProposeTxList(ctx, txListBytes, txNums[i], &nonces[i]); err != nil {
+						return fmt.Errorf("failed to propose transactions: %w", err)
+					}
+
+					return nil
+				})
+			}(i, txListBytes)
+		}
+
+		if err := g.Wait(); err != nil {
+			return fmt.Errorf("failed to propose transactions: %w", err)
+		}
+	}
+
+	if p.AfterCommitHook != nil {
+		if err := p.AfterCommitHook(); err != nil {
+			log.Error("Run AfterCommitHook error", "error", err)
+		}
+	}
+
+	return nil
+}
+
+// fetchTxListsBytes fetches the transactions lists from L2 execution engine's tx pool, applies the
+// local addresses filter and the inclusion policy, and encodes the lists to be proposed in this epoch.
+func (p *Proposer) fetchTxListsBytes(ctx context.Context) ([][]byte, []uint, error) {
 	// Wait until L2 execution engine is synced at first.
 	if err := p.rpc.WaitTillL2ExecutionEngineSynced(ctx); err != nil {
-		return fmt.Errorf("failed to wait until L2 execution engine synced: %w", err)
+		return nil, nil, fmt.Errorf("failed to wait until L2 execution engine synced: %w", err)
 	}
 
 	log.Info("Start fetching L2 execution engine's transaction pool content")
 
 	l2Head, err := p.rpc.L2.HeaderByNumber(ctx, nil)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	baseFee, err := p.rpc.TaikoL2.GetBasefee(
@@ -205,7 +468,7 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 		uint32(l2Head.GasUsed),
 	)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	log.Info("Current base fee", "fee", baseFee)
@@ -220,7 +483,7 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 		p.MaxProposedTxListsPerEpoch,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to fetch transaction pool content: %w", err)
+		return nil, nil, fmt.Errorf("failed to fetch transaction pool content: %w", err)
 	}
 
 	if p.LocalAddressesOnly {
@@ -233,7 +496,7 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 			for _, tx := range txs {
 				sender, err := types.Sender(signer, tx)
 				if err != nil {
-					return err
+					return nil, nil, err
 				}
 
 				for _, localAddress := range p.LocalAddresses {
@@ -250,64 +513,41 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 		txLists = localTxsLists
 	}
 
+	if p.txPolicy != nil {
+		if txLists, err = p.txPolicy.Filter(txLists, baseFee); err != nil {
+			return nil, nil, fmt.Errorf("failed to apply transaction inclusion policy: %w", err)
+		}
+	}
+
 	log.Info("Transactions lists count", "count", len(txLists))
 
 	if len(txLists) == 0 {
-		return errNoNewTxs
+		return nil, nil, errNoNewTxs
 	}
 
-	head, err := p.rpc.L1.BlockNumber(ctx)
-	if err != nil {
-		return err
-	}
-	nonce, err := p.rpc.L1.NonceAt(
-		ctx,
-		crypto.PubkeyToAddress(p.L1ProposerPrivKey.PublicKey),
-		new(big.Int).SetUint64(head),
+	var (
+		txListsBytes [][]byte
+		txNums       []uint
 	)
-	if err != nil {
-		return err
-	}
-
-	log.Info("Proposer account information", "chainHead", head, "nonce", nonce)
-
-	g := new(errgroup.Group)
 	for i, txs := range txLists {
-		func(i int, txs types.Transactions) {
-			g.Go(func() error {
-				if i >= int(p.MaxProposedTxListsPerEpoch) {
-					return nil
-				}
-
-				txListBytes, err := rlp.EncodeToBytes(txs)
-				if err != nil {
-					return fmt.Errorf("failed to encode transactions: %w", err)
-				}
-
-				txNonce := nonce + uint64(i)
-				if err := p.ProposeTxList(ctx, txListBytes, uint(txs.Len()), &txNonce); err != nil {
-					return fmt.Errorf("failed to propose transactions: %w", err)
-				}
-
-				return nil
-			})
-		}(i, txs)
-	}
-
-	if err := g.Wait(); err != nil {
-		return fmt.Errorf("failed to propose transactions: %w", err)
-	}
+		if i >= int(p.MaxProposedTxListsPerEpoch) {
+			break
+		}
 
-	if p.AfterCommitHook != nil {
-		if err := p.AfterCommitHook(); err != nil {
-			log.Error("Run AfterCommitHook error", "error", err)
+		txListBytes, err := rlp.EncodeToBytes(txs)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to encode transactions: %w", err)
 		}
+
+		txListsBytes = append(txListsBytes, txListBytes)
+		txNums = append(txNums, uint(txs.Len()))
 	}
 
-	return nil
+	return txListsBytes, txNums, nil
 }
 
-// sendProposeBlockTx tries to send a TaikoL1.proposeBlock transaction.
+// sendProposeBlockTx tries to send a TaikoL1.proposeBlock transaction. If sending the signed transaction
+// fails, it's still returned along with the error, since the L1 node may have accepted it.
 func (p *Proposer) sendProposeBlockTx(
 	ctx context.Context,
 	txListBytes []byte,
@@ -316,9 +556,10 @@ func (p *Proposer) sendProposeBlockTx(
 	assignedProver common.Address,
 	maxFee *big.Int,
 	isReplacement bool,
+	blobOpts *blobProposeOptions,
 ) (*types.Transaction, error) {
 	// Propose the transactions list
-	opts, err := getTxOpts(ctx, p.rpc.L1, p.L1ProposerPrivKey, p.rpc.L1ChainID, maxFee)
+	opts, err := getTxOpts(ctx, p.rpc.L1, p.signer, p.rpc.L1ChainID, maxFee)
 	if err != nil {
 		return nil, err
 	}
@@ -339,6 +580,48 @@ func (p *Proposer) sendProposeBlockTx(
 		}
 	}
 
+	encodedParams, err := p.encodeBlockParams(ctx, assignment, assignedProver, blobOpts)
+	if err != nil {
+		return nil, err
+	}
+	// The transactions list will be read from the blob, if proposing with a blob.
+	if blobOpts != nil {
+		txListBytes = []byte{}
+	}
+
+	var (
+		proposeTx *types.Transaction
+		signedTx  *types.Transaction
+		signFn    = opts.Signer
+	)
+	opts.Signer = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		signed, err := signFn(address, tx)
+		signedTx = signed
+		return signed, err
+	}
+	if blobOpts != nil && blobOpts.Sidecar != nil {
+		proposeTx, err = p.sendBlobTx(ctx, opts, encodedParams, blobOpts.Sidecar, isReplacement)
+	} else {
+		proposeTx, err = p.rpc.TaikoL1.ProposeBlock(opts, encodedParams, txListBytes)
+	}
+	if err != nil {
+		if proposeTx == nil {
+			proposeTx = signedTx
+		}
+		return proposeTx, encoding.TryParsingCustomError(err)
+	}
+
+	return proposeTx, nil
+}
+
+// encodeBlockParams builds and encodes the TaikoL1.proposeBlock parameters with the given prover
+// assignment, if the blob options are given, the parameters will point to the blob.
+func (p *Proposer) encodeBlockParams(
+	ctx context.Context,
+	assignment *encoding.ProverAssignment,
+	assignedProver common.Address,
+	blobOpts *blobProposeOptions,
+) ([]byte, error) {
 	var parentMetaHash = [32]byte{}
 	if p.IncludeParentMetaHash {
 		state, err := p.rpc.TaikoL1.State(&bind.CallOpts{Context: ctx})
@@ -371,7 +654,7 @@ func (p *Proposer) sendProposeBlockTx(
 		Data: hookInputData,
 	})
 
-	encodedParams, err := encoding.EncodeBlockParams(&encoding.BlockParams{
+	blockParams := &encoding.BlockParams{
 		AssignedProver:    assignedProver,
 		ExtraData:         rpc.StringToBytes32(p.ExtraData),
 		TxListByteOffset:  common.Big0,
@@ -380,21 +663,19 @@ func (p *Proposer) sendProposeBlockTx(
 		CacheBlobForReuse: false,
 		ParentMetaHash:    parentMetaHash,
 		HookCalls:         hookCalls,
-	})
-	if err != nil {
-		return nil, err
 	}
-
-	proposeTx, err := p.rpc.TaikoL1.ProposeBlock(
-		opts,
-		encodedParams,
-		txListBytes,
-	)
-	if err != nil {
-		return nil, encoding.TryParsingCustomError(err)
+	if blobOpts != nil {
+		// The blob hash should be left empty when the blob is attached to the current transaction,
+		// then protocol will use `blobhash(0)` instead.
+		if blobOpts.Sidecar == nil {
+			blockParams.BlobHash = blobOpts.BlobHash
+		}
+		blockParams.TxListByteOffset = new(big.Int).SetUint64(blobOpts.Offset)
+		blockParams.TxListByteSize = new(big.Int).SetUint64(blobOpts.Size)
+		blockParams.CacheBlobForReuse = blobOpts.CacheForReuse
 	}
 
-	return proposeTx, nil
+	return encoding.EncodeBlockParams(blockParams)
 }
 
 // ProposeTxList proposes the given transactions list to TaikoL1 smart contract.
@@ -404,18 +685,48 @@ func (p *Proposer) ProposeTxList(
 	txNum uint,
 	nonce *uint64,
 ) error {
+	return p.proposeTxList(ctx, txListBytes, txNum, nonce, nil)
+}
+
+// proposeTxList proposes the given transactions list to TaikoL1 smart contract, if the blob options
+// are given, the transactions list will be proposed with a blob. The given nonce must be reserved
+// from the nonce manager, a new one is reserved if it's nil.
+func (p *Proposer) proposeTxList(
+	ctx context.Context,
+	txListBytes []byte,
+	txNum uint,
+	nonce *uint64,
+	blobOpts *blobProposeOptions,
+) error {
+	if nonce == nil {
+		reserved, err := p.nonceManager.Acquire(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to acquire proposer nonce: %w", err)
+		}
+		nonce = &reserved
+	}
+
+	// The prover assignment is signed over the blob hash when proposing with a blob.
+	txListHash := crypto.Keccak256Hash(txListBytes)
+	if blobOpts != nil {
+		txListHash = blobOpts.BlobHash
+	}
+
 	assignment, proverAddress, maxFee, err := p.proverSelector.AssignProver(
 		ctx,
 		p.tierFees,
-		crypto.Keccak256Hash(txListBytes),
+		txListHash,
 	)
 	if err != nil {
+		p.releaseNonce(*nonce)
 		return err
 	}
 
 	var (
 		isReplacement bool
 		tx            *types.Transaction
+		// All the signed attempts, any of them may have been accepted by the L1 node.
+		attempts []*types.Transaction
 	)
 	if err := backoff.Retry(
 		func() error {
@@ -430,10 +741,34 @@ func (p *Proposer) ProposeTxList(
 				proverAddress,
 				maxFee,
 				isReplacement,
+				blobOpts,
 			); err != nil {
 				log.Warn("Failed to send taikoL1.proposeBlock transaction", "error", encoding.TryParsingCustomError(err))
+				if tx != nil {
+					attempts = append(attempts, tx)
+				}
 				if strings.Contains(err.Error(), core.ErrNonceTooLow.Error()) {
-					return nil
+					// The nonce may have been consumed by a previous attempt, in which case the transactions
+					// list has already been proposed, and must not be proposed again with a new nonce.
+					if mined := p.findProposedAttempt(ctx, attempts); mined != nil {
+						if mined.Nonce() != *nonce {
+							p.releaseNonce(*nonce)
+						}
+						minedNonce := mined.Nonce()
+						tx, nonce, err = mined, &minedNonce, nil
+						return nil
+					}
+					// The nonce has been consumed by another transaction, reconcile the nonce manager
+					// with the L1 node and retry with a new nonce.
+					if reconcileErr := p.nonceManager.Reconcile(ctx); reconcileErr != nil {
+						return reconcileErr
+					}
+					reserved, acquireErr := p.nonceManager.Acquire(ctx)
+					if acquireErr != nil {
+						return acquireErr
+					}
+					nonce, isReplacement = &reserved, false
+					return err
 				}
 				if strings.Contains(err.Error(), txpool.ErrReplaceUnderpriced.Error()) {
 					isReplacement = true
@@ -450,19 +785,24 @@ func (p *Proposer) ProposeTxList(
 			uint64(maxSendProposeBlockTxRetry),
 		),
 	); err != nil {
+		p.releaseNonce(*nonce)
 		return err
 	}
 	if ctx.Err() != nil {
+		p.releaseNonce(*nonce)
 		return ctx.Err()
 	}
 	if err != nil {
+		p.releaseNonce(*nonce)
 		return err
 	}
 
+	p.nonceManager.Track(tx)
+
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, p.WaitReceiptTimeout)
 	defer cancel()
 
-	if _, err := rpc.WaitReceipt(ctxWithTimeout, p.rpc.L1, tx); err != nil {
+	if _, err := p.nonceManager.WaitMined(ctxWithTimeout, tx); err != nil {
 		return err
 	}
 
@@ -474,12 +814,46 @@ func (p *Proposer) ProposeTxList(
 	return nil
 }
 
+// findProposedAttempt returns the attempt, or one of its tracked replacements, which has been mined
+// successfully, or nil if none of them has.
+func (p *Proposer) findProposedAttempt(ctx context.Context, attempts []*types.Transaction) *types.Transaction {
+	if len(attempts) == 0 {
+		return nil
+	}
+
+	mined, receipt := p.nonceManager.FindMined(ctx, attempts)
+	if receipt == nil || receipt.Status != types.ReceiptStatusSuccessful {
+		return nil
+	}
+	// A tracked replacement may be a cancellation, which proposes nothing.
+	if mined.To() == nil || *mined.To() != p.TaikoL1Address {
+		return nil
+	}
+
+	log.Info("Previous taikoL1.proposeBlock transaction already mined", "hash", mined.Hash(), "nonce", mined.Nonce())
+
+	return mined
+}
+
+// releaseNonce gives back the given reserved nonce to the nonce manager, since no transaction has been
+// sent with it.
+func (p *Proposer) releaseNonce(nonce uint64) {
+	// Use the proposer's context, since the given context may have been cancelled.
+	if err := p.nonceManager.Release(p.ctx, nonce); err != nil {
+		log.Error("Failed to release proposer nonce", "nonce", nonce, "error", err)
+	}
+}
+
 // ProposeEmptyBlockOp performs a proposing one empty block operation.
 func (p *Proposer) ProposeEmptyBlockOp(ctx context.Context) error {
 	emptyTxListBytes, err := rlp.EncodeToBytes(types.Transactions{})
 	if err != nil {
 		return err
 	}
+	if p.DryRun {
+		_, err := p.simulateTxLists(ctx, [][]byte{emptyTxListBytes}, []uint{0})
+		return err
+	}
 	return p.ProposeTxList(ctx, emptyTxListBytes, 0, nil)
 }
 
@@ -490,7 +864,9 @@ func (p *Proposer) updateProposingTicker() {
 	}
 
 	var duration time.Duration
-	if p.ProposeInterval != nil {
+	if p.scheduler != nil {
+		duration = p.SchedulerCheckInterval
+	} else if p.ProposeInterval != nil {
 		duration = *p.ProposeInterval
 	} else {
 		// Random number between 12 - 120
@@ -539,15 +915,15 @@ func (p *Proposer) initTierFees() error {
 	return nil
 }
 
-// getTxOpts creates a bind.TransactOpts instance using the given private key.
+// getTxOpts creates a bind.TransactOpts instance using the given signer.
 func getTxOpts(
 	ctx context.Context,
 	cli *rpc.EthClient,
-	privKey *ecdsa.PrivateKey,
+	s signer.Signer,
 	chainID *big.Int,
 	fee *big.Int,
 ) (*bind.TransactOpts, error) {
-	opts, err := bind.NewKeyedTransactorWithChainID(privKey, chainID)
+	opts, err := signer.NewTransactor(ctx, s, chainID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate prepareBlock transaction options: %w", err)
 	}
@@ -138,6 +138,32 @@ func (s *ProposerTestSuite) TestProposeEmptyBlockOp() {
 	s.Nil(s.p.ProposeEmptyBlockOp(context.Background()))
 }
 
+func (s *ProposerTestSuite) TestProposeEmptyBlockOpDryRun() {
+	s.p.DryRun = true
+	defer func() { s.p.DryRun = false }()
+
+	head, err := s.p.rpc.L1.BlockNumber(context.Background())
+	s.Nil(err)
+
+	emptyTxListBytes, err := rlp.EncodeToBytes(types.Transactions{})
+	s.Nil(err)
+
+	results, err := s.p.simulateTxLists(context.Background(), [][]byte{emptyTxListBytes}, []uint{0})
+	s.Nil(err)
+	s.Len(results, 1)
+	s.Nil(results[0].Err)
+	s.NotZero(results[0].GasLimit)
+	s.Equal(1, results[0].EstimatedCost.Cmp(results[0].L1Cost))
+
+	// Nothing should be broadcast in dry-run mode.
+	s.Nil(s.p.ProposeEmptyBlockOp(context.Background()))
+	pending, err := s.p.rpc.L1.PendingNonceAt(context.Background(), s.p.proposerAddress)
+	s.Nil(err)
+	nonce, err := s.p.rpc.L1.NonceAt(context.Background(), s.p.proposerAddress, new(big.Int).SetUint64(head))
+	s.Nil(err)
+	s.Equal(nonce, pending)
+}
+
 func (s *ProposerTestSuite) TestCustomProposeOpHook() {
 	flag := false
 
@@ -155,7 +181,7 @@ func (s *ProposerTestSuite) TestSendProposeBlockTx() {
 	opts, err := getTxOpts(
 		context.Background(),
 		s.p.rpc.L1,
-		s.p.L1ProposerPrivKey,
+		s.p.signer,
 		s.RPCClient.L1ChainID,
 		fee,
 	)
@@ -200,6 +226,7 @@ func (s *ProposerTestSuite) TestSendProposeBlockTx() {
 		proverAddress,
 		fee,
 		true,
+		nil,
 	)
 	s.Nil(err)
 	s.Greater(newTx.GasTipCap().Uint64(), tx.GasTipCap().Uint64())
@@ -0,0 +1,272 @@
+package selector
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/go-resty/resty/v2"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/prover/server"
+)
+
+// AuctionSelector is a prover selector implementation which runs a sealed-bid auction across all
+// the registered prover endpoints: every prover quotes its own tier fees at once, and the cheapest
+// valid bid wins. It uses ETHs as prover fee and all provers selected must be EOA accounts.
+type AuctionSelector struct {
+	*ETHFeeEOASelector
+	auctionDeadline time.Duration
+}
+
+// NewAuctionSelector creates a new AuctionSelector instance, the tier fees after all tier fee bumps
+// are used as the highest acceptable bid of each tier.
+func NewAuctionSelector(
+	protocolConfigs *bindings.TaikoDataConfig,
+	rpc *rpc.Client,
+	taikoL1Address common.Address,
+	assignmentHookAddress common.Address,
+	auctionDeadline time.Duration,
+	tiersFee []encoding.TierFee,
+	tierFeePriceBump *big.Int,
+	proverEndpoints []*url.URL,
+	maxTierFeePriceBumpIterations uint64,
+	proposalExpiry time.Duration,
+	requestTimeout time.Duration,
+) (*AuctionSelector, error) {
+	if auctionDeadline <= 0 {
+		return nil, fmt.Errorf("invalid auction deadline %s", auctionDeadline)
+	}
+
+	ethFeeSelector, err := NewETHFeeEOASelector(
+		protocolConfigs,
+		rpc,
+		taikoL1Address,
+		assignmentHookAddress,
+		tiersFee,
+		tierFeePriceBump,
+		proverEndpoints,
+		maxTierFeePriceBumpIterations,
+		proposalExpiry,
+		requestTimeout,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuctionSelector{ethFeeSelector, auctionDeadline}, nil
+}
+
+// AssignProver sends a quote request to all registered prover endpoints at once, and picks the
+// cheapest valid bid received before the auction deadline.
+func (s *AuctionSelector) AssignProver(
+	ctx context.Context,
+	tierFees []encoding.TierFee,
+	txListHash common.Hash,
+) (*encoding.ProverAssignment, common.Address, *big.Int, error) {
+	return s.runAuction(ctx, tierFees, txListHash, false)
+}
+
+// DryRunAssignProver implements the ProverSelector interface.
+func (s *AuctionSelector) DryRunAssignProver(
+	ctx context.Context,
+	tierFees []encoding.TierFee,
+	txListHash common.Hash,
+) (*encoding.ProverAssignment, common.Address, *big.Int, error) {
+	return s.runAuction(ctx, tierFees, txListHash, true)
+}
+
+// runAuction runs a sealed-bid auction for the given transactions list, the bids of a dry-run
+// auction are only valid in the L1 block they are signed in.
+func (s *AuctionSelector) runAuction(
+	ctx context.Context,
+	tierFees []encoding.TierFee,
+	txListHash common.Hash,
+	dryRun bool,
+) (*encoding.ProverAssignment, common.Address, *big.Int, error) {
+	var (
+		expiry  = uint64(time.Now().Add(s.proposalExpiry).Unix())
+		maxFees = bumpedTierFees(tierFees, s.tierFeePriceBump, s.maxTierFeePriceBumpIterations)
+		bids    = make([]*server.QuoteResponse, len(s.proverEndpoints))
+		wg      sync.WaitGroup
+	)
+
+	ctxDeadline, cancel := context.WithTimeout(ctx, s.auctionDeadline)
+	defer cancel()
+
+	for i, endpoint := range s.proverEndpoints {
+		wg.Add(1)
+		go func(i int, endpoint *url.URL) {
+			defer wg.Done()
+
+			bid, err := s.requestQuote(ctxDeadline, endpoint, expiry, maxFees, txListHash, dryRun)
+			if err != nil {
+				log.Warn("Failed to get prover bid", "endpoint", endpoint, "error", err)
+				return
+			}
+			bids[i] = bid
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	for _, bid := range sortBids(bids) {
+		ok, err := rpc.CheckProverBalance(
+			ctx,
+			s.rpc,
+			bid.Prover,
+			s.assignmentHookAddress,
+			s.protocolConfigs.LivenessBond,
+		)
+		if err != nil {
+			log.Warn("Failed to check prover balance", "prover", bid.Prover, "error", err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		log.Info(
+			"Prover assigned by auction",
+			"address", bid.Prover,
+			"tierFees", bid.TierFees,
+			"maxBlockID", bid.MaxBlockID,
+			"expiry", expiry,
+			"endpoints", len(bids),
+		)
+
+		// Convert signature to one solidity can recover by adding 27 to 65th byte
+		bid.SignedPayload[64] = uint8(uint(bid.SignedPayload[64])) + 27
+
+		return &encoding.ProverAssignment{
+			FeeToken:      common.Address{},
+			TierFees:      bid.TierFees,
+			Expiry:        expiry,
+			MaxBlockId:    bid.MaxBlockID,
+			MaxProposedIn: bid.MaxProposedIn,
+			MetaHash:      [32]byte{},
+			Signature:     bid.SignedPayload,
+		}, bid.Prover, maxTierFee(bid.TierFees), nil
+	}
+
+	return nil, common.Address{}, nil, errUnableToFindProver
+}
+
+// requestQuote requests a bid from the given prover endpoint, and validates the bid against the
+// given highest acceptable tier fees.
+func (s *AuctionSelector) requestQuote(
+	ctx context.Context,
+	endpoint *url.URL,
+	expiry uint64,
+	maxFees []encoding.TierFee,
+	txListHash common.Hash,
+	dryRun bool,
+) (*server.QuoteResponse, error) {
+	var (
+		reqBody = &server.CreateQuoteRequestBody{
+			FeeToken:   rpc.ZeroAddress,
+			Tiers:      make([]uint16, len(maxFees)),
+			Expiry:     expiry,
+			TxListHash: txListHash,
+			DryRun:     dryRun,
+		}
+		result = new(server.QuoteResponse)
+	)
+	for i, fee := range maxFees {
+		reqBody.Tiers[i] = fee.Tier
+	}
+
+	requestURL, err := url.JoinPath(endpoint.String(), "/quote")
+	if err != nil {
+		return nil, err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, s.requestTimeout)
+	defer cancel()
+
+	resp, err := resty.New().R().
+		SetContext(ctxTimeout).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("Accept", "application/json").
+		SetBody(reqBody).
+		SetResult(result).
+		Post(requestURL)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("unsuccessful response %d", resp.StatusCode())
+	}
+
+	if err := validateBid(result, maxFees); err != nil {
+		return nil, err
+	}
+
+	if err := verifyAssignmentSignature(
+		s.protocolConfigs.ChainId,
+		s.taikoL1Address,
+		s.assignmentHookAddress,
+		txListHash,
+		rpc.ZeroAddress,
+		expiry,
+		&result.ProposeBlockResponse,
+		result.TierFees,
+	); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// validateBid checks whether the given bid quotes all the requested tiers in order, and none of
+// the quoted fees is higher than the highest acceptable one.
+func validateBid(bid *server.QuoteResponse, maxFees []encoding.TierFee) error {
+	if len(bid.TierFees) != len(maxFees) {
+		return fmt.Errorf("invalid number of quoted tiers %d, expected %d", len(bid.TierFees), len(maxFees))
+	}
+	if len(bid.SignedPayload) != 65 {
+		return fmt.Errorf("invalid signed payload length %d", len(bid.SignedPayload))
+	}
+
+	for i, fee := range bid.TierFees {
+		if fee.Tier != maxFees[i].Tier || fee.Fee == nil || fee.Fee.Sign() < 0 {
+			return fmt.Errorf("invalid quoted tier fee %v", fee)
+		}
+		if fee.Fee.Cmp(maxFees[i].Fee) > 0 {
+			return fmt.Errorf("quoted fee %s of tier %d is too high", fee.Fee, fee.Tier)
+		}
+	}
+
+	return nil
+}
+
+// sortBids returns the received bids sorted by the sum of their quoted tier fees in ascending order.
+func sortBids(bids []*server.QuoteResponse) []*server.QuoteResponse {
+	var (
+		sorted = make([]*server.QuoteResponse, 0, len(bids))
+		totals = make(map[*server.QuoteResponse]*big.Int, len(bids))
+	)
+	for _, bid := range bids {
+		if bid == nil {
+			continue
+		}
+
+		total := new(big.Int)
+		for _, fee := range bid.TierFees {
+			total.Add(total, fee.Fee)
+		}
+		totals[bid] = total
+		sorted = append(sorted, bid)
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool { return totals[sorted[i]].Cmp(totals[sorted[j]]) < 0 })
+
+	return sorted
+}
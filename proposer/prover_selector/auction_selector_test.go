@@ -0,0 +1,166 @@
+package selector
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/prover/server"
+)
+
+func newTestQuoteServer(t *testing.T, chainID uint64, fee *big.Int) (common.Address, *url.URL) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	e := echo.New()
+	e.POST("/quote", func(c echo.Context) error {
+		req := new(server.CreateQuoteRequestBody)
+		if err := c.Bind(req); err != nil {
+			return err
+		}
+
+		tierFees := make([]encoding.TierFee, len(req.Tiers))
+		for i, tier := range req.Tiers {
+			tierFees[i] = encoding.TierFee{Tier: tier, Fee: fee}
+		}
+
+		payload, err := encoding.EncodeProverAssignmentPayload(
+			chainID,
+			common.Address{},
+			common.Address{},
+			req.TxListHash,
+			req.FeeToken,
+			req.Expiry,
+			100,
+			10,
+			tierFees,
+		)
+		if err != nil {
+			return err
+		}
+		sig, err := crypto.Sign(crypto.Keccak256(payload), key)
+		if err != nil {
+			return err
+		}
+
+		return c.JSON(http.StatusOK, &server.QuoteResponse{
+			ProposeBlockResponse: server.ProposeBlockResponse{
+				SignedPayload: sig,
+				Prover:        crypto.PubkeyToAddress(key.PublicKey),
+				MaxBlockID:    100,
+				MaxProposedIn: 10,
+			},
+			TierFees: tierFees,
+		})
+	})
+	srv := httptest.NewServer(e)
+	t.Cleanup(srv.Close)
+
+	endpoint, err := url.Parse(srv.URL)
+	require.Nil(t, err)
+
+	return crypto.PubkeyToAddress(key.PublicKey), endpoint
+}
+
+func TestAuctionSelectorRequestQuote(t *testing.T) {
+	var (
+		chainID         = uint64(167001)
+		prover, cheap   = newTestQuoteServer(t, chainID, big.NewInt(100))
+		_, tooExpensive = newTestQuoteServer(t, chainID, big.NewInt(10_000))
+		maxFees         = []encoding.TierFee{
+			{Tier: encoding.TierOptimisticID, Fee: big.NewInt(1000)},
+			{Tier: encoding.TierSgxID, Fee: big.NewInt(1000)},
+		}
+	)
+
+	s, err := NewAuctionSelector(
+		&bindings.TaikoDataConfig{ChainId: chainID},
+		nil,
+		common.Address{},
+		common.Address{},
+		time.Second,
+		maxFees,
+		common.Big0,
+		[]*url.URL{cheap, tooExpensive},
+		1,
+		time.Minute,
+		time.Minute,
+	)
+	require.Nil(t, err)
+
+	bid, err := s.requestQuote(context.Background(), cheap, 1, maxFees, common.BigToHash(common.Big1), false)
+	require.Nil(t, err)
+	require.Equal(t, prover, bid.Prover)
+	require.Len(t, bid.TierFees, 2)
+
+	_, err = s.requestQuote(context.Background(), tooExpensive, 1, maxFees, common.BigToHash(common.Big1), false)
+	require.ErrorContains(t, err, "too high")
+
+	// The bid should be signed over the same chain ID.
+	s.protocolConfigs = &bindings.TaikoDataConfig{ChainId: chainID + 1}
+	_, err = s.requestQuote(context.Background(), cheap, 1, maxFees, common.BigToHash(common.Big1), false)
+	require.ErrorContains(t, err, "did not recover")
+
+	_, err = NewAuctionSelector(nil, nil, common.Address{}, common.Address{}, 0, nil, nil, nil, 0, 0, 0)
+	require.ErrorContains(t, err, "invalid auction deadline")
+}
+
+func TestValidateBid(t *testing.T) {
+	var (
+		maxFees = []encoding.TierFee{
+			{Tier: encoding.TierOptimisticID, Fee: big.NewInt(1000)},
+			{Tier: encoding.TierSgxID, Fee: big.NewInt(1000)},
+		}
+		newBid = func(tierFees ...encoding.TierFee) *server.QuoteResponse {
+			return &server.QuoteResponse{
+				ProposeBlockResponse: server.ProposeBlockResponse{SignedPayload: make([]byte, 65)},
+				TierFees:             tierFees,
+			}
+		}
+	)
+
+	require.Nil(t, validateBid(newBid(
+		encoding.TierFee{Tier: encoding.TierOptimisticID, Fee: big.NewInt(1000)},
+		encoding.TierFee{Tier: encoding.TierSgxID, Fee: common.Big0},
+	), maxFees))
+	require.ErrorContains(t, validateBid(newBid(
+		encoding.TierFee{Tier: encoding.TierOptimisticID, Fee: big.NewInt(1000)},
+	), maxFees), "invalid number of quoted tiers")
+	require.ErrorContains(t, validateBid(newBid(
+		encoding.TierFee{Tier: encoding.TierSgxID, Fee: big.NewInt(1000)},
+		encoding.TierFee{Tier: encoding.TierOptimisticID, Fee: big.NewInt(1000)},
+	), maxFees), "invalid quoted tier fee")
+	require.ErrorContains(t, validateBid(newBid(
+		encoding.TierFee{Tier: encoding.TierOptimisticID, Fee: big.NewInt(1000)},
+		encoding.TierFee{Tier: encoding.TierSgxID, Fee: big.NewInt(1001)},
+	), maxFees), "too high")
+}
+
+func TestSortBids(t *testing.T) {
+	newBid := func(fees ...int64) *server.QuoteResponse {
+		bid := new(server.QuoteResponse)
+		for _, fee := range fees {
+			bid.TierFees = append(bid.TierFees, encoding.TierFee{Fee: big.NewInt(fee)})
+		}
+		return bid
+	}
+
+	var (
+		a = newBid(100, 300)
+		b = newBid(200, 100)
+		c = newBid(50, 350)
+	)
+	require.Equal(t, []*server.QuoteResponse{b, a, c}, sortBids([]*server.QuoteResponse{a, nil, b, c}))
+	require.Empty(t, sortBids([]*server.QuoteResponse{nil}))
+}
@@ -0,0 +1,219 @@
+package selector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
+)
+
+var (
+	errInvalidFeeToken               = errors.New("invalid fee token")
+	errInsufficientFeeTokenBalance   = errors.New("insufficient fee token balance")
+	errInsufficientFeeTokenAllowance = errors.New("insufficient fee token allowance")
+)
+
+// ERC20FeeSelector is a prover selector implementation which uses an ERC-20 token as prover fee,
+// all provers selected must be EOA accounts. The fee is transferred from the proposer to the
+// assigned prover by the AssignmentHook contract, so the proposer needs to approve the hook to
+// spend its tokens before proposing.
+type ERC20FeeSelector struct {
+	*ETHFeeEOASelector
+	feeToken       common.Address
+	feeTokenClient *bindings.TaikoToken
+	proposerSigner signer.Signer
+	approveAmount  *big.Int
+}
+
+// NewERC20FeeSelector creates a new ERC20FeeSelector instance, the given tier fees are denominated
+// in the smallest unit of the fee token.
+func NewERC20FeeSelector(
+	protocolConfigs *bindings.TaikoDataConfig,
+	rpc *rpc.Client,
+	taikoL1Address common.Address,
+	assignmentHookAddress common.Address,
+	feeToken common.Address,
+	proposerSigner signer.Signer,
+	approveAmount *big.Int,
+	tiersFee []encoding.TierFee,
+	tierFeePriceBump *big.Int,
+	proverEndpoints []*url.URL,
+	maxTierFeePriceBumpIterations uint64,
+	proposalExpiry time.Duration,
+	requestTimeout time.Duration,
+) (*ERC20FeeSelector, error) {
+	if feeToken == (common.Address{}) {
+		return nil, errInvalidFeeToken
+	}
+
+	ethFeeSelector, err := NewETHFeeEOASelector(
+		protocolConfigs,
+		rpc,
+		taikoL1Address,
+		assignmentHookAddress,
+		tiersFee,
+		tierFeePriceBump,
+		proverEndpoints,
+		maxTierFeePriceBumpIterations,
+		proposalExpiry,
+		requestTimeout,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	feeTokenClient, err := bindings.NewTaikoToken(feeToken, rpc.L1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create fee token client: %w", err)
+	}
+
+	return &ERC20FeeSelector{
+		ethFeeSelector,
+		feeToken,
+		feeTokenClient,
+		proposerSigner,
+		approveAmount,
+	}, nil
+}
+
+// FeeToken returns the ERC-20 token used as prover fee.
+func (s *ERC20FeeSelector) FeeToken() common.Address { return s.feeToken }
+
+// AssignProver tries to pick a prover through the registered prover endpoints, and makes
+// sure the proposer has enough fee token balance and allowance to pay the prover. The returned
+// fee is the ETH value attached to the proposing transaction, which is always zero.
+func (s *ERC20FeeSelector) AssignProver(
+	ctx context.Context,
+	tierFees []encoding.TierFee,
+	txListHash common.Hash,
+) (*encoding.ProverAssignment, common.Address, *big.Int, error) {
+	return s.assign(ctx, tierFees, txListHash, false)
+}
+
+// DryRunAssignProver implements the ProverSelector interface.
+func (s *ERC20FeeSelector) DryRunAssignProver(
+	ctx context.Context,
+	tierFees []encoding.TierFee,
+	txListHash common.Hash,
+) (*encoding.ProverAssignment, common.Address, *big.Int, error) {
+	return s.assign(ctx, tierFees, txListHash, true)
+}
+
+// assign picks a prover who accepts the fee token, and returns a zero ETH fee.
+func (s *ERC20FeeSelector) assign(
+	ctx context.Context,
+	tierFees []encoding.TierFee,
+	txListHash common.Hash,
+	dryRun bool,
+) (*encoding.ProverAssignment, common.Address, *big.Int, error) {
+	assignment, proverAddress, _, err := s.assignProver(ctx, s.feeToken, tierFees, txListHash, s.checkFee, nil, dryRun)
+	if err != nil {
+		return nil, common.Address{}, nil, err
+	}
+
+	return assignment, proverAddress, common.Big0, nil
+}
+
+// ApproveFeeToken approves the AssignmentHook to spend the proposer's fee tokens, if the current
+// allowance can not cover the max prover fee after all tier fee bumps. It should be called before
+// the proposing transactions nonces are decided, since it may send an approval transaction.
+func (s *ERC20FeeSelector) ApproveFeeToken(ctx context.Context) error {
+	maxProverFee := s.maxProverFee()
+
+	allowance, err := s.feeTokenClient.Allowance(
+		&bind.CallOpts{Context: ctx},
+		s.proposerSigner.Address(),
+		s.assignmentHookAddress,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get fee token allowance: %w", err)
+	}
+	if allowance.Cmp(maxProverFee) >= 0 {
+		return nil
+	}
+
+	amount := s.approveAmount
+	if amount == nil || amount.Cmp(maxProverFee) < 0 {
+		amount = maxProverFee
+	}
+
+	opts, err := signer.NewTransactor(ctx, s.proposerSigner, s.rpc.L1ChainID)
+	if err != nil {
+		return err
+	}
+
+	log.Info(
+		"Approving the assignment hook for fee token",
+		"feeToken", s.feeToken,
+		"allowance", allowance,
+		"approvalAmount", amount,
+	)
+
+	tx, err := s.feeTokenClient.Approve(opts, s.assignmentHookAddress, amount)
+	if err != nil {
+		return fmt.Errorf("failed to approve fee token: %w", err)
+	}
+
+	receipt, err := rpc.WaitReceipt(ctx, s.rpc.L1, tx)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Approved the assignment hook for fee token", "feeToken", s.feeToken, "txHash", receipt.TxHash)
+
+	return nil
+}
+
+// checkFee checks whether the proposer has enough fee token balance and allowance to pay
+// the given max prover fee.
+func (s *ERC20FeeSelector) checkFee(ctx context.Context, maxProverFee *big.Int) error {
+	proposer := s.proposerSigner.Address()
+
+	balance, err := s.feeTokenClient.BalanceOf(&bind.CallOpts{Context: ctx}, proposer)
+	if err != nil {
+		return fmt.Errorf("failed to get fee token balance: %w", err)
+	}
+	if balance.Cmp(maxProverFee) < 0 {
+		log.Warn(
+			"Insufficient fee token balance",
+			"feeToken", s.feeToken,
+			"proposer", proposer,
+			"balance", balance,
+			"maxProverFee", maxProverFee,
+		)
+		return errInsufficientFeeTokenBalance
+	}
+
+	allowance, err := s.feeTokenClient.Allowance(&bind.CallOpts{Context: ctx}, proposer, s.assignmentHookAddress)
+	if err != nil {
+		return fmt.Errorf("failed to get fee token allowance: %w", err)
+	}
+	if allowance.Cmp(maxProverFee) < 0 {
+		log.Warn(
+			"Insufficient fee token allowance",
+			"feeToken", s.feeToken,
+			"proposer", proposer,
+			"allowance", allowance,
+			"maxProverFee", maxProverFee,
+		)
+		return errInsufficientFeeTokenAllowance
+	}
+
+	return nil
+}
+
+// maxProverFee returns the max prover fee which can be paid after all tier fee bumps.
+func (s *ERC20FeeSelector) maxProverFee() *big.Int {
+	return maxBumpedTierFee(s.tiersFee, s.tierFeePriceBump, s.maxTierFeePriceBumpIterations)
+}
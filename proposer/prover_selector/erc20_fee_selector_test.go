@@ -0,0 +1,31 @@
+package selector
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+func TestNewERC20FeeSelectorInvalidFeeToken(t *testing.T) {
+	_, err := NewERC20FeeSelector(
+		nil,
+		nil,
+		common.Address{},
+		common.Address{},
+		common.Address{},
+		nil,
+		nil,
+		[]encoding.TierFee{},
+		common.Big2,
+		[]*url.URL{},
+		32,
+		time.Minute,
+		time.Minute,
+	)
+	require.ErrorIs(t, err, errInvalidFeeToken)
+}
@@ -88,6 +88,31 @@ func (s *ETHFeeEOASelector) AssignProver(
 	ctx context.Context,
 	tierFees []encoding.TierFee,
 	txListHash common.Hash,
+) (*encoding.ProverAssignment, common.Address, *big.Int, error) {
+	return s.assignProver(ctx, rpc.ZeroAddress, tierFees, txListHash, nil, nil, false)
+}
+
+// DryRunAssignProver implements the ProverSelector interface.
+func (s *ETHFeeEOASelector) DryRunAssignProver(
+	ctx context.Context,
+	tierFees []encoding.TierFee,
+	txListHash common.Hash,
+) (*encoding.ProverAssignment, common.Address, *big.Int, error) {
+	return s.assignProver(ctx, rpc.ZeroAddress, tierFees, txListHash, nil, nil, true)
+}
+
+// assignProver tries to pick a prover who accepts the given fee token through the given prover
+// endpoints in order, if no endpoints are given, the registered ones are shuffled on each round.
+// The given checkFee callback is called with the max prover fee before each round of requests,
+// if it is not nil.
+func (s *ETHFeeEOASelector) assignProver(
+	ctx context.Context,
+	feeToken common.Address,
+	tierFees []encoding.TierFee,
+	txListHash common.Hash,
+	checkFee func(ctx context.Context, maxProverFee *big.Int) error,
+	endpoints []*url.URL,
+	dryRun bool,
 ) (*encoding.ProverAssignment, common.Address, *big.Int, error) {
 	guardianProverAddress, err := s.rpc.TaikoL1.Resolve0(
 		&bind.CallOpts{Context: ctx},
@@ -115,25 +140,38 @@ func (s *ETHFeeEOASelector) AssignProver(
 		for idx := range fees {
 			if i > 0 {
 				fee := new(big.Int).Mul(fees[idx].Fee, cumulativeBumpPercent)
-				fees[idx].Fee = fees[idx].Fee.Add(fees[idx].Fee, fee.Div(fee, big100))
+				fees[idx].Fee = new(big.Int).Add(fees[idx].Fee, fee.Div(fee, big100))
 			}
 			if fees[idx].Fee.Cmp(maxProverFee) > 0 {
 				maxProverFee = fees[idx].Fee
 			}
 		}
 
-		for _, endpoint := range s.shuffleProverEndpoints() {
+		if checkFee != nil {
+			if err := checkFee(ctx, maxProverFee); err != nil {
+				return nil, common.Address{}, nil, err
+			}
+		}
+
+		roundEndpoints := endpoints
+		if roundEndpoints == nil {
+			roundEndpoints = s.shuffleProverEndpoints()
+		}
+
+		for _, endpoint := range roundEndpoints {
 			encodedAssignment, proverAddress, err := assignProver(
 				ctx,
 				s.protocolConfigs.ChainId,
 				endpoint,
 				expiry,
-				tierFees,
+				feeToken,
+				fees,
 				s.taikoL1Address,
 				s.assignmentHookAddress,
 				txListHash,
 				s.requestTimeout,
 				guardianProverAddress,
+				dryRun,
 			)
 			if err != nil {
 				log.Warn("Failed to assign prover", "endpoint", endpoint, "error", err)
@@ -174,34 +212,76 @@ func (s *ETHFeeEOASelector) shuffleProverEndpoints() []*url.URL {
 	return shuffledEndpoints
 }
 
-// assignProver tries to assign a proof generation task to the given prover by HTTP API.
+// bumpedTierFees returns the tier fees after the given number of tier fee bump iterations,
+// following the same bumping rules as ETHFeeEOASelector.assignProver.
+func bumpedTierFees(tierFees []encoding.TierFee, tierFeePriceBump *big.Int, iterations uint64) []encoding.TierFee {
+	var (
+		big100 = new(big.Int).SetUint64(uint64(100))
+		fees   = make([]encoding.TierFee, len(tierFees))
+	)
+	for idx, tierFee := range tierFees {
+		fee := new(big.Int).Set(tierFee.Fee)
+		for i := uint64(1); i < iterations; i++ {
+			bump := new(big.Int).Mul(fee, new(big.Int).Mul(tierFeePriceBump, new(big.Int).SetUint64(i)))
+			fee.Add(fee, bump.Div(bump, big100))
+		}
+		fees[idx] = encoding.TierFee{Tier: tierFee.Tier, Fee: fee}
+	}
+
+	return fees
+}
+
+// maxBumpedTierFee returns the max tier fee after the given number of tier fee bump iterations.
+func maxBumpedTierFee(tierFees []encoding.TierFee, tierFeePriceBump *big.Int, iterations uint64) *big.Int {
+	return maxTierFee(bumpedTierFees(tierFees, tierFeePriceBump, iterations))
+}
+
+// maxTierFee returns the max fee among the given tier fees.
+func maxTierFee(tierFees []encoding.TierFee) *big.Int {
+	maxProverFee := common.Big0
+	for _, tierFee := range tierFees {
+		if tierFee.Fee.Cmp(maxProverFee) > 0 {
+			maxProverFee = tierFee.Fee
+		}
+	}
+
+	return maxProverFee
+}
+
+// assignProver tries to assign a proof generation task to the given prover by HTTP API, a dry-run
+// assignment is only valid in the L1 block it's signed in.
 func assignProver(
 	ctx context.Context,
 	chainID uint64,
 	endpoint *url.URL,
 	expiry uint64,
+	feeToken common.Address,
 	tierFees []encoding.TierFee,
 	taikoL1Address common.Address,
 	assignmentHookAddress common.Address,
 	txListHash common.Hash,
 	timeout time.Duration,
 	guardianProverAddress common.Address,
+	dryRun bool,
 ) (*encoding.ProverAssignment, common.Address, error) {
 	log.Info(
 		"Attempting to assign prover",
 		"endpoint", endpoint,
 		"expiry", expiry,
+		"feeToken", feeToken,
 		"txListHash", txListHash,
+		"dryRun", dryRun,
 	)
 
 	// Send the HTTP request
 	var (
 		client  = resty.New()
 		reqBody = &server.CreateAssignmentRequestBody{
-			FeeToken:   rpc.ZeroAddress,
+			FeeToken:   feeToken,
 			TierFees:   tierFees,
 			Expiry:     expiry,
 			TxListHash: txListHash,
+			DryRun:     dryRun,
 		}
 		result = server.ProposeBlockResponse{}
 	)
@@ -229,34 +309,19 @@ func assignProver(
 
 	// Ensure prover in response is the same as the one recovered
 	// from the signature
-	payload, err := encoding.EncodeProverAssignmentPayload(
+	if err := verifyAssignmentSignature(
 		chainID,
 		taikoL1Address,
 		assignmentHookAddress,
 		txListHash,
-		common.Address{},
+		feeToken,
 		expiry,
-		result.MaxBlockID,
-		result.MaxProposedIn,
+		&result,
 		tierFees,
-	)
-	if err != nil {
-		return nil, common.Address{}, err
-	}
-
-	pubKey, err := crypto.SigToPub(crypto.Keccak256Hash(payload).Bytes(), result.SignedPayload)
-	if err != nil {
+	); err != nil {
 		return nil, common.Address{}, err
 	}
 
-	if crypto.PubkeyToAddress(*pubKey).Hex() != result.Prover.Hex() {
-		return nil, common.Address{}, fmt.Errorf(
-			"assigned prover signature did not recover to provided prover address %s != %s",
-			crypto.PubkeyToAddress(*pubKey).Hex(),
-			result.Prover.Hex(),
-		)
-	}
-
 	log.Info(
 		"Prover assigned",
 		"address", result.Prover,
@@ -270,7 +335,7 @@ func assignProver(
 	result.SignedPayload[64] = uint8(uint(result.SignedPayload[64])) + 27
 
 	return &encoding.ProverAssignment{
-		FeeToken:      common.Address{},
+		FeeToken:      feeToken,
 		TierFees:      tierFees,
 		Expiry:        reqBody.Expiry,
 		MaxBlockId:    result.MaxBlockID,
@@ -279,3 +344,46 @@ func assignProver(
 		Signature:     result.SignedPayload,
 	}, result.Prover, nil
 }
+
+// verifyAssignmentSignature checks whether the signed payload in the given prover server response
+// recovers to the prover address in the response.
+func verifyAssignmentSignature(
+	chainID uint64,
+	taikoL1Address common.Address,
+	assignmentHookAddress common.Address,
+	txListHash common.Hash,
+	feeToken common.Address,
+	expiry uint64,
+	result *server.ProposeBlockResponse,
+	tierFees []encoding.TierFee,
+) error {
+	payload, err := encoding.EncodeProverAssignmentPayload(
+		chainID,
+		taikoL1Address,
+		assignmentHookAddress,
+		txListHash,
+		feeToken,
+		expiry,
+		result.MaxBlockID,
+		result.MaxProposedIn,
+		tierFees,
+	)
+	if err != nil {
+		return err
+	}
+
+	pubKey, err := crypto.SigToPub(crypto.Keccak256Hash(payload).Bytes(), result.SignedPayload)
+	if err != nil {
+		return err
+	}
+
+	if crypto.PubkeyToAddress(*pubKey).Hex() != result.Prover.Hex() {
+		return fmt.Errorf(
+			"assigned prover signature did not recover to provided prover address %s != %s",
+			crypto.PubkeyToAddress(*pubKey).Hex(),
+			result.Prover.Hex(),
+		)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package selector
 
 import (
 	"context"
+	"math/big"
 	"net/url"
 	"os"
 	"testing"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
@@ -62,6 +64,26 @@ func (s *ProverSelectorTestSuite) TestProverAssignProver() {
 	s.Nil(err)
 }
 
+func TestMaxBumpedTierFee(t *testing.T) {
+	tierFees := []encoding.TierFee{
+		{Tier: encoding.TierOptimisticID, Fee: big.NewInt(1000)},
+		{Tier: encoding.TierSgxID, Fee: big.NewInt(2000)},
+	}
+
+	require.Equal(t, common.Big0, maxBumpedTierFee([]encoding.TierFee{}, big.NewInt(10), 3))
+	require.Equal(t, big.NewInt(2000), maxBumpedTierFee(tierFees, big.NewInt(10), 1))
+	// 2000 * 110% * 120%
+	require.Equal(t, big.NewInt(2640), maxBumpedTierFee(tierFees, big.NewInt(10), 3))
+
+	require.Equal(t, []encoding.TierFee{
+		{Tier: encoding.TierOptimisticID, Fee: big.NewInt(1320)},
+		{Tier: encoding.TierSgxID, Fee: big.NewInt(2640)},
+	}, bumpedTierFees(tierFees, big.NewInt(10), 3))
+
+	// The given tier fees should not be modified.
+	require.Equal(t, big.NewInt(2000), tierFees[1].Fee)
+}
+
 func TestProverSelectorTestSuite(t *testing.T) {
 	suite.Run(t, new(ProverSelectorTestSuite))
 }
@@ -16,5 +16,12 @@ type ProverSelector interface {
 		tierFees []encoding.TierFee,
 		txListHash common.Hash,
 	) (assignment *encoding.ProverAssignment, assignedProver common.Address, fee *big.Int, err error)
+	// DryRunAssignProver picks a prover the same way as AssignProver, but the returned assignment
+	// can only be used to simulate a proposal in the L1 block it's signed in, it can't be submitted.
+	DryRunAssignProver(
+		ctx context.Context,
+		tierFees []encoding.TierFee,
+		txListHash common.Hash,
+	) (assignment *encoding.ProverAssignment, assignedProver common.Address, fee *big.Int, err error)
 	ProverEndpoints() []*url.URL
 }
@@ -0,0 +1,295 @@
+package selector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+var (
+	reputationKeyPrefix = "reputation"
+	proverKeyPrefix     = "prover"
+	blockKeyPrefix      = "block"
+	l1HeightKey         = "l1Height"
+	keySeparator        = "++"
+)
+
+// ProverStats is the proving history of a prover.
+type ProverStats struct {
+	ProofsOnTime  uint64   `json:"proofsOnTime"`
+	MissedWindows uint64   `json:"missedWindows"`
+	BondsLost     *big.Int `json:"bondsLost"`
+}
+
+// Reliability returns the ratio of the blocks proved on time to all the blocks assigned to the
+// prover, smoothed so that a prover without any history gets 0.5.
+func (s *ProverStats) Reliability() float64 {
+	return float64(s.ProofsOnTime+1) / float64(s.ProofsOnTime+s.MissedWindows+2)
+}
+
+// ReputationTracker tracks the proving history of each prover from the protocol's TransitionProved
+// and BlockVerified events, and persists the history into the given db.
+type ReputationTracker struct {
+	db             ethdb.KeyValueStore
+	rpc            *rpc.Client
+	livenessBond   *big.Int
+	provingWindows map[uint16]time.Duration
+	mutex          sync.RWMutex
+}
+
+// NewReputationTracker creates a new ReputationTracker instance.
+func NewReputationTracker(
+	db ethdb.KeyValueStore,
+	rpc *rpc.Client,
+	livenessBond *big.Int,
+	tiers []*rpc.TierProviderTierWithID,
+) *ReputationTracker {
+	provingWindows := make(map[uint16]time.Duration, len(tiers))
+	for _, tier := range tiers {
+		provingWindows[tier.ID] = time.Duration(tier.ProvingWindow) * time.Second
+	}
+
+	return &ReputationTracker{db: db, rpc: rpc, livenessBond: livenessBond, provingWindows: provingWindows}
+}
+
+// Start catches up with the events emitted since the last processed L1 block, and then keeps
+// tracking the new events, until the given context is done.
+func (t *ReputationTracker) Start(ctx context.Context) {
+	var (
+		transitionProvedCh  = make(chan *bindings.TaikoL1ClientTransitionProved, 128)
+		blockVerifiedCh     = make(chan *bindings.TaikoL1ClientBlockVerified, 128)
+		transitionProvedSub = rpc.SubscribeTransitionProved(t.rpc.TaikoL1, transitionProvedCh)
+		blockVerifiedSub    = rpc.SubscribeBlockVerified(t.rpc.TaikoL1, blockVerifiedCh)
+	)
+	defer func() {
+		transitionProvedSub.Unsubscribe()
+		blockVerifiedSub.Unsubscribe()
+	}()
+
+	if err := t.catchUp(ctx); err != nil {
+		log.Warn("Failed to catch up with prover reputation events", "error", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-transitionProvedCh:
+			if err := t.OnTransitionProved(ctx, e); err != nil {
+				log.Warn("Failed to track TransitionProved event", "blockID", e.BlockId, "error", err)
+			}
+		case e := <-blockVerifiedCh:
+			if err := t.OnBlockVerified(e); err != nil {
+				log.Warn("Failed to track BlockVerified event", "blockID", e.BlockId, "error", err)
+			}
+		}
+	}
+}
+
+// catchUp processes the events emitted since the last processed L1 block, nothing is done if
+// there is no L1 block processed before.
+func (t *ReputationTracker) catchUp(ctx context.Context) error {
+	lastHeight := t.lastL1Height()
+	if lastHeight == 0 {
+		return nil
+	}
+
+	head, err := t.rpc.L1.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if head <= lastHeight {
+		return nil
+	}
+
+	opts := &bind.FilterOpts{Start: lastHeight + 1, End: &head, Context: ctx}
+
+	// A block is always proved before it is verified.
+	provedIter, err := t.rpc.TaikoL1.FilterTransitionProved(opts, nil)
+	if err != nil {
+		return err
+	}
+	defer provedIter.Close()
+	for provedIter.Next() {
+		if err := t.OnTransitionProved(ctx, provedIter.Event); err != nil {
+			return err
+		}
+	}
+	if err := provedIter.Error(); err != nil {
+		return err
+	}
+
+	verifiedIter, err := t.rpc.TaikoL1.FilterBlockVerified(opts, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer verifiedIter.Close()
+	for verifiedIter.Next() {
+		if err := t.OnBlockVerified(verifiedIter.Event); err != nil {
+			return err
+		}
+	}
+	if err := verifiedIter.Error(); err != nil {
+		return err
+	}
+
+	log.Info("Caught up with prover reputation events", "from", lastHeight+1, "to", head)
+
+	return nil
+}
+
+// OnTransitionProved records whether the assigned prover of the proved block submitted its proof
+// within the proving window of the proof's tier, the proofs submitted by other provers are ignored.
+func (t *ReputationTracker) OnTransitionProved(
+	ctx context.Context,
+	e *bindings.TaikoL1ClientTransitionProved,
+) error {
+	block, err := t.rpc.TaikoL1.GetBlock(&bind.CallOpts{Context: ctx}, e.BlockId.Uint64())
+	if err != nil {
+		return fmt.Errorf("failed to get block %d: %w", e.BlockId, err)
+	}
+	if block.AssignedProver != e.Prover {
+		return t.setL1Height(e.Raw.BlockNumber)
+	}
+
+	header, err := t.rpc.L1.HeaderByNumber(ctx, new(big.Int).SetUint64(e.Raw.BlockNumber))
+	if err != nil {
+		return fmt.Errorf("failed to get L1 header %d: %w", e.Raw.BlockNumber, err)
+	}
+
+	onTime := header.Time <= block.ProposedAt+uint64(t.provingWindows[e.Tier].Seconds())
+
+	return t.record(e.BlockId.Uint64(), e.Prover, onTime, e.Raw.BlockNumber)
+}
+
+// OnBlockVerified records a missed proving window for the assigned prover, if the verified block
+// was proved by another prover and the assigned prover never submitted a proof.
+func (t *ReputationTracker) OnBlockVerified(e *bindings.TaikoL1ClientBlockVerified) error {
+	if e.Prover == e.AssignedProver {
+		return t.setL1Height(e.Raw.BlockNumber)
+	}
+
+	return t.record(e.BlockId.Uint64(), e.AssignedProver, false, e.Raw.BlockNumber)
+}
+
+// Stats returns the proving history of the given prover.
+func (t *ReputationTracker) Stats(prover common.Address) (*ProverStats, error) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.stats(prover)
+}
+
+// stats reads the proving history of the given prover from the db.
+func (t *ReputationTracker) stats(prover common.Address) (*ProverStats, error) {
+	stats := &ProverStats{BondsLost: new(big.Int)}
+
+	val, err := t.db.Get(buildReputationKey(proverKeyPrefix, prover.Hex()))
+	if err != nil {
+		return stats, nil
+	}
+	if err := json.Unmarshal(val, stats); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal prover stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// record records the outcome of the given assigned block, each block is only recorded once, so
+// that the events can be safely processed multiple times.
+func (t *ReputationTracker) record(blockID uint64, prover common.Address, onTime bool, l1Height uint64) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	blockKey := buildReputationKey(blockKeyPrefix, strconv.FormatUint(blockID, 10))
+	if ok, err := t.db.Has(blockKey); err != nil || ok {
+		return err
+	}
+
+	stats, err := t.stats(prover)
+	if err != nil {
+		return err
+	}
+
+	if onTime {
+		stats.ProofsOnTime++
+	} else {
+		stats.MissedWindows++
+		stats.BondsLost.Add(stats.BondsLost, t.livenessBond)
+	}
+
+	val, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+
+	batch := t.db.NewBatch()
+	if err := batch.Put(buildReputationKey(proverKeyPrefix, prover.Hex()), val); err != nil {
+		return err
+	}
+	if err := batch.Put(blockKey, []byte{1}); err != nil {
+		return err
+	}
+	if err := t.putL1Height(batch, l1Height); err != nil {
+		return err
+	}
+
+	log.Debug("Prover reputation updated", "prover", prover, "blockID", blockID, "onTime", onTime)
+
+	return batch.Write()
+}
+
+// setL1Height records the last processed L1 height.
+func (t *ReputationTracker) setL1Height(l1Height uint64) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.putL1Height(t.db, l1Height)
+}
+
+// putL1Height writes the last processed L1 height, if it is higher than the recorded one.
+func (t *ReputationTracker) putL1Height(w ethdb.KeyValueWriter, l1Height uint64) error {
+	if l1Height <= t.lastL1Height() {
+		return nil
+	}
+
+	return w.Put(buildReputationKey(l1HeightKey), []byte(strconv.FormatUint(l1Height, 10)))
+}
+
+// lastL1Height returns the last processed L1 height, zero if there is no L1 block processed.
+func (t *ReputationTracker) lastL1Height() uint64 {
+	val, err := t.db.Get(buildReputationKey(l1HeightKey))
+	if err != nil {
+		return 0
+	}
+
+	l1Height, err := strconv.ParseUint(string(val), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return l1Height
+}
+
+// buildReputationKey builds a db key for the given reputation record.
+func buildReputationKey(parts ...string) []byte {
+	key := [][]byte{[]byte(reputationKeyPrefix)}
+	for _, part := range parts {
+		key = append(key, []byte(part))
+	}
+
+	return bytes.Join(key, []byte(keySeparator))
+}
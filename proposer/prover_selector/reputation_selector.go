@@ -0,0 +1,214 @@
+package selector
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/go-resty/resty/v2"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/prover/server"
+)
+
+// ReputationSelector is a prover selector implementation which use ETHs as prover fee, and ranks
+// the prover endpoints by a score combining the provers' proving history and their quoted fees,
+// instead of picking them randomly. All provers selected must be EOA accounts.
+type ReputationSelector struct {
+	*ETHFeeEOASelector
+	tracker           *ReputationTracker
+	reliabilityWeight float64
+}
+
+// NewReputationSelector creates a new ReputationSelector instance, the reliability weight is
+// the weight of the provers' reliability in their scores, and the rest is the weight of their
+// quoted fees.
+func NewReputationSelector(
+	protocolConfigs *bindings.TaikoDataConfig,
+	rpc *rpc.Client,
+	taikoL1Address common.Address,
+	assignmentHookAddress common.Address,
+	tracker *ReputationTracker,
+	reliabilityWeight float64,
+	tiersFee []encoding.TierFee,
+	tierFeePriceBump *big.Int,
+	proverEndpoints []*url.URL,
+	maxTierFeePriceBumpIterations uint64,
+	proposalExpiry time.Duration,
+	requestTimeout time.Duration,
+) (*ReputationSelector, error) {
+	if reliabilityWeight < 0 || reliabilityWeight > 1 {
+		return nil, fmt.Errorf("invalid reliability weight %f", reliabilityWeight)
+	}
+
+	ethFeeSelector, err := NewETHFeeEOASelector(
+		protocolConfigs,
+		rpc,
+		taikoL1Address,
+		assignmentHookAddress,
+		tiersFee,
+		tierFeePriceBump,
+		proverEndpoints,
+		maxTierFeePriceBumpIterations,
+		proposalExpiry,
+		requestTimeout,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReputationSelector{ethFeeSelector, tracker, reliabilityWeight}, nil
+}
+
+// AssignProver tries to pick a prover through the registered prover endpoints, from the one with
+// the highest score to the lowest.
+func (s *ReputationSelector) AssignProver(
+	ctx context.Context,
+	tierFees []encoding.TierFee,
+	txListHash common.Hash,
+) (*encoding.ProverAssignment, common.Address, *big.Int, error) {
+	return s.assignProver(ctx, rpc.ZeroAddress, tierFees, txListHash, nil, s.rankProverEndpoints(ctx, tierFees), false)
+}
+
+// DryRunAssignProver implements the ProverSelector interface.
+func (s *ReputationSelector) DryRunAssignProver(
+	ctx context.Context,
+	tierFees []encoding.TierFee,
+	txListHash common.Hash,
+) (*encoding.ProverAssignment, common.Address, *big.Int, error) {
+	return s.assignProver(ctx, rpc.ZeroAddress, tierFees, txListHash, nil, s.rankProverEndpoints(ctx, tierFees), true)
+}
+
+// endpointQuote is the quoted fee and the reliability of the prover behind a prover endpoint.
+type endpointQuote struct {
+	endpoint    *url.URL
+	prover      common.Address
+	fee         *big.Int
+	reliability float64
+}
+
+// rankProverEndpoints fetches the quotes of all registered prover endpoints, and sorts the
+// endpoints by their scores.
+func (s *ReputationSelector) rankProverEndpoints(ctx context.Context, tierFees []encoding.TierFee) []*url.URL {
+	var (
+		quotes = make([]*endpointQuote, len(s.proverEndpoints))
+		wg     sync.WaitGroup
+	)
+	for i, endpoint := range s.proverEndpoints {
+		wg.Add(1)
+		go func(i int, endpoint *url.URL) {
+			defer wg.Done()
+
+			quote, err := s.fetchQuote(ctx, endpoint, tierFees)
+			if err != nil {
+				log.Warn("Failed to fetch prover quote", "endpoint", endpoint, "error", err)
+				quote = &endpointQuote{endpoint: endpoint}
+			}
+			quotes[i] = quote
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	return rankEndpoints(quotes, s.reliabilityWeight)
+}
+
+// fetchQuote fetches the prover address and minimum tier fees of the given prover endpoint, the
+// quoted fee is the highest minimum fee among the given tiers.
+func (s *ReputationSelector) fetchQuote(
+	ctx context.Context,
+	endpoint *url.URL,
+	tierFees []encoding.TierFee,
+) (*endpointQuote, error) {
+	requestURL, err := url.JoinPath(endpoint.String(), "/status")
+	if err != nil {
+		return nil, err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, s.requestTimeout)
+	defer cancel()
+
+	status := new(server.Status)
+	resp, err := resty.New().R().
+		SetContext(ctxTimeout).
+		SetHeader("Accept", "application/json").
+		SetResult(status).
+		Get(requestURL)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsSuccess() {
+		return nil, fmt.Errorf("unsuccessful response %d", resp.StatusCode())
+	}
+
+	minTierFees := map[uint16]uint64{
+		encoding.TierOptimisticID:     status.MinOptimisticTierFee,
+		encoding.TierSgxID:            status.MinSgxTierFee,
+		encoding.TierPseZkevmID:       status.MinPseZkevmTierFee,
+		encoding.TierSgxAndPseZkevmID: status.MinSgxAndPseZkevmTierFee,
+	}
+	fee := new(big.Int)
+	for _, tierFee := range tierFees {
+		if minTierFee := new(big.Int).SetUint64(minTierFees[tierFee.Tier]); minTierFee.Cmp(fee) > 0 {
+			fee = minTierFee
+		}
+	}
+
+	prover := common.HexToAddress(status.Prover)
+	stats, err := s.tracker.Stats(prover)
+	if err != nil {
+		return nil, err
+	}
+
+	return &endpointQuote{endpoint: endpoint, prover: prover, fee: fee, reliability: stats.Reliability()}, nil
+}
+
+// rankEndpoints sorts the given endpoints by their scores in descending order, the score is the
+// weighted sum of the prover's reliability and the ratio of the lowest quoted fee to the prover's
+// quoted fee. The endpoints without a quote are always put at the end.
+func rankEndpoints(quotes []*endpointQuote, reliabilityWeight float64) []*url.URL {
+	var lowestFee *big.Int
+	for _, quote := range quotes {
+		if quote.fee != nil && (lowestFee == nil || quote.fee.Cmp(lowestFee) < 0) {
+			lowestFee = quote.fee
+		}
+	}
+
+	scores := make(map[*url.URL]float64, len(quotes))
+	for _, quote := range quotes {
+		if quote.fee == nil {
+			scores[quote.endpoint] = -1
+			continue
+		}
+
+		feeScore := 1.0
+		if quote.fee.Sign() > 0 {
+			feeScore, _ = new(big.Float).Quo(new(big.Float).SetInt(lowestFee), new(big.Float).SetInt(quote.fee)).Float64()
+		}
+		scores[quote.endpoint] = reliabilityWeight*quote.reliability + (1-reliabilityWeight)*feeScore
+
+		log.Debug(
+			"Prover endpoint score",
+			"endpoint", quote.endpoint,
+			"prover", quote.prover,
+			"fee", quote.fee,
+			"reliability", quote.reliability,
+			"score", scores[quote.endpoint],
+		)
+	}
+
+	endpoints := make([]*url.URL, len(quotes))
+	for i, quote := range quotes {
+		endpoints[i] = quote.endpoint
+	}
+	sort.SliceStable(endpoints, func(i, j int) bool { return scores[endpoints[i]] > scores[endpoints[j]] })
+
+	return endpoints
+}
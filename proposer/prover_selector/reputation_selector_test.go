@@ -0,0 +1,90 @@
+package selector
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/prover/server"
+)
+
+func TestRankEndpoints(t *testing.T) {
+	var (
+		cheap      = &url.URL{Host: "cheap"}
+		reliable   = &url.URL{Host: "reliable"}
+		unreliable = &url.URL{Host: "unreliable"}
+		offline    = &url.URL{Host: "offline"}
+		quotes     = []*endpointQuote{
+			{endpoint: offline},
+			{endpoint: unreliable, fee: big.NewInt(100), reliability: 0.1},
+			{endpoint: reliable, fee: big.NewInt(200), reliability: 0.9},
+			{endpoint: cheap, fee: big.NewInt(100), reliability: 0.5},
+		}
+	)
+
+	require.Equal(t, []*url.URL{reliable, cheap, unreliable, offline}, rankEndpoints(quotes, 1))
+	require.Equal(t, []*url.URL{unreliable, cheap, reliable, offline}, rankEndpoints(quotes, 0))
+	// reliable: 0.5 * 0.9 + 0.5 * 0.5 = 0.7, cheap: 0.5 * 0.5 + 0.5 * 1 = 0.75
+	require.Equal(t, []*url.URL{cheap, reliable, unreliable, offline}, rankEndpoints(quotes, 0.5))
+}
+
+func TestReputationSelectorFetchQuote(t *testing.T) {
+	prover := common.BigToAddress(common.Big1)
+
+	e := echo.New()
+	e.GET("/status", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, &server.Status{
+			MinOptimisticTierFee: 100,
+			MinSgxTierFee:        200,
+			Prover:               prover.Hex(),
+		})
+	})
+	srv := httptest.NewServer(e)
+	defer srv.Close()
+
+	endpoint, err := url.Parse(srv.URL)
+	require.Nil(t, err)
+
+	tracker := NewReputationTracker(memorydb.New(), nil, common.Big1, nil)
+	require.Nil(t, tracker.record(1, prover, true, 1))
+
+	s, err := NewReputationSelector(
+		nil,
+		nil,
+		common.Address{},
+		common.Address{},
+		tracker,
+		0.5,
+		[]encoding.TierFee{},
+		common.Big2,
+		[]*url.URL{endpoint},
+		32,
+		time.Minute,
+		time.Minute,
+	)
+	require.Nil(t, err)
+
+	quote, err := s.fetchQuote(context.Background(), endpoint, []encoding.TierFee{
+		{Tier: encoding.TierOptimisticID, Fee: common.Big256},
+		{Tier: encoding.TierSgxID, Fee: common.Big256},
+	})
+	require.Nil(t, err)
+	require.Equal(t, prover, quote.prover)
+	require.Equal(t, big.NewInt(200), quote.fee)
+	require.Equal(t, 2.0/3, quote.reliability)
+
+	_, err = NewReputationSelector(
+		nil, nil, common.Address{}, common.Address{}, tracker, 1.5, nil, nil, []*url.URL{endpoint}, 0, 0, 0,
+	)
+	require.ErrorContains(t, err, "invalid reliability weight")
+}
@@ -0,0 +1,83 @@
+package selector
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+func TestProverStatsReliability(t *testing.T) {
+	require.Equal(t, 0.5, (&ProverStats{}).Reliability())
+	require.Equal(t, 0.75, (&ProverStats{ProofsOnTime: 2}).Reliability())
+	require.Equal(t, 0.25, (&ProverStats{MissedWindows: 2}).Reliability())
+}
+
+func TestReputationTrackerOnBlockVerified(t *testing.T) {
+	var (
+		db           = memorydb.New()
+		livenessBond = big.NewInt(100)
+		tracker      = NewReputationTracker(db, nil, livenessBond, nil)
+		assigned     = common.BigToAddress(common.Big1)
+		prover       = common.BigToAddress(common.Big2)
+	)
+
+	// Proved by the assigned prover.
+	require.Nil(t, tracker.OnBlockVerified(&bindings.TaikoL1ClientBlockVerified{
+		BlockId:        common.Big1,
+		AssignedProver: assigned,
+		Prover:         assigned,
+		Raw:            types.Log{BlockNumber: 10},
+	}))
+	stats, err := tracker.Stats(assigned)
+	require.Nil(t, err)
+	require.Equal(t, &ProverStats{BondsLost: new(big.Int)}, stats)
+	require.Equal(t, uint64(10), tracker.lastL1Height())
+
+	// Proved by another prover, the same event can be processed multiple times.
+	for i := 0; i < 2; i++ {
+		require.Nil(t, tracker.OnBlockVerified(&bindings.TaikoL1ClientBlockVerified{
+			BlockId:        common.Big2,
+			AssignedProver: assigned,
+			Prover:         prover,
+			Raw:            types.Log{BlockNumber: 11},
+		}))
+	}
+	stats, err = tracker.Stats(assigned)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), stats.MissedWindows)
+	require.Equal(t, livenessBond, stats.BondsLost)
+	require.Equal(t, uint64(11), tracker.lastL1Height())
+
+	// The history is persisted.
+	stats, err = NewReputationTracker(db, nil, livenessBond, nil).Stats(assigned)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), stats.MissedWindows)
+
+	// The recorded L1 height never goes back.
+	require.Nil(t, tracker.setL1Height(5))
+	require.Equal(t, uint64(11), tracker.lastL1Height())
+}
+
+func TestReputationTrackerRecord(t *testing.T) {
+	var (
+		tracker = NewReputationTracker(memorydb.New(), nil, common.Big1, nil)
+		prover  = common.BigToAddress(common.Big1)
+	)
+
+	require.Nil(t, tracker.record(1, prover, true, 1))
+	require.Nil(t, tracker.record(2, prover, true, 2))
+	require.Nil(t, tracker.record(3, prover, false, 3))
+	require.Nil(t, tracker.record(3, prover, true, 3))
+
+	stats, err := tracker.Stats(prover)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), stats.ProofsOnTime)
+	require.Equal(t, uint64(1), stats.MissedWindows)
+	require.Equal(t, common.Big1, stats.BondsLost)
+}
@@ -0,0 +1,210 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+
+	taikoMetrics "github.com/taikoxyz/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+var (
+	errProposalHeld = errors.New("proposal held until L1 fees drop")
+	// A transactions list is considered full if its size reaches this percentage of the max size.
+	fullTxListPercentage uint64 = 90
+)
+
+// scheduleReason is the reason of a proposal scheduling decision.
+type scheduleReason string
+
+// All reasons of the proposal scheduling decisions, only scheduleReasonFeesHigh holds the proposal.
+const (
+	scheduleReasonTxListFull scheduleReason = "txListFull"
+	scheduleReasonByteBudget scheduleReason = "byteBudget"
+	scheduleReasonFeesLow    scheduleReason = "feesLow"
+	scheduleReasonMaxDelay   scheduleReason = "maxDelay"
+	scheduleReasonFeesHigh   scheduleReason = "feesHigh"
+)
+
+// scheduleCounters are the metrics counters of the proposal scheduling decisions for each reason.
+var scheduleCounters = map[scheduleReason]metrics.Counter{
+	scheduleReasonTxListFull: taikoMetrics.ProposerScheduleTxListFullCounter,
+	scheduleReasonByteBudget: taikoMetrics.ProposerScheduleByteBudgetCounter,
+	scheduleReasonFeesLow:    taikoMetrics.ProposerScheduleFeesLowCounter,
+	scheduleReasonMaxDelay:   taikoMetrics.ProposerScheduleMaxDelayCounter,
+	scheduleReasonFeesHigh:   taikoMetrics.ProposerScheduleFeesHighCounter,
+}
+
+// l1Fees are the current L1 fees and their averages over the recent blocks, the blob fees are nil
+// if they are not supported by the L1 node.
+type l1Fees struct {
+	baseFee        *big.Int
+	avgBaseFee     *big.Int
+	blobBaseFee    *big.Int
+	avgBlobBaseFee *big.Int
+}
+
+// proposalScheduler decides whether the fetched transactions lists should be proposed in the current
+// epoch, or held until the L1 fees drop. A held proposal is still proposed right away when the
+// transactions lists are full, or the pending bytes reach the budget, or it has been held for the
+// max delay.
+type proposalScheduler struct {
+	rpc               *rpc.Client
+	feeHistoryBlocks  uint64
+	feeThreshold      uint64
+	maxDelay          time.Duration
+	maxPendingBytes   uint64
+	maxTxListBytes    uint64
+	maxBaseFee        *big.Int // nil or zero means no ceiling
+	maxBlobBaseFee    *big.Int // nil or zero means no ceiling
+	blobFeeConsidered bool
+
+	heldSince time.Time
+}
+
+// shouldPropose fetches the L1 fee history, and decides whether the given transactions lists should
+// be proposed now.
+func (s *proposalScheduler) shouldPropose(ctx context.Context, txListsBytes [][]byte) (bool, error) {
+	fees, err := s.getL1Fees(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get L1 fee history: %w", err)
+	}
+
+	propose, reason := s.decide(fees, txListsBytes, time.Now())
+
+	var pendingBytes int
+	for _, txListBytes := range txListsBytes {
+		pendingBytes += len(txListBytes)
+	}
+
+	log.Info(
+		"Proposal scheduling decision",
+		"propose", propose,
+		"reason", reason,
+		"pendingBytes", pendingBytes,
+		"baseFee", fees.baseFee,
+		"avgBaseFee", fees.avgBaseFee,
+		"blobBaseFee", fees.blobBaseFee,
+		"avgBlobBaseFee", fees.avgBlobBaseFee,
+		"heldSince", s.heldSince,
+	)
+
+	scheduleCounters[reason].Inc(1)
+	taikoMetrics.ProposerSchedulePendingBytesGauge.Update(int64(pendingBytes))
+	if propose {
+		taikoMetrics.ProposerScheduleHeldGauge.Update(0)
+	} else {
+		taikoMetrics.ProposerScheduleHeldGauge.Update(1)
+	}
+
+	return propose, nil
+}
+
+// decide decides whether the given transactions lists should be proposed at the given time with the
+// given L1 fees, and returns the reason of the decision.
+func (s *proposalScheduler) decide(fees *l1Fees, txListsBytes [][]byte, now time.Time) (bool, scheduleReason) {
+	var (
+		pendingBytes uint64
+		full         bool
+	)
+	for _, txListBytes := range txListsBytes {
+		pendingBytes += uint64(len(txListBytes))
+		if uint64(len(txListBytes))*100 >= s.maxTxListBytes*fullTxListPercentage {
+			full = true
+		}
+	}
+
+	var reason scheduleReason
+	switch {
+	case full:
+		reason = scheduleReasonTxListFull
+	case s.maxPendingBytes != 0 && pendingBytes >= s.maxPendingBytes:
+		reason = scheduleReasonByteBudget
+	case !s.isExpensive(fees):
+		reason = scheduleReasonFeesLow
+	case !s.heldSince.IsZero() && now.Sub(s.heldSince) >= s.maxDelay:
+		reason = scheduleReasonMaxDelay
+	default:
+		if s.heldSince.IsZero() {
+			s.heldSince = now
+		}
+		return false, scheduleReasonFeesHigh
+	}
+
+	s.heldSince = time.Time{}
+	return true, reason
+}
+
+// reset clears the hold, it is called when there are no pending transactions, so that a hold of the
+// previous transactions won't shorten the delay of the next ones.
+func (s *proposalScheduler) reset() {
+	s.heldSince = time.Time{}
+}
+
+// isExpensive checks whether the current L1 base fee, or the blob base fee if blobs are allowed,
+// exceeds the fee threshold percentage of its recent average, or its absolute ceiling. The ceilings
+// keep the proposals held during a long fee spike, when the averages catch up with the current fees.
+func (s *proposalScheduler) isExpensive(fees *l1Fees) bool {
+	if exceedsThreshold(fees.baseFee, fees.avgBaseFee, s.feeThreshold) || exceedsCeiling(fees.baseFee, s.maxBaseFee) {
+		return true
+	}
+
+	return s.blobFeeConsidered &&
+		fees.blobBaseFee != nil &&
+		(exceedsThreshold(fees.blobBaseFee, fees.avgBlobBaseFee, s.feeThreshold) ||
+			exceedsCeiling(fees.blobBaseFee, s.maxBlobBaseFee))
+}
+
+// getL1Fees fetches the current L1 fees and their averages over the recent blocks.
+func (s *proposalScheduler) getL1Fees(ctx context.Context) (*l1Fees, error) {
+	history, err := s.rpc.GetL1FeeHistory(ctx, s.feeHistoryBlocks)
+	if err != nil {
+		return nil, err
+	}
+	if len(history.BaseFee) == 0 {
+		return nil, errors.New("empty L1 fee history")
+	}
+
+	fees := &l1Fees{}
+	fees.baseFee, fees.avgBaseFee = splitFeeHistory(history.BaseFee)
+	if len(history.BlobBaseFee) != 0 {
+		fees.blobBaseFee, fees.avgBlobBaseFee = splitFeeHistory(history.BlobBaseFee)
+	}
+
+	return fees, nil
+}
+
+// splitFeeHistory returns the last fee in the given fee history, which is the fee of the next block,
+// and the average of the other fees.
+func splitFeeHistory(fees []*big.Int) (*big.Int, *big.Int) {
+	current := fees[len(fees)-1]
+	if len(fees) == 1 {
+		return current, current
+	}
+
+	sum := new(big.Int)
+	for _, fee := range fees[:len(fees)-1] {
+		sum.Add(sum, fee)
+	}
+
+	return current, sum.Div(sum, big.NewInt(int64(len(fees)-1)))
+}
+
+// exceedsThreshold checks whether the given fee exceeds the given percentage of the average fee.
+func exceedsThreshold(fee *big.Int, avg *big.Int, percentage uint64) bool {
+	return new(big.Int).Mul(fee, big.NewInt(100)).Cmp(
+		new(big.Int).Mul(avg, new(big.Int).SetUint64(percentage)),
+	) > 0
+}
+
+// exceedsCeiling checks whether the given fee exceeds the given ceiling, a nil or zero ceiling is
+// never exceeded.
+func exceedsCeiling(fee *big.Int, ceiling *big.Int) bool {
+	return ceiling != nil && ceiling.Sign() > 0 && fee.Cmp(ceiling) > 0
+}
@@ -0,0 +1,141 @@
+package proposer
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecide(t *testing.T) {
+	var (
+		s = &proposalScheduler{
+			feeThreshold:      110,
+			maxDelay:          time.Minute,
+			maxPendingBytes:   1000,
+			maxTxListBytes:    1000,
+			blobFeeConsidered: true,
+		}
+		cheap     = &l1Fees{baseFee: big.NewInt(100), avgBaseFee: big.NewInt(100)}
+		expensive = &l1Fees{baseFee: big.NewInt(111), avgBaseFee: big.NewInt(100)}
+		now       = time.Now()
+	)
+
+	propose, reason := s.decide(cheap, [][]byte{make([]byte, 100)}, now)
+	require.True(t, propose)
+	require.Equal(t, scheduleReasonFeesLow, reason)
+
+	// Held while the L1 fees are expensive, until the max delay.
+	propose, reason = s.decide(expensive, [][]byte{make([]byte, 100)}, now)
+	require.False(t, propose)
+	require.Equal(t, scheduleReasonFeesHigh, reason)
+	require.Equal(t, now, s.heldSince)
+
+	propose, _ = s.decide(expensive, [][]byte{make([]byte, 100)}, now.Add(time.Second))
+	require.False(t, propose)
+	require.Equal(t, now, s.heldSince)
+
+	propose, reason = s.decide(expensive, [][]byte{make([]byte, 100)}, now.Add(time.Minute))
+	require.True(t, propose)
+	require.Equal(t, scheduleReasonMaxDelay, reason)
+	require.True(t, s.heldSince.IsZero())
+
+	// Proposed right away when the transactions lists are full, or the pending bytes reach the budget.
+	propose, reason = s.decide(expensive, [][]byte{make([]byte, 900)}, now)
+	require.True(t, propose)
+	require.Equal(t, scheduleReasonTxListFull, reason)
+
+	propose, reason = s.decide(expensive, [][]byte{make([]byte, 500), make([]byte, 500)}, now)
+	require.True(t, propose)
+	require.Equal(t, scheduleReasonByteBudget, reason)
+
+	// The blob base fee is also considered if blobs are allowed.
+	cheap.blobBaseFee, cheap.avgBlobBaseFee = big.NewInt(200), common.Big1
+	propose, reason = s.decide(cheap, [][]byte{make([]byte, 100)}, now)
+	require.False(t, propose)
+	require.Equal(t, scheduleReasonFeesHigh, reason)
+
+	s.blobFeeConsidered = false
+	propose, reason = s.decide(cheap, [][]byte{make([]byte, 100)}, now)
+	require.True(t, propose)
+	require.Equal(t, scheduleReasonFeesLow, reason)
+}
+
+func TestDecideFeeCeilings(t *testing.T) {
+	var (
+		s = &proposalScheduler{
+			feeThreshold:      110,
+			maxDelay:          time.Minute,
+			maxTxListBytes:    1000,
+			maxBaseFee:        big.NewInt(100),
+			maxBlobBaseFee:    big.NewInt(10),
+			blobFeeConsidered: true,
+		}
+		// A long fee spike, the current fees are as high as their averages.
+		spike = &l1Fees{baseFee: big.NewInt(200), avgBaseFee: big.NewInt(200)}
+		now   = time.Now()
+	)
+
+	propose, reason := s.decide(spike, [][]byte{make([]byte, 100)}, now)
+	require.False(t, propose)
+	require.Equal(t, scheduleReasonFeesHigh, reason)
+
+	s.maxBaseFee = common.Big0
+	propose, reason = s.decide(spike, [][]byte{make([]byte, 100)}, now)
+	require.True(t, propose)
+	require.Equal(t, scheduleReasonFeesLow, reason)
+
+	spike.blobBaseFee, spike.avgBlobBaseFee = big.NewInt(20), big.NewInt(20)
+	propose, reason = s.decide(spike, [][]byte{make([]byte, 100)}, now)
+	require.False(t, propose)
+	require.Equal(t, scheduleReasonFeesHigh, reason)
+}
+
+func TestReset(t *testing.T) {
+	var (
+		s = &proposalScheduler{
+			feeThreshold:   110,
+			maxDelay:       time.Minute,
+			maxTxListBytes: 1000,
+		}
+		expensive = &l1Fees{baseFee: big.NewInt(111), avgBaseFee: big.NewInt(100)}
+		now       = time.Now()
+	)
+
+	propose, _ := s.decide(expensive, [][]byte{make([]byte, 100)}, now)
+	require.False(t, propose)
+
+	// The pool is emptied, the next transactions are held for the whole max delay again.
+	s.reset()
+	require.True(t, s.heldSince.IsZero())
+
+	propose, reason := s.decide(expensive, [][]byte{make([]byte, 100)}, now.Add(time.Minute))
+	require.False(t, propose)
+	require.Equal(t, scheduleReasonFeesHigh, reason)
+	require.Equal(t, now.Add(time.Minute), s.heldSince)
+}
+
+func TestSplitFeeHistory(t *testing.T) {
+	current, avg := splitFeeHistory([]*big.Int{big.NewInt(10), big.NewInt(20), big.NewInt(40)})
+	require.Equal(t, big.NewInt(40), current)
+	require.Equal(t, big.NewInt(15), avg)
+
+	current, avg = splitFeeHistory([]*big.Int{common.Big1})
+	require.Equal(t, common.Big1, current)
+	require.Equal(t, common.Big1, avg)
+}
+
+func TestExceedsThreshold(t *testing.T) {
+	require.False(t, exceedsThreshold(big.NewInt(110), big.NewInt(100), 110))
+	require.True(t, exceedsThreshold(big.NewInt(111), big.NewInt(100), 110))
+	require.True(t, exceedsThreshold(common.Big1, common.Big0, 110))
+}
+
+func TestExceedsCeiling(t *testing.T) {
+	require.False(t, exceedsCeiling(big.NewInt(100), big.NewInt(100)))
+	require.True(t, exceedsCeiling(big.NewInt(101), big.NewInt(100)))
+	require.False(t, exceedsCeiling(big.NewInt(101), common.Big0))
+	require.False(t, exceedsCeiling(big.NewInt(101), nil))
+}
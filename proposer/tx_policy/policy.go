@@ -0,0 +1,245 @@
+package policy
+
+import (
+	"bufio"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+
+	taikoMetrics "github.com/taikoxyz/taiko-client/internal/metrics"
+)
+
+// DropReason is the reason why a transaction is dropped by the inclusion policy.
+type DropReason string
+
+// All reasons why a transaction can be dropped.
+const (
+	DropReasonDeniedSender       DropReason = "deniedSender"
+	DropReasonDeniedRecipient    DropReason = "deniedRecipient"
+	DropReasonTipTooLow          DropReason = "tipTooLow"
+	DropReasonSenderQuota        DropReason = "senderQuota"
+	DropReasonContractNotAllowed DropReason = "contractNotAllowed"
+	DropReasonNonceGap           DropReason = "nonceGap"
+)
+
+// dropCounters are the metrics counters of the dropped transactions for each reason.
+var dropCounters = map[DropReason]metrics.Counter{
+	DropReasonDeniedSender:       taikoMetrics.ProposerPolicyDeniedSenderCounter,
+	DropReasonDeniedRecipient:    taikoMetrics.ProposerPolicyDeniedRecipientCounter,
+	DropReasonTipTooLow:          taikoMetrics.ProposerPolicyTipTooLowCounter,
+	DropReasonSenderQuota:        taikoMetrics.ProposerPolicySenderQuotaCounter,
+	DropReasonContractNotAllowed: taikoMetrics.ProposerPolicyContractNotAllowedCounter,
+	DropReasonNonceGap:           taikoMetrics.ProposerPolicyNonceGapCounter,
+}
+
+// Config contains all configurations of the transaction inclusion policy.
+type Config struct {
+	// Files listing the addresses whose transactions are never proposed.
+	DenySendersFile    string
+	DenyRecipientsFile string
+	// File listing the only contracts which can be called, all contract calls are allowed if not set.
+	AllowedContractsFile string
+	// Minimum effective priority fee per gas of a proposed transaction.
+	MinTip *big.Int
+	// Maximum number of transactions of a single sender proposed in one proposing epoch,
+	// zero means no limit.
+	MaxTxsPerSender uint64
+}
+
+// addressLists are the address lists loaded from the configured files.
+type addressLists struct {
+	deniedSenders    map[common.Address]struct{}
+	deniedRecipients map[common.Address]struct{}
+	allowedContracts map[common.Address]struct{}
+}
+
+// Engine applies the transaction inclusion policy to the transactions fetched from the L2 execution
+// engine's transaction pool, before they are proposed.
+type Engine struct {
+	cfg    *Config
+	signer types.Signer
+	lists  *addressLists
+	mutex  sync.RWMutex
+}
+
+// New creates a new inclusion policy engine instance, and loads the configured address lists.
+func New(cfg *Config, chainID *big.Int) (*Engine, error) {
+	e := &Engine{cfg: cfg, signer: types.LatestSignerForChainID(chainID)}
+	if err := e.Reload(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// Reload reloads the configured address lists from their files, the current lists are kept if
+// any of the files can not be loaded.
+func (e *Engine) Reload() error {
+	var (
+		lists = new(addressLists)
+		err   error
+	)
+	if lists.deniedSenders, err = loadAddressList(e.cfg.DenySendersFile); err != nil {
+		return err
+	}
+	if lists.deniedRecipients, err = loadAddressList(e.cfg.DenyRecipientsFile); err != nil {
+		return err
+	}
+	if lists.allowedContracts, err = loadAddressList(e.cfg.AllowedContractsFile); err != nil {
+		return err
+	}
+
+	e.mutex.Lock()
+	e.lists = lists
+	e.mutex.Unlock()
+
+	taikoMetrics.ProposerPolicyReloadCounter.Inc(1)
+
+	log.Info(
+		"Transaction inclusion policy loaded",
+		"deniedSenders", len(lists.deniedSenders),
+		"deniedRecipients", len(lists.deniedRecipients),
+		"allowedContracts", len(lists.allowedContracts),
+		"minTip", e.cfg.MinTip,
+		"maxTxsPerSender", e.cfg.MaxTxsPerSender,
+	)
+
+	return nil
+}
+
+// Filter drops the transactions which violate the inclusion policy from the given transaction lists,
+// the empty lists after filtering are removed. Once a transaction is dropped, all the following
+// transactions of the same sender are dropped as well, since their nonces are no longer continuous.
+func (e *Engine) Filter(txLists []types.Transactions, baseFee *big.Int) ([]types.Transactions, error) {
+	e.mutex.RLock()
+	lists := e.lists
+	e.mutex.RUnlock()
+
+	var (
+		filteredLists []types.Transactions
+		txsPerSender  = make(map[common.Address]uint64)
+		blocked       = make(map[common.Address]struct{})
+		dropped       = make(map[DropReason]int)
+	)
+	for _, txs := range txLists {
+		var filtered types.Transactions
+		for _, tx := range txs {
+			sender, err := types.Sender(e.signer, tx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to recover transaction sender: %w", err)
+			}
+
+			reason, ok := e.check(lists, tx, sender, baseFee, txsPerSender[sender])
+			if _, isBlocked := blocked[sender]; ok && isBlocked {
+				reason, ok = DropReasonNonceGap, false
+			}
+			if !ok {
+				blocked[sender] = struct{}{}
+				dropped[reason]++
+				dropCounters[reason].Inc(1)
+				log.Debug("Transaction dropped by inclusion policy", "hash", tx.Hash(), "sender", sender, "reason", reason)
+				continue
+			}
+
+			txsPerSender[sender]++
+			filtered = append(filtered, tx)
+		}
+
+		if filtered.Len() != 0 {
+			filteredLists = append(filteredLists, filtered)
+		}
+	}
+
+	if len(dropped) != 0 {
+		ctx := make([]interface{}, 0, 2*len(dropped))
+		for reason, count := range dropped {
+			ctx = append(ctx, string(reason), count)
+		}
+		log.Info("Transactions dropped by inclusion policy", ctx...)
+	}
+
+	return filteredLists, nil
+}
+
+// check checks the given transaction against the inclusion policy, and returns the drop reason if
+// the transaction violates the policy.
+func (e *Engine) check(
+	lists *addressLists,
+	tx *types.Transaction,
+	sender common.Address,
+	baseFee *big.Int,
+	senderTxs uint64,
+) (DropReason, bool) {
+	if _, ok := lists.deniedSenders[sender]; ok {
+		return DropReasonDeniedSender, false
+	}
+	if tx.To() != nil {
+		if _, ok := lists.deniedRecipients[*tx.To()]; ok {
+			return DropReasonDeniedRecipient, false
+		}
+	}
+	if e.cfg.MinTip != nil && e.cfg.MinTip.Sign() > 0 {
+		tip, err := tx.EffectiveGasTip(baseFee)
+		if err != nil || tip.Cmp(e.cfg.MinTip) < 0 {
+			return DropReasonTipTooLow, false
+		}
+	}
+	if e.cfg.MaxTxsPerSender != 0 && senderTxs >= e.cfg.MaxTxsPerSender {
+		return DropReasonSenderQuota, false
+	}
+	// Only the listed contracts can be called if there is an allowlist, the transactions carrying
+	// calldata are treated as contract calls, and contract creations are never allowed.
+	if lists.allowedContracts != nil && (tx.To() == nil || len(tx.Data()) != 0) {
+		if tx.To() == nil {
+			return DropReasonContractNotAllowed, false
+		}
+		if _, ok := lists.allowedContracts[*tx.To()]; !ok {
+			return DropReasonContractNotAllowed, false
+		}
+	}
+
+	return "", true
+}
+
+// loadAddressList loads a list of addresses from the given file, one address per line, the empty
+// lines and lines starting with "#" are ignored. Nil is returned if the file path is empty.
+func loadAddressList(path string) (map[common.Address]struct{}, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open address list %s: %w", path, err)
+	}
+	defer f.Close()
+
+	var (
+		addresses = make(map[common.Address]struct{})
+		scanner   = bufio.NewScanner(f)
+		line      = 0
+	)
+	for scanner.Scan() {
+		line++
+		trimmed := strings.TrimSpace(scanner.Text())
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if !common.IsHexAddress(trimmed) {
+			return nil, fmt.Errorf("invalid address %s in %s at line %d", trimmed, path, line)
+		}
+		addresses[common.HexToAddress(trimmed)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read address list %s: %w", path, err)
+	}
+
+	return addresses, nil
+}
@@ -0,0 +1,134 @@
+package policy
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testChainID   = big.NewInt(167001)
+	testBaseFee   = big.NewInt(params.GWei)
+	testRecipient = common.HexToAddress("0x01")
+	testContract  = common.HexToAddress("0x02")
+)
+
+func newTestTx(
+	t *testing.T,
+	key *ecdsa.PrivateKey,
+	nonce uint64,
+	to *common.Address,
+	tip int64,
+	data []byte,
+) *types.Transaction {
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(testChainID), &types.DynamicFeeTx{
+		ChainID:   testChainID,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(tip),
+		GasFeeCap: new(big.Int).Add(testBaseFee, big.NewInt(tip)),
+		Gas:       100_000,
+		To:        to,
+		Data:      data,
+	})
+	require.Nil(t, err)
+
+	return tx
+}
+
+func writeAddressList(t *testing.T, addresses ...common.Address) string {
+	content := "# test list\n\n"
+	for _, address := range addresses {
+		content += address.Hex() + "\n"
+	}
+
+	path := filepath.Join(t.TempDir(), "list.txt")
+	require.Nil(t, os.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
+
+func TestFilter(t *testing.T) {
+	var (
+		alice, _ = crypto.GenerateKey()
+		bob, _   = crypto.GenerateKey()
+		carol, _ = crypto.GenerateKey()
+	)
+
+	e, err := New(&Config{
+		DenySendersFile:      writeAddressList(t, crypto.PubkeyToAddress(carol.PublicKey)),
+		AllowedContractsFile: writeAddressList(t, testContract),
+		MinTip:               big.NewInt(10),
+		MaxTxsPerSender:      2,
+	}, testChainID)
+	require.Nil(t, err)
+
+	txLists, err := e.Filter([]types.Transactions{
+		{
+			newTestTx(t, alice, 0, &testRecipient, 10, nil),
+			newTestTx(t, alice, 1, &testContract, 10, []byte{1}),
+			// Sender quota exceeded.
+			newTestTx(t, alice, 2, &testRecipient, 10, nil),
+			// Tip too low, and the following transaction has a nonce gap.
+			newTestTx(t, bob, 0, &testRecipient, 9, nil),
+			newTestTx(t, bob, 1, &testRecipient, 10, nil),
+		},
+		{
+			// Denied sender.
+			newTestTx(t, carol, 0, &testRecipient, 10, nil),
+			// Contract call and contract creation not allowed.
+			newTestTx(t, alice, 3, &testRecipient, 10, []byte{1}),
+			newTestTx(t, alice, 4, nil, 10, []byte{1}),
+		},
+	}, testBaseFee)
+	require.Nil(t, err)
+	require.Len(t, txLists, 1)
+	require.Len(t, txLists[0], 2)
+	require.Equal(t, uint64(0), txLists[0][0].Nonce())
+	require.Equal(t, uint64(1), txLists[0][1].Nonce())
+}
+
+func TestCheck(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	e, err := New(&Config{DenyRecipientsFile: writeAddressList(t, testRecipient)}, testChainID)
+	require.Nil(t, err)
+
+	reason, ok := e.check(e.lists, newTestTx(t, key, 0, &testRecipient, 0, nil), sender, testBaseFee, 0)
+	require.False(t, ok)
+	require.Equal(t, DropReasonDeniedRecipient, reason)
+
+	// No contract allowlist, no tip floor and no sender quota.
+	_, ok = e.check(e.lists, newTestTx(t, key, 0, &testContract, 0, []byte{1}), sender, testBaseFee, 1024)
+	require.True(t, ok)
+	_, ok = e.check(e.lists, newTestTx(t, key, 0, nil, 0, []byte{1}), sender, testBaseFee, 0)
+	require.True(t, ok)
+}
+
+func TestReload(t *testing.T) {
+	path := writeAddressList(t, testRecipient)
+
+	e, err := New(&Config{DenySendersFile: path}, testChainID)
+	require.Nil(t, err)
+	require.Len(t, e.lists.deniedSenders, 1)
+
+	require.Nil(t, os.WriteFile(path, []byte(testRecipient.Hex()+"\n"+testContract.Hex()), 0600))
+	require.Nil(t, e.Reload())
+	require.Len(t, e.lists.deniedSenders, 2)
+
+	// The current lists are kept if the new ones are invalid.
+	require.Nil(t, os.WriteFile(path, []byte("invalid"), 0600))
+	require.ErrorContains(t, e.Reload(), "invalid address")
+	require.Len(t, e.lists.deniedSenders, 2)
+
+	_, err = New(&Config{DenySendersFile: filepath.Join(t.TempDir(), "missing.txt")}, testChainID)
+	require.ErrorContains(t, err, "failed to open address list")
+}
@@ -0,0 +1,686 @@
+package bond
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
+)
+
+var (
+	// Default interval to refresh the balances, used when it's not configured.
+	defaultCheckInterval = 1 * time.Minute
+	// Maximum number of L1 blocks to filter the escrow events in one request.
+	replayBlockRange uint64 = 10_000
+	// tokenUnit is the number of the smallest units in one TaikoToken.
+	tokenUnit = new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+)
+
+// Config contains all configurations of the bond manager.
+type Config struct {
+	// TaikoL1 contract, which holds the prover's L1 escrow.
+	TaikoL1Address common.Address
+	// Contracts allowed to transfer the prover's TaikoToken for the bonds.
+	Spenders []common.Address
+	// Allowance approved to each spender when its current allowance drops below the threshold,
+	// a nil or zero threshold disables the automatic top-ups.
+	Allowance          *big.Int
+	AllowanceThreshold *big.Int
+	// An alert is logged when the wallet balance plus the L1 escrow drops below this threshold.
+	BalanceThreshold *big.Int
+	// Interval to refresh the balances and top up the allowances.
+	CheckInterval time.Duration
+	// L1 height from which the escrow events are replayed.
+	StartHeight uint64
+}
+
+// Exposure is the bond locked by the prover for an in-flight block, the liveness bond is locked once the
+// block is assigned to the prover until it's proven, and the validity bond is locked once the prover
+// proves it until it's verified.
+type Exposure struct {
+	BlockID      uint64   `json:"blockID"`
+	LivenessBond *big.Int `json:"livenessBond"`
+	ValidityBond *big.Int `json:"validityBond"`
+	Tier         uint16   `json:"tier"`
+	BlockHash    [32]byte `json:"-"`
+	Contested    bool     `json:"contested"`
+}
+
+// total returns the total bond locked for the block.
+func (e *Exposure) total() *big.Int {
+	total := new(big.Int)
+	if e.LivenessBond != nil {
+		total.Add(total, e.LivenessBond)
+	}
+	if e.ValidityBond != nil {
+		total.Add(total, e.ValidityBond)
+	}
+	return total
+}
+
+// Status is a snapshot of the prover's bond states.
+type Status struct {
+	Balance    *big.Int                    `json:"balance"`
+	Escrow     *big.Int                    `json:"escrow"`
+	Allowances map[common.Address]*big.Int `json:"allowances"`
+	Exposure   *big.Int                    `json:"exposure"`
+	Blocks     []*Exposure                 `json:"blocks"`
+}
+
+// Escrow is the prover's L1 escrow rebuilt from the escrow events till the given L1 height.
+type Escrow struct {
+	Amount       *big.Int `json:"amount"`
+	ReplayedTill uint64   `json:"replayedTill"`
+}
+
+// EscrowStore persists the prover's L1 escrow, so that only the escrow events emitted after the
+// last replay need to be replayed after a restart.
+type EscrowStore interface {
+	// GetEscrow returns nil if there is no escrow of the given account.
+	GetEscrow(account common.Address) (*Escrow, error)
+	PutEscrow(account common.Address, escrow *Escrow) error
+}
+
+// escrowEventKind is the kind of a TaikoL1 event changing the prover's L1 escrow.
+type escrowEventKind int
+
+// All kinds of the escrow events.
+const (
+	escrowDeposited escrowEventKind = iota
+	escrowWithdrawn
+	escrowCredited
+	escrowDebited
+)
+
+// escrowEvent is a TaikoL1 TokenDeposited / TokenWithdrawn / TokenCredited / TokenDebited event.
+type escrowEvent struct {
+	kind    escrowEventKind
+	account common.Address
+	amount  *big.Int
+	raw     types.Log
+}
+
+// Manager tracks the prover's TaikoToken balance, allowances and L1 escrow, together with the bonds
+// locked for the in-flight blocks. It tops up the allowances when they drop below the threshold, and
+// alerts when the balance runs low or a bond is lost.
+type Manager struct {
+	rpc     *rpc.Client
+	signer  signer.Signer
+	address common.Address
+	cfg     *Config
+	store   EscrowStore // optional
+
+	balance      *big.Int
+	allowances   map[common.Address]*big.Int
+	escrow       *big.Int
+	replayedTill uint64
+	blocks       map[uint64]*Exposure
+	mutex        sync.RWMutex
+}
+
+// New creates a new bond manager instance for the given signer's account.
+func New(cli *rpc.Client, s signer.Signer, cfg *Config, store EscrowStore) *Manager {
+	if cfg.CheckInterval == 0 {
+		cfg.CheckInterval = defaultCheckInterval
+	}
+
+	return &Manager{
+		rpc:        cli,
+		signer:     s,
+		address:    s.Address(),
+		cfg:        cfg,
+		store:      store,
+		balance:    new(big.Int),
+		allowances: make(map[common.Address]*big.Int),
+		escrow:     new(big.Int),
+		blocks:     make(map[uint64]*Exposure),
+	}
+}
+
+// Start replays the escrow events, and keeps tracking the balances until the given context is done.
+func (m *Manager) Start(ctx context.Context) {
+	var (
+		depositedCh = make(chan *bindings.TaikoL1ClientTokenDeposited, 16)
+		withdrawnCh = make(chan *bindings.TaikoL1ClientTokenWithdrawn, 16)
+		creditedCh  = make(chan *bindings.TaikoL1ClientTokenCredited, 16)
+		debitedCh   = make(chan *bindings.TaikoL1ClientTokenDebited, 16)
+	)
+	// Subscribe at first, so that no event is missed between the replay and the subscriptions.
+	depositedSub := rpc.SubscribeTokenDeposited(m.rpc.TaikoL1, depositedCh)
+	withdrawnSub := rpc.SubscribeTokenWithdrawn(m.rpc.TaikoL1, withdrawnCh)
+	creditedSub := rpc.SubscribeTokenCredited(m.rpc.TaikoL1, creditedCh)
+	debitedSub := rpc.SubscribeTokenDebited(m.rpc.TaikoL1, debitedCh)
+	defer func() {
+		depositedSub.Unsubscribe()
+		withdrawnSub.Unsubscribe()
+		creditedSub.Unsubscribe()
+		debitedSub.Unsubscribe()
+	}()
+
+	if err := m.replayEscrowEvents(ctx); err != nil {
+		log.Error("Failed to replay TaikoToken escrow events", "address", m.address, "error", err)
+	}
+	if err := m.check(ctx); err != nil {
+		log.Error("Failed to check prover bond", "address", m.address, "error", err)
+	}
+
+	ticker := time.NewTicker(m.cfg.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-depositedCh:
+			m.onEscrowEvent(ctx, &escrowEvent{kind: escrowDeposited, amount: e.Amount, raw: e.Raw})
+		case e := <-withdrawnCh:
+			m.onEscrowEvent(ctx, &escrowEvent{kind: escrowWithdrawn, amount: e.Amount, raw: e.Raw})
+		case e := <-creditedCh:
+			m.onEscrowEvent(ctx, &escrowEvent{kind: escrowCredited, account: e.To, amount: e.Amount, raw: e.Raw})
+		case e := <-debitedCh:
+			m.onEscrowEvent(ctx, &escrowEvent{kind: escrowDebited, account: e.From, amount: e.Amount, raw: e.Raw})
+		case <-ticker.C:
+			if err := m.check(ctx); err != nil {
+				log.Error("Failed to check prover bond", "address", m.address, "error", err)
+			}
+		}
+	}
+}
+
+// Approve approves the given amount of TaikoToken to the given spender, and waits for the receipt.
+func (m *Manager) Approve(ctx context.Context, spender common.Address, amount *big.Int) error {
+	opts, err := signer.NewTransactor(ctx, m.signer, m.rpc.L1ChainID)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Approving the contract for taiko token", "allowance", amount, "contract", spender)
+
+	tx, err := m.rpc.TaikoToken.Approve(opts, spender, amount)
+	if err != nil {
+		return err
+	}
+
+	receipt, err := rpc.WaitReceipt(ctx, m.rpc.L1, tx)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Approved the contract for taiko token", "txHash", receipt.TxHash.Hex(), "contract", spender)
+
+	m.mutex.Lock()
+	m.allowances[spender] = new(big.Int).Set(amount)
+	m.mutex.Unlock()
+
+	return nil
+}
+
+// check refreshes the prover's TaikoToken balance and allowances, tops up the allowances below the
+// threshold, and alerts if the balance runs low.
+func (m *Manager) check(ctx context.Context) error {
+	balance, err := m.rpc.TaikoToken.BalanceOf(&bind.CallOpts{Context: ctx}, m.address)
+	if err != nil {
+		return fmt.Errorf("failed to get TaikoToken balance: %w", err)
+	}
+
+	allowances := make(map[common.Address]*big.Int, len(m.cfg.Spenders))
+	for _, spender := range m.cfg.Spenders {
+		if allowances[spender], err = m.rpc.TaikoToken.Allowance(
+			&bind.CallOpts{Context: ctx},
+			m.address,
+			spender,
+		); err != nil {
+			return fmt.Errorf("failed to get TaikoToken allowance: %w", err)
+		}
+	}
+
+	m.mutex.Lock()
+	m.balance, m.allowances = balance, allowances
+	m.mutex.Unlock()
+
+	for spender, allowance := range allowances {
+		if !m.needsTopUp(allowance) {
+			continue
+		}
+
+		log.Warn(
+			"TaikoToken allowance below threshold, topping up",
+			"contract", spender,
+			"allowance", allowance,
+			"threshold", m.cfg.AllowanceThreshold,
+		)
+		if err := m.Approve(ctx, spender, m.cfg.Allowance); err != nil {
+			return fmt.Errorf("failed to top up TaikoToken allowance: %w", err)
+		}
+		metrics.ProverBondAllowanceTopUpCounter.Inc(1)
+	}
+
+	status := m.Status()
+	if m.isBalanceLow(status.Balance, status.Escrow) {
+		log.Warn(
+			"Prover TaikoToken balance below threshold, assignments may start failing",
+			"address", m.address,
+			"balance", status.Balance,
+			"escrow", status.Escrow,
+			"threshold", m.cfg.BalanceThreshold,
+		)
+	}
+
+	log.Info(
+		"Prover bond status",
+		"address", m.address,
+		"balance", status.Balance,
+		"escrow", status.Escrow,
+		"allowances", status.Allowances,
+		"exposure", status.Exposure,
+		"inFlightBlocks", len(status.Blocks),
+	)
+
+	metrics.ProverBondBalanceGauge.Update(toTokenUnits(status.Balance))
+	metrics.ProverBondEscrowGauge.Update(toTokenUnits(status.Escrow))
+
+	return nil
+}
+
+// needsTopUp checks whether the given allowance is below the top-up threshold.
+func (m *Manager) needsTopUp(allowance *big.Int) bool {
+	if m.cfg.AllowanceThreshold == nil || m.cfg.AllowanceThreshold.Sign() == 0 {
+		return false
+	}
+	return allowance.Cmp(m.cfg.AllowanceThreshold) < 0
+}
+
+// isBalanceLow checks whether the given wallet balance plus the L1 escrow is below the alert threshold.
+func (m *Manager) isBalanceLow(balance *big.Int, escrow *big.Int) bool {
+	if m.cfg.BalanceThreshold == nil || m.cfg.BalanceThreshold.Sign() == 0 {
+		return false
+	}
+	return new(big.Int).Add(balance, escrow).Cmp(m.cfg.BalanceThreshold) < 0
+}
+
+// replayEscrowEvents replays the escrow events to rebuild the prover's L1 escrow, from the start height,
+// or from the height the persisted escrow has been replayed till.
+func (m *Manager) replayEscrowEvents(ctx context.Context) error {
+	head, err := m.rpc.L1.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	start := m.cfg.StartHeight
+	if escrow := m.loadEscrow(); escrow != nil && escrow.ReplayedTill >= start {
+		m.mutex.Lock()
+		m.escrow, m.replayedTill = new(big.Int).Set(escrow.Amount), escrow.ReplayedTill
+		m.mutex.Unlock()
+
+		start = escrow.ReplayedTill + 1
+	}
+
+	var replayed int
+	for ; start <= head; start += replayBlockRange {
+		end := start + replayBlockRange - 1
+		if end > head {
+			end = head
+		}
+
+		opts := &bind.FilterOpts{Start: start, End: &end, Context: ctx}
+		events, err := m.filterEscrowEvents(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("failed to filter escrow events from %d to %d: %w", start, end, err)
+		}
+		ownTxs, err := m.filterOwnTransferTxs(opts)
+		if err != nil {
+			return fmt.Errorf("failed to filter TaikoToken transfers from %d to %d: %w", start, end, err)
+		}
+
+		sort.Slice(events, func(i, j int) bool {
+			if events[i].raw.BlockNumber != events[j].raw.BlockNumber {
+				return events[i].raw.BlockNumber < events[j].raw.BlockNumber
+			}
+			return events[i].raw.Index < events[j].raw.Index
+		})
+		for _, e := range events {
+			m.resolveAccount(e, ownTxs)
+			m.applyEscrowEvent(e)
+		}
+		replayed += len(events)
+
+		m.mutex.Lock()
+		m.replayedTill = end
+		m.mutex.Unlock()
+		m.saveEscrow()
+	}
+
+	log.Info("Replayed TaikoToken escrow events", "address", m.address, "events", replayed, "escrow", m.Escrow())
+
+	return nil
+}
+
+// loadEscrow loads the persisted escrow of the prover, nil is returned if there is none.
+func (m *Manager) loadEscrow() *Escrow {
+	if m.store == nil {
+		return nil
+	}
+
+	escrow, err := m.store.GetEscrow(m.address)
+	if err != nil {
+		log.Error("Failed to load TaikoToken escrow", "address", m.address, "error", err)
+		return nil
+	}
+
+	return escrow
+}
+
+// saveEscrow persists the escrow of the prover, together with the height it has been replayed till.
+func (m *Manager) saveEscrow() {
+	if m.store == nil {
+		return
+	}
+
+	m.mutex.RLock()
+	escrow := &Escrow{Amount: new(big.Int).Set(m.escrow), ReplayedTill: m.replayedTill}
+	m.mutex.RUnlock()
+
+	if err := m.store.PutEscrow(m.address, escrow); err != nil {
+		log.Error("Failed to save TaikoToken escrow", "address", m.address, "error", err)
+	}
+}
+
+// filterEscrowEvents filters all kinds of the escrow events with the given filter options.
+func (m *Manager) filterEscrowEvents(ctx context.Context, opts *bind.FilterOpts) ([]*escrowEvent, error) {
+	var events []*escrowEvent
+
+	deposited, err := m.rpc.TaikoL1.FilterTokenDeposited(opts)
+	if err != nil {
+		return nil, err
+	}
+	for deposited.Next() {
+		events = append(events, &escrowEvent{kind: escrowDeposited, amount: deposited.Event.Amount, raw: deposited.Event.Raw})
+	}
+	if err := deposited.Error(); err != nil {
+		return nil, err
+	}
+
+	withdrawn, err := m.rpc.TaikoL1.FilterTokenWithdrawn(opts)
+	if err != nil {
+		return nil, err
+	}
+	for withdrawn.Next() {
+		events = append(events, &escrowEvent{kind: escrowWithdrawn, amount: withdrawn.Event.Amount, raw: withdrawn.Event.Raw})
+	}
+	if err := withdrawn.Error(); err != nil {
+		return nil, err
+	}
+
+	credited, err := m.rpc.TaikoL1.FilterTokenCredited(opts)
+	if err != nil {
+		return nil, err
+	}
+	for credited.Next() {
+		events = append(events, &escrowEvent{
+			kind:    escrowCredited,
+			account: credited.Event.To,
+			amount:  credited.Event.Amount,
+			raw:     credited.Event.Raw,
+		})
+	}
+	if err := credited.Error(); err != nil {
+		return nil, err
+	}
+
+	debited, err := m.rpc.TaikoL1.FilterTokenDebited(opts)
+	if err != nil {
+		return nil, err
+	}
+	for debited.Next() {
+		events = append(events, &escrowEvent{
+			kind:    escrowDebited,
+			account: debited.Event.From,
+			amount:  debited.Event.Amount,
+			raw:     debited.Event.Raw,
+		})
+	}
+
+	return events, debited.Error()
+}
+
+// onEscrowEvent applies a newly received escrow event, the events already replayed are skipped.
+func (m *Manager) onEscrowEvent(ctx context.Context, e *escrowEvent) {
+	m.mutex.RLock()
+	replayed := e.raw.BlockNumber <= m.replayedTill
+	m.mutex.RUnlock()
+
+	if replayed || e.raw.Removed {
+		return
+	}
+
+	if e.kind == escrowDeposited || e.kind == escrowWithdrawn {
+		ownTxs, err := m.filterOwnTransferTxs(&bind.FilterOpts{
+			Start:   e.raw.BlockNumber,
+			End:     &e.raw.BlockNumber,
+			Context: ctx,
+		})
+		if err != nil {
+			log.Error("Failed to filter TaikoToken transfers", "height", e.raw.BlockNumber, "error", err)
+			return
+		}
+		m.resolveAccount(e, ownTxs)
+	}
+	m.applyEscrowEvent(e)
+}
+
+// filterOwnTransferTxs filters the transactions transferring TaikoToken between the prover and TaikoL1 with
+// the given filter options, which is cheaper than looking up the sender of each escrow event transaction.
+func (m *Manager) filterOwnTransferTxs(opts *bind.FilterOpts) (map[common.Hash]bool, error) {
+	txs := make(map[common.Hash]bool)
+	for _, pair := range [][2]common.Address{
+		{m.address, m.cfg.TaikoL1Address},
+		{m.cfg.TaikoL1Address, m.address},
+	} {
+		iter, err := m.rpc.TaikoToken.FilterTransfer(opts, []common.Address{pair[0]}, []common.Address{pair[1]})
+		if err != nil {
+			return nil, err
+		}
+		for iter.Next() {
+			txs[iter.Event.Raw.TxHash] = true
+		}
+		if err := iter.Error(); err != nil {
+			return nil, err
+		}
+	}
+
+	return txs, nil
+}
+
+// resolveAccount sets the account of a TokenDeposited / TokenWithdrawn event, which is the sender of the
+// transaction emitting it. TaikoL1 transfers the deposited / withdrawn TaikoToken from / to the sender in
+// the same transaction, so only the events in the prover's own transfer transactions concern the prover,
+// the account of the other events is left empty.
+func (m *Manager) resolveAccount(e *escrowEvent, ownTxs map[common.Hash]bool) {
+	if (e.kind == escrowDeposited || e.kind == escrowWithdrawn) && ownTxs[e.raw.TxHash] {
+		e.account = m.address
+	}
+}
+
+// applyEscrowEvent updates the prover's L1 escrow with the given escrow event. As in TaikoL1, a debit is
+// paid from the escrow if it's sufficient, otherwise it's transferred from the prover's wallet.
+func (m *Manager) applyEscrowEvent(e *escrowEvent) {
+	if e.account != m.address {
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	switch e.kind {
+	case escrowDeposited, escrowCredited:
+		m.escrow.Add(m.escrow, e.amount)
+	case escrowWithdrawn:
+		m.escrow.Sub(m.escrow, e.amount)
+	case escrowDebited:
+		if e.amount.Cmp(m.escrow) <= 0 {
+			m.escrow.Sub(m.escrow, e.amount)
+		} else {
+			m.balance = new(big.Int).Sub(m.balance, e.amount)
+		}
+	}
+	if m.escrow.Sign() < 0 {
+		m.escrow.SetUint64(0)
+	}
+
+	log.Debug("TaikoToken escrow updated", "kind", e.kind, "amount", e.amount, "escrow", m.escrow)
+}
+
+// OnBlockProposed starts tracking the liveness bond of the given block, if it's assigned to the prover.
+func (m *Manager) OnBlockProposed(e *bindings.TaikoL1ClientBlockProposed) {
+	if e.AssignedProver != m.address {
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, ok := m.blocks[e.BlockId.Uint64()]; ok {
+		return
+	}
+	m.blocks[e.BlockId.Uint64()] = &Exposure{BlockID: e.BlockId.Uint64(), LivenessBond: e.LivenessBond}
+	m.updateExposureMetrics()
+}
+
+// OnTransitionProved tracks the validity bond of the given block if it's proven by the prover, or alerts
+// if the prover's bonds of the block are lost.
+func (m *Manager) OnTransitionProved(e *bindings.TaikoL1ClientTransitionProved) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	exposure, ok := m.blocks[e.BlockId.Uint64()]
+	if e.Prover == m.address {
+		if !ok {
+			exposure = &Exposure{BlockID: e.BlockId.Uint64()}
+			m.blocks[e.BlockId.Uint64()] = exposure
+		}
+		// The liveness bond is returned once the block is proven.
+		exposure.LivenessBond = nil
+		exposure.ValidityBond = e.ValidityBond
+		exposure.Tier = e.Tier
+		exposure.BlockHash = e.Tran.BlockHash
+		exposure.Contested = false
+		m.updateExposureMetrics()
+		return
+	}
+	if !ok {
+		return
+	}
+
+	// The block is proven by another prover, after the prover's proving window expired.
+	if exposure.LivenessBond != nil {
+		m.alertBondLost(exposure.BlockID, "liveness", exposure.LivenessBond, e.Prover)
+		exposure.LivenessBond = nil
+	}
+	// The prover's transition is overridden by a higher tier proof with a different block hash.
+	if exposure.ValidityBond != nil && exposure.BlockHash != e.Tran.BlockHash {
+		m.alertBondLost(exposure.BlockID, "validity", exposure.ValidityBond, e.Prover)
+		exposure.ValidityBond = nil
+	}
+	if exposure.total().Sign() == 0 {
+		delete(m.blocks, exposure.BlockID)
+	}
+	m.updateExposureMetrics()
+}
+
+// OnTransitionContested marks the validity bond of the given block at risk, if the prover's transition
+// is contested.
+func (m *Manager) OnTransitionContested(e *bindings.TaikoL1ClientTransitionContested) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	exposure, ok := m.blocks[e.BlockId.Uint64()]
+	if !ok || exposure.ValidityBond == nil || exposure.BlockHash != e.Tran.BlockHash {
+		return
+	}
+
+	exposure.Contested = true
+	log.Warn(
+		"Prover transition contested, validity bond at risk",
+		"blockID", exposure.BlockID,
+		"validityBond", exposure.ValidityBond,
+		"contester", e.Contester,
+		"contestBond", e.ContestBond,
+	)
+}
+
+// OnBlockVerified stops tracking the bonds of the given block, which are all settled.
+func (m *Manager) OnBlockVerified(e *bindings.TaikoL1ClientBlockVerified) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	delete(m.blocks, e.BlockId.Uint64())
+	m.updateExposureMetrics()
+}
+
+// alertBondLost logs and counts a lost bond of the given block.
+func (m *Manager) alertBondLost(blockID uint64, kind string, amount *big.Int, prover common.Address) {
+	log.Error("Prover bond lost", "blockID", blockID, "bond", kind, "amount", amount, "prover", prover)
+	metrics.ProverBondLostCounter.Inc(1)
+}
+
+// updateExposureMetrics updates the exposure metrics, the caller must hold the mutex.
+func (m *Manager) updateExposureMetrics() {
+	total := new(big.Int)
+	for _, exposure := range m.blocks {
+		total.Add(total, exposure.total())
+	}
+
+	metrics.ProverBondExposureGauge.Update(toTokenUnits(total))
+	metrics.ProverBondInFlightBlocksGauge.Update(int64(len(m.blocks)))
+}
+
+// Escrow returns the prover's current L1 escrow.
+func (m *Manager) Escrow() *big.Int {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return new(big.Int).Set(m.escrow)
+}
+
+// Status returns a snapshot of the prover's bond states, the in-flight blocks are sorted by ID.
+func (m *Manager) Status() *Status {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	status := &Status{
+		Balance:    new(big.Int).Set(m.balance),
+		Escrow:     new(big.Int).Set(m.escrow),
+		Allowances: make(map[common.Address]*big.Int, len(m.allowances)),
+		Exposure:   new(big.Int),
+		Blocks:     make([]*Exposure, 0, len(m.blocks)),
+	}
+	for spender, allowance := range m.allowances {
+		status.Allowances[spender] = new(big.Int).Set(allowance)
+	}
+	for _, exposure := range m.blocks {
+		copied := *exposure
+		status.Blocks = append(status.Blocks, &copied)
+		status.Exposure.Add(status.Exposure, exposure.total())
+	}
+	sort.Slice(status.Blocks, func(i, j int) bool { return status.Blocks[i].BlockID < status.Blocks[j].BlockID })
+
+	return status
+}
+
+// toTokenUnits converts the given amount to TaikoToken units, for the metrics.
+func toTokenUnits(amount *big.Int) float64 {
+	units, _ := new(big.Float).Quo(new(big.Float).SetInt(amount), tokenUnit).Float64()
+	return units
+}
@@ -0,0 +1,311 @@
+package bond
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
+)
+
+var (
+	testOther      = common.HexToAddress("0x01")
+	testBlockHash  = common.HexToHash("0x02")
+	testTaikoL1    = common.HexToAddress("0x03")
+	testTaikoToken = common.HexToAddress("0x04")
+)
+
+// testEscrowStore is an in-memory EscrowStore for tests.
+type testEscrowStore struct {
+	escrows map[common.Address]*Escrow
+}
+
+func (s *testEscrowStore) GetEscrow(account common.Address) (*Escrow, error) {
+	return s.escrows[account], nil
+}
+
+func (s *testEscrowStore) PutEscrow(account common.Address, escrow *Escrow) error {
+	s.escrows[account] = escrow
+	return nil
+}
+
+// testLogQuery is the `eth_getLogs` query sent by the bound contracts.
+type testLogQuery struct {
+	FromBlock hexutil.Uint64   `json:"fromBlock"`
+	ToBlock   hexutil.Uint64   `json:"toBlock"`
+	Address   []common.Address `json:"address"`
+	Topics    [][]common.Hash  `json:"topics"`
+}
+
+// testEscrowService is a mock of the `eth` namespace of a L1 node, which serves the TaikoL1 and
+// TaikoToken event logs, it doesn't serve any transactions.
+type testEscrowService struct {
+	head       uint64
+	logs       []types.Log
+	minQueried uint64
+	mutex      sync.Mutex
+}
+
+func (s *testEscrowService) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(s.head)
+}
+
+func (s *testEscrowService) GetLogs(query testLogQuery) ([]types.Log, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.minQueried == 0 || uint64(query.FromBlock) < s.minQueried {
+		s.minQueried = uint64(query.FromBlock)
+	}
+
+	logs := make([]types.Log, 0)
+	for _, l := range s.logs {
+		if l.BlockNumber < uint64(query.FromBlock) ||
+			l.BlockNumber > uint64(query.ToBlock) ||
+			!containsLogValue(query.Address, l.Address) {
+			continue
+		}
+		matched := true
+		for i, topics := range query.Topics {
+			if len(topics) != 0 && (i >= len(l.Topics) || !containsLogValue(topics, l.Topics[i])) {
+				matched = false
+			}
+		}
+		if matched {
+			logs = append(logs, l)
+		}
+	}
+
+	return logs, nil
+}
+
+func containsLogValue[T comparable](values []T, value T) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// newTestEscrowLog builds a TaikoL1 escrow event log, or a TaikoToken Transfer event log.
+func newTestEscrowLog(t *testing.T, height uint64, txHash common.Hash, name string, args ...interface{}) types.Log {
+	var (
+		address = testTaikoL1
+		meta    = bindings.TaikoL1ClientMetaData
+		topics  []common.Hash
+	)
+	if name == "Transfer" {
+		address, meta = testTaikoToken, bindings.TaikoTokenMetaData
+		topics = []common.Hash{
+			common.BytesToHash(args[0].(common.Address).Bytes()),
+			common.BytesToHash(args[1].(common.Address).Bytes()),
+		}
+		args = args[2:]
+	}
+
+	contractABI, err := meta.GetAbi()
+	require.Nil(t, err)
+	event := contractABI.Events[name]
+
+	data, err := event.Inputs.NonIndexed().Pack(args...)
+	require.Nil(t, err)
+
+	return types.Log{
+		Address:     address,
+		Topics:      append([]common.Hash{event.ID}, topics...),
+		Data:        data,
+		BlockNumber: height,
+		TxHash:      txHash,
+	}
+}
+
+func newTestReplayManager(t *testing.T, service *testEscrowService, store EscrowStore, key *ecdsa.PrivateKey) *Manager {
+	rpcServer := gethRPC.NewServer()
+	require.Nil(t, rpcServer.RegisterName("eth", service))
+	t.Cleanup(rpcServer.Stop)
+
+	server := httptest.NewServer(rpcServer)
+	t.Cleanup(server.Close)
+
+	l1, err := rpc.NewEthClient(context.Background(), server.URL, time.Second)
+	require.Nil(t, err)
+	t.Cleanup(l1.Close)
+
+	taikoL1, err := bindings.NewTaikoL1Client(testTaikoL1, l1)
+	require.Nil(t, err)
+	taikoToken, err := bindings.NewTaikoToken(testTaikoToken, l1)
+	require.Nil(t, err)
+
+	s, err := signer.New(&signer.Config{PrivateKey: key})
+	require.Nil(t, err)
+
+	return New(
+		&rpc.Client{L1: l1, TaikoL1: taikoL1, TaikoToken: taikoToken},
+		s,
+		&Config{TaikoL1Address: testTaikoL1, StartHeight: 1},
+		store,
+	)
+}
+
+func newTestManager(t *testing.T, cfg *Config) *Manager {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	s, err := signer.New(&signer.Config{PrivateKey: key})
+	require.Nil(t, err)
+
+	return New(nil, s, cfg, nil)
+}
+
+func TestApplyEscrowEvent(t *testing.T) {
+	m := newTestManager(t, &Config{})
+	require.Equal(t, defaultCheckInterval, m.cfg.CheckInterval)
+	m.balance = big.NewInt(100)
+
+	for _, e := range []*escrowEvent{
+		{kind: escrowDeposited, account: m.address, amount: big.NewInt(50)},
+		{kind: escrowCredited, account: m.address, amount: big.NewInt(20)},
+		// Events of other accounts are ignored.
+		{kind: escrowCredited, account: testOther, amount: big.NewInt(1000)},
+		{kind: escrowWithdrawn, account: m.address, amount: big.NewInt(10)},
+		// Paid from the escrow.
+		{kind: escrowDebited, account: m.address, amount: big.NewInt(30)},
+		// Paid from the wallet, since the escrow is insufficient.
+		{kind: escrowDebited, account: m.address, amount: big.NewInt(40)},
+	} {
+		m.applyEscrowEvent(e)
+	}
+
+	require.Equal(t, big.NewInt(30), m.Escrow())
+	require.Equal(t, big.NewInt(60), m.Status().Balance)
+}
+
+func TestReplayEscrowEvents(t *testing.T) {
+	blockRange := replayBlockRange
+	replayBlockRange = 10
+	t.Cleanup(func() { replayBlockRange = blockRange })
+
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	var (
+		prover  = crypto.PubkeyToAddress(key.PublicKey)
+		store   = &testEscrowStore{escrows: make(map[common.Address]*Escrow)}
+		service = &testEscrowService{head: 25, logs: []types.Log{
+			newTestEscrowLog(t, 3, common.HexToHash("0x0a"), "TokenDeposited", big.NewInt(100)),
+			newTestEscrowLog(t, 3, common.HexToHash("0x0a"), "Transfer", prover, testTaikoL1, big.NewInt(100)),
+			// Deposits of other accounts are ignored, without looking up their transactions.
+			newTestEscrowLog(t, 5, common.HexToHash("0x0b"), "TokenDeposited", big.NewInt(1000)),
+			newTestEscrowLog(t, 5, common.HexToHash("0x0b"), "Transfer", testOther, testTaikoL1, big.NewInt(1000)),
+			newTestEscrowLog(t, 12, common.HexToHash("0x0c"), "TokenCredited", prover, big.NewInt(20)),
+			newTestEscrowLog(t, 22, common.HexToHash("0x0d"), "TokenWithdrawn", big.NewInt(30)),
+			newTestEscrowLog(t, 22, common.HexToHash("0x0d"), "Transfer", testTaikoL1, prover, big.NewInt(30)),
+		}}
+	)
+
+	m := newTestReplayManager(t, service, store, key)
+	require.Nil(t, m.replayEscrowEvents(context.Background()))
+	require.Equal(t, big.NewInt(90), m.Escrow())
+	require.Equal(t, &Escrow{Amount: big.NewInt(90), ReplayedTill: 25}, store.escrows[prover])
+
+	// After a restart, only the new events are replayed.
+	service.head, service.minQueried = 30, 0
+	service.logs = append(
+		service.logs,
+		newTestEscrowLog(t, 28, common.HexToHash("0x0e"), "TokenCredited", prover, big.NewInt(10)),
+	)
+
+	m = newTestReplayManager(t, service, store, key)
+	require.Nil(t, m.replayEscrowEvents(context.Background()))
+	require.Equal(t, big.NewInt(100), m.Escrow())
+	require.Equal(t, uint64(26), service.minQueried)
+	require.Equal(t, &Escrow{Amount: big.NewInt(100), ReplayedTill: 30}, store.escrows[prover])
+}
+
+func TestExposure(t *testing.T) {
+	m := newTestManager(t, &Config{})
+
+	for i := int64(1); i <= 3; i++ {
+		m.OnBlockProposed(&bindings.TaikoL1ClientBlockProposed{
+			BlockId:        big.NewInt(i),
+			AssignedProver: m.address,
+			LivenessBond:   big.NewInt(10),
+		})
+	}
+	m.OnBlockProposed(&bindings.TaikoL1ClientBlockProposed{
+		BlockId:        big.NewInt(4),
+		AssignedProver: testOther,
+		LivenessBond:   big.NewInt(10),
+	})
+
+	// Block 1 is proven by the prover, its liveness bond is returned and the validity bond is locked.
+	m.OnTransitionProved(&bindings.TaikoL1ClientTransitionProved{
+		BlockId:      common.Big1,
+		Tran:         bindings.TaikoDataTransition{BlockHash: testBlockHash},
+		Prover:       m.address,
+		ValidityBond: big.NewInt(100),
+		Tier:         1,
+	})
+	// Block 2 is proven by another prover, the liveness bond is lost.
+	m.OnTransitionProved(&bindings.TaikoL1ClientTransitionProved{
+		BlockId:      common.Big2,
+		Prover:       testOther,
+		ValidityBond: big.NewInt(100),
+	})
+
+	status := m.Status()
+	require.Len(t, status.Blocks, 2)
+	require.Equal(t, uint64(1), status.Blocks[0].BlockID)
+	require.Nil(t, status.Blocks[0].LivenessBond)
+	require.Equal(t, big.NewInt(100), status.Blocks[0].ValidityBond)
+	require.Equal(t, uint64(3), status.Blocks[1].BlockID)
+	require.Equal(t, big.NewInt(110), status.Exposure)
+
+	// The prover's transition is contested, and then overridden with a different block hash.
+	m.OnTransitionContested(&bindings.TaikoL1ClientTransitionContested{
+		BlockId: common.Big1,
+		Tran:    bindings.TaikoDataTransition{BlockHash: testBlockHash},
+	})
+	require.True(t, m.Status().Blocks[0].Contested)
+
+	m.OnTransitionProved(&bindings.TaikoL1ClientTransitionProved{
+		BlockId: common.Big1,
+		Prover:  testOther,
+		Tier:    2,
+	})
+	m.OnBlockVerified(&bindings.TaikoL1ClientBlockVerified{BlockId: common.Big3})
+
+	status = m.Status()
+	require.Empty(t, status.Blocks)
+	require.Zero(t, status.Exposure.Sign())
+}
+
+func TestThresholds(t *testing.T) {
+	m := newTestManager(t, &Config{})
+	require.False(t, m.needsTopUp(common.Big0))
+	require.False(t, m.isBalanceLow(common.Big0, common.Big0))
+
+	m = newTestManager(t, &Config{AllowanceThreshold: big.NewInt(10), BalanceThreshold: big.NewInt(10)})
+	require.True(t, m.needsTopUp(big.NewInt(9)))
+	require.False(t, m.needsTopUp(big.NewInt(10)))
+	require.True(t, m.isBalanceLow(big.NewInt(4), big.NewInt(5)))
+	require.False(t, m.isBalanceLow(big.NewInt(5), big.NewInt(5)))
+}
+
+func TestToTokenUnits(t *testing.T) {
+	require.Equal(t, 1.5, toTokenUnits(new(big.Int).Mul(big.NewInt(15), big.NewInt(1e17))))
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"math/big"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -12,6 +14,8 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/taikoxyz/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
 )
 
 // Config contains the configurations to initialize a Taiko prover.
@@ -20,11 +24,15 @@ type Config struct {
 	L1HttpEndpoint                          string
 	L2WsEndpoint                            string
 	L2HttpEndpoint                          string
+	L1FallbackEndpoints                     []string
+	L2FallbackEndpoints                     []string
+	RPCHealthCheck                          *rpc.HealthCheckConfig
 	TaikoL1Address                          common.Address
 	TaikoL2Address                          common.Address
 	TaikoTokenAddress                       common.Address
 	AssignmentHookAddress                   common.Address
 	L1ProverPrivKey                         *ecdsa.PrivateKey
+	L1ProverSigner                          signer.Signer
 	ZKEvmRpcdEndpoint                       string
 	ZkEvmRpcdParamsPath                     string
 	StartingBlockID                         *big.Int
@@ -37,6 +45,7 @@ type Config struct {
 	BackOffRetryInterval                    time.Duration
 	ProveUnassignedBlocks                   bool
 	ContesterMode                           bool
+	VerifyProofLocally                      bool
 	EnableLivenessBondProof                 bool
 	RPCTimeout                              time.Duration
 	WaitReceiptTimeout                      time.Duration
@@ -49,21 +58,59 @@ type Config struct {
 	MinSgxTierFee                           *big.Int
 	MinPseZkevmTierFee                      *big.Int
 	MinSgxAndPseZkevmTierFee                *big.Int
+	TierFeeMargin                           uint64
+	TierFeeQueuePremium                     uint64
+	TierFeeProveBlockGas                    uint64
+	TierFeeBondTokenPrice                   *big.Int
+	TierFeeBondAPR                          uint64
+	TierFeeUpdateInterval                   time.Duration
+	FeeTokenRates                           map[common.Address]*big.Int
 	MaxExpiry                               time.Duration
 	MaxProposedIn                           uint64
 	MaxBlockSlippage                        uint64
 	DatabasePath                            string
 	DatabaseCacheSize                       uint64
 	Allowance                               *big.Int
+	BondAllowanceThreshold                  *big.Int
+	BondBalanceThreshold                    *big.Int
+	BondCheckInterval                       time.Duration
 	GuardianProverHealthCheckServerEndpoint *url.URL
 	RaikoHostEndpoint                       string
+	RaikoHostEndpoints                      []string
+	ZkEvmRpcdEndpoints                      []string
+	ProofRaceBackends                       uint64
+	LedgerProfitFilter                      bool
+	LedgerBackendCosts                      map[uint16]*big.Int
+	LedgerUnassignedReward                  *big.Int
+	LedgerContestReward                     *big.Int
+	ContestL2Endpoint                       string
+	ContestMinConfirmations                 uint64
+	ContestMinCooldownRemaining             time.Duration
+	ContestMaxBond                          *big.Int
+	ContestRequireFollowUp                  bool
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
 func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
-	l1ProverPrivKey, err := crypto.ToECDSA(common.FromHex(c.String(flags.L1ProverPrivKey.Name)))
+	var l1ProverPrivKey *ecdsa.PrivateKey
+	if c.IsSet(flags.L1ProverPrivKey.Name) {
+		privKey, err := crypto.ToECDSA(common.FromHex(c.String(flags.L1ProverPrivKey.Name)))
+		if err != nil {
+			return nil, fmt.Errorf("invalid L1 prover private key: %w", err)
+		}
+		l1ProverPrivKey = privKey
+	}
+
+	l1ProverSigner, err := signer.New(&signer.Config{
+		PrivateKey:     l1ProverPrivKey,
+		KeystorePath:   c.String(flags.L1ProverKeystore.Name),
+		PasswordFile:   c.String(flags.L1ProverKeystorePassword.Name),
+		RemoteEndpoint: c.String(flags.L1ProverRemoteSigner.Name),
+		RemoteAddress:  common.HexToAddress(c.String(flags.L1ProverRemoteSignerAddress.Name)),
+		Timeout:        c.Duration(flags.RPCTimeout.Name),
+	})
 	if err != nil {
-		return nil, fmt.Errorf("invalid L1 prover private key: %w", err)
+		return nil, fmt.Errorf("invalid L1 prover signer: %w", err)
 	}
 
 	var startingBlockID *big.Int
@@ -100,6 +147,48 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		allowance = amt
 	}
 
+	bondAllowanceThreshold, err := parseTokenAmount(c, flags.BondAllowanceThreshold.Name)
+	if err != nil {
+		return nil, err
+	}
+	if bondAllowanceThreshold != nil && allowance.Cmp(bondAllowanceThreshold) < 0 {
+		return nil, fmt.Errorf(
+			"--%s must not be lower than --%s",
+			flags.Allowance.Name,
+			flags.BondAllowanceThreshold.Name,
+		)
+	}
+
+	bondBalanceThreshold, err := parseTokenAmount(c, flags.BondBalanceThreshold.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	feeTokenRates, err := parseFeeTokenRates(c.StringSlice(flags.FeeTokens.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	ledgerBackendCosts, err := parseTierCosts(c.StringSlice(flags.LedgerBackendCosts.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	ledgerUnassignedReward, err := parseTokenAmount(c, flags.LedgerUnassignedReward.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	ledgerContestReward, err := parseTokenAmount(c, flags.LedgerContestReward.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	contestMaxBond, err := parseTokenAmount(c, flags.ContestMaxBond.Name)
+	if err != nil {
+		return nil, err
+	}
+
 	var guardianProverHealthCheckServerEndpoint *url.URL
 	if c.IsSet(flags.GuardianProverHealthCheckServerEndpoint.Name) {
 		if guardianProverHealthCheckServerEndpoint, err = url.Parse(
@@ -124,18 +213,30 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 	}
 
 	return &Config{
-		L1WsEndpoint:                            c.String(flags.L1WSEndpoint.Name),
-		L1HttpEndpoint:                          c.String(flags.L1HTTPEndpoint.Name),
-		L2WsEndpoint:                            c.String(flags.L2WSEndpoint.Name),
-		L2HttpEndpoint:                          c.String(flags.L2HTTPEndpoint.Name),
+		L1WsEndpoint:        c.String(flags.L1WSEndpoint.Name),
+		L1HttpEndpoint:      c.String(flags.L1HTTPEndpoint.Name),
+		L2WsEndpoint:        c.String(flags.L2WSEndpoint.Name),
+		L2HttpEndpoint:      c.String(flags.L2HTTPEndpoint.Name),
+		L1FallbackEndpoints: c.StringSlice(flags.L1FallbackEndpoints.Name),
+		L2FallbackEndpoints: c.StringSlice(flags.L2FallbackEndpoints.Name),
+		RPCHealthCheck: &rpc.HealthCheckConfig{
+			Interval:     c.Duration(flags.RPCHealthCheckInterval.Name),
+			MaxHeadLag:   c.Uint64(flags.RPCMaxHeadLag.Name),
+			MaxLatency:   c.Duration(flags.RPCMaxLatency.Name),
+			MaxErrorRate: c.Float64(flags.RPCMaxErrorRate.Name),
+		},
 		TaikoL1Address:                          common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
 		TaikoL2Address:                          common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
 		TaikoTokenAddress:                       common.HexToAddress(c.String(flags.TaikoTokenAddress.Name)),
 		AssignmentHookAddress:                   common.HexToAddress(c.String(flags.ProverAssignmentHookAddress.Name)),
 		L1ProverPrivKey:                         l1ProverPrivKey,
+		L1ProverSigner:                          l1ProverSigner,
 		ZKEvmRpcdEndpoint:                       c.String(flags.ZkEvmRpcdEndpoint.Name),
 		ZkEvmRpcdParamsPath:                     c.String(flags.ZkEvmRpcdParamsPath.Name),
 		RaikoHostEndpoint:                       c.String(flags.RaikoHostEndpoint.Name),
+		RaikoHostEndpoints:                      c.StringSlice(flags.RaikoHostEndpoints.Name),
+		ZkEvmRpcdEndpoints:                      c.StringSlice(flags.ZkEvmRpcdEndpoints.Name),
+		ProofRaceBackends:                       c.Uint64(flags.ProofRaceBackends.Name),
 		StartingBlockID:                         startingBlockID,
 		Dummy:                                   c.Bool(flags.Dummy.Name),
 		GuardianProverAddress:                   common.HexToAddress(c.String(flags.GuardianProver.Name)),
@@ -148,6 +249,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		ProveUnassignedBlocks:                   c.Bool(flags.ProveUnassignedBlocks.Name),
 		ContesterMode:                           c.Bool(flags.ContesterMode.Name),
 		EnableLivenessBondProof:                 c.Bool(flags.EnableLivenessBondProof.Name),
+		VerifyProofLocally:                      c.Bool(flags.VerifyProofLocally.Name),
 		RPCTimeout:                              c.Duration(flags.RPCTimeout.Name),
 		WaitReceiptTimeout:                      c.Duration(flags.WaitReceiptTimeout.Name),
 		ProveBlockGasLimit:                      proveBlockTxGasLimit,
@@ -159,11 +261,89 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		MinSgxTierFee:                           new(big.Int).SetUint64(c.Uint64(flags.MinSgxTierFee.Name)),
 		MinPseZkevmTierFee:                      new(big.Int).SetUint64(c.Uint64(flags.MinPseZkevmTierFee.Name)),
 		MinSgxAndPseZkevmTierFee:                new(big.Int).SetUint64(c.Uint64(flags.MinSgxAndPseZkevmTierFee.Name)),
+		TierFeeMargin:                           c.Uint64(flags.TierFeeMargin.Name),
+		TierFeeQueuePremium:                     c.Uint64(flags.TierFeeQueuePremium.Name),
+		TierFeeProveBlockGas:                    c.Uint64(flags.TierFeeProveBlockGas.Name),
+		TierFeeBondTokenPrice:                   new(big.Int).SetUint64(c.Uint64(flags.TierFeeBondTokenPrice.Name)),
+		TierFeeBondAPR:                          c.Uint64(flags.TierFeeBondAPR.Name),
+		TierFeeUpdateInterval:                   c.Duration(flags.TierFeeUpdateInterval.Name),
+		FeeTokenRates:                           feeTokenRates,
 		MaxExpiry:                               c.Duration(flags.MaxExpiry.Name),
 		MaxBlockSlippage:                        c.Uint64(flags.MaxAcceptableBlockSlippage.Name),
 		MaxProposedIn:                           c.Uint64(flags.MaxProposedIn.Name),
 		DatabasePath:                            c.String(flags.DatabasePath.Name),
 		DatabaseCacheSize:                       c.Uint64(flags.DatabaseCacheSize.Name),
 		Allowance:                               allowance,
+		BondAllowanceThreshold:                  bondAllowanceThreshold,
+		BondBalanceThreshold:                    bondBalanceThreshold,
+		BondCheckInterval:                       c.Duration(flags.BondCheckInterval.Name),
+		LedgerProfitFilter:                      c.Bool(flags.LedgerProfitFilter.Name),
+		LedgerBackendCosts:                      ledgerBackendCosts,
+		LedgerUnassignedReward:                  ledgerUnassignedReward,
+		LedgerContestReward:                     ledgerContestReward,
+		ContestL2Endpoint:                       c.String(flags.ContestL2Endpoint.Name),
+		ContestMinConfirmations:                 c.Uint64(flags.ContestMinConfirmations.Name),
+		ContestMinCooldownRemaining:             c.Duration(flags.ContestMinCooldownRemaining.Name),
+		ContestMaxBond:                          contestMaxBond,
+		ContestRequireFollowUp:                  c.Bool(flags.ContestRequireFollowUp.Name),
 	}, nil
 }
+
+// parseTokenAmount parses the TaikoToken or wei amount of the given flag, nil is returned if the flag is not set.
+func parseTokenAmount(c *cli.Context, name string) (*big.Int, error) {
+	if !c.IsSet(name) {
+		return nil, nil
+	}
+
+	amount, ok := new(big.Int).SetString(c.String(name), 10)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("invalid --%s value: %s", name, c.String(name))
+	}
+
+	return amount, nil
+}
+
+// parseFeeTokenRates parses the accepted ERC-20 fee tokens in the form of `address:rate`.
+func parseFeeTokenRates(values []string) (map[common.Address]*big.Int, error) {
+	rates := make(map[common.Address]*big.Int, len(values))
+	for _, value := range values {
+		token, rate, found := strings.Cut(value, ":")
+		if !found || !common.IsHexAddress(token) {
+			return nil, fmt.Errorf("invalid fee token: %s", value)
+		}
+
+		amount, ok := new(big.Int).SetString(rate, 10)
+		if !ok || amount.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid fee token rate: %s", value)
+		}
+
+		rates[common.HexToAddress(token)] = amount
+	}
+
+	return rates, nil
+}
+
+// parseTierCosts parses the proof costs of each tier in the form of `tier:cost`.
+func parseTierCosts(values []string) (map[uint16]*big.Int, error) {
+	costs := make(map[uint16]*big.Int, len(values))
+	for _, value := range values {
+		tier, cost, found := strings.Cut(value, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid tier cost: %s", value)
+		}
+
+		tierID, err := strconv.ParseUint(tier, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tier cost: %s", value)
+		}
+
+		amount, ok := new(big.Int).SetString(cost, 10)
+		if !ok || amount.Sign() < 0 {
+			return nil, fmt.Errorf("invalid tier cost amount: %s", value)
+		}
+
+		costs[uint16(tierID)] = amount
+	}
+
+	return costs, nil
+}
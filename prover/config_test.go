@@ -3,12 +3,17 @@ package prover
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"os"
+	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli/v2"
 
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-client/cmd/flags"
 )
 
@@ -22,6 +27,7 @@ var (
 	allowance      = "10000000000000000000000000000000000000000000000000"
 	rpcTimeout     = 5 * time.Second
 	minTierFee     = 1024
+	feeToken       = "0x0000000000000000000000000000000000000001"
 )
 
 func (s *ProverTestSuite) TestNewConfigFromCliContextGuardianProver() {
@@ -57,6 +63,21 @@ func (s *ProverTestSuite) TestNewConfigFromCliContextGuardianProver() {
 		s.Equal(uint64(100), c.MaxProposedIn)
 		s.Equal(os.Getenv("ASSIGNMENT_HOOK_ADDRESS"), c.AssignmentHookAddress.String())
 		s.Equal(allowance, c.Allowance.String())
+		s.Equal(allowance, c.BondAllowanceThreshold.String())
+		s.Nil(c.BondBalanceThreshold)
+		s.Equal(time.Minute, c.BondCheckInterval)
+		s.True(c.VerifyProofLocally)
+		s.Equal([]string{"http://localhost:9090"}, c.RaikoHostEndpoints)
+		s.Equal(uint64(1), c.ProofRaceBackends)
+		s.Equal(big.NewInt(1000), c.FeeTokenRates[common.HexToAddress(feeToken)])
+		s.True(c.LedgerProfitFilter)
+		s.Equal(big.NewInt(100), c.LedgerBackendCosts[encoding.TierSgxID])
+		s.Equal(big.NewInt(10), c.LedgerUnassignedReward)
+		s.Nil(c.LedgerContestReward)
+		s.Equal(uint64(1), c.ContestMinConfirmations)
+		s.Equal(time.Hour, c.ContestMinCooldownRemaining)
+		s.Equal(big.NewInt(100), c.ContestMaxBond)
+		s.True(c.ContestRequireFollowUp)
 
 		return err
 	}
@@ -88,6 +109,19 @@ func (s *ProverTestSuite) TestNewConfigFromCliContextGuardianProver() {
 		"--" + flags.DatabaseCacheSize.Name, "128",
 		"--" + flags.MaxProposedIn.Name, "100",
 		"--" + flags.Allowance.Name, allowance,
+		"--" + flags.BondAllowanceThreshold.Name, allowance,
+		"--" + flags.BondCheckInterval.Name, "1m",
+		"--" + flags.VerifyProofLocally.Name,
+		"--" + flags.RaikoHostEndpoints.Name, "http://localhost:9090",
+		"--" + flags.ProofRaceBackends.Name, "1",
+		"--" + flags.FeeTokens.Name, feeToken + ":1000",
+		"--" + flags.LedgerProfitFilter.Name,
+		"--" + flags.LedgerBackendCosts.Name, fmt.Sprintf("%d:100", encoding.TierSgxID),
+		"--" + flags.LedgerUnassignedReward.Name, "10",
+		"--" + flags.ContestMinConfirmations.Name, "1",
+		"--" + flags.ContestMinCooldownRemaining.Name, "1h",
+		"--" + flags.ContestMaxBond.Name, "100",
+		"--" + flags.ContestRequireFollowUp.Name,
 	}))
 }
 
@@ -100,6 +134,46 @@ func (s *ProverTestSuite) TestNewConfigFromCliContextProverKeyError() {
 	}), "invalid L1 prover private key")
 }
 
+func (s *ProverTestSuite) TestNewConfigFromCliContextBondAllowanceThresholdError() {
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContext",
+		"--" + flags.Allowance.Name, "1",
+		"--" + flags.BondAllowanceThreshold.Name, "2",
+	}), "must not be lower than")
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContext",
+		"--" + flags.BondBalanceThreshold.Name, "-1",
+	}), "invalid --bond.balanceThreshold value")
+}
+
+func TestParseFeeTokenRates(t *testing.T) {
+	rates, err := parseFeeTokenRates([]string{feeToken + ":1000"})
+	require.Nil(t, err)
+	require.Equal(t, map[common.Address]*big.Int{common.HexToAddress(feeToken): big.NewInt(1000)}, rates)
+
+	_, err = parseFeeTokenRates([]string{feeToken})
+	require.ErrorContains(t, err, "invalid fee token")
+	_, err = parseFeeTokenRates([]string{"0x:1000"})
+	require.ErrorContains(t, err, "invalid fee token")
+	_, err = parseFeeTokenRates([]string{feeToken + ":0"})
+	require.ErrorContains(t, err, "invalid fee token rate")
+}
+
+func TestParseTierCosts(t *testing.T) {
+	costs, err := parseTierCosts([]string{"200:100", "300:0"})
+	require.Nil(t, err)
+	require.Equal(t, map[uint16]*big.Int{200: big.NewInt(100), 300: big.NewInt(0)}, costs)
+
+	_, err = parseTierCosts([]string{"200"})
+	require.ErrorContains(t, err, "invalid tier cost")
+	_, err = parseTierCosts([]string{"70000:100"})
+	require.ErrorContains(t, err, "invalid tier cost")
+	_, err = parseTierCosts([]string{"200:-1"})
+	require.ErrorContains(t, err, "invalid tier cost amount")
+}
+
 func (s *ProverTestSuite) SetupApp() *cli.App {
 	app := cli.NewApp()
 	app.Flags = []cli.Flag{
@@ -128,6 +202,23 @@ func (s *ProverTestSuite) SetupApp() *cli.App {
 		&cli.Uint64Flag{Name: flags.MaxProposedIn.Name},
 		&cli.StringFlag{Name: flags.ProverAssignmentHookAddress.Name},
 		&cli.StringFlag{Name: flags.Allowance.Name},
+		&cli.BoolFlag{Name: flags.VerifyProofLocally.Name},
+		&cli.StringFlag{Name: flags.BondAllowanceThreshold.Name},
+		&cli.StringFlag{Name: flags.BondBalanceThreshold.Name},
+		&cli.DurationFlag{Name: flags.BondCheckInterval.Name},
+		&cli.StringSliceFlag{Name: flags.RaikoHostEndpoints.Name},
+		&cli.StringSliceFlag{Name: flags.ZkEvmRpcdEndpoints.Name},
+		&cli.Uint64Flag{Name: flags.ProofRaceBackends.Name},
+		&cli.StringSliceFlag{Name: flags.FeeTokens.Name},
+		&cli.BoolFlag{Name: flags.LedgerProfitFilter.Name},
+		&cli.StringSliceFlag{Name: flags.LedgerBackendCosts.Name},
+		&cli.StringFlag{Name: flags.LedgerUnassignedReward.Name},
+		&cli.StringFlag{Name: flags.LedgerContestReward.Name},
+		&cli.StringFlag{Name: flags.ContestL2Endpoint.Name},
+		&cli.Uint64Flag{Name: flags.ContestMinConfirmations.Name},
+		&cli.DurationFlag{Name: flags.ContestMinCooldownRemaining.Name},
+		&cli.StringFlag{Name: flags.ContestMaxBond.Name},
+		&cli.BoolFlag{Name: flags.ContestRequireFollowUp.Name},
 		&cli.StringFlag{Name: flags.ContesterMode.Name},
 	}
 	app.Action = func(ctx *cli.Context) error {
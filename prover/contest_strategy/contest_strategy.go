@@ -0,0 +1,297 @@
+package contest
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	taikoMetrics "github.com/taikoxyz/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+// Action is the action decided for a transition.
+type Action string
+
+// All actions the strategy engine can decide.
+const (
+	// Leave the transition as it is.
+	ActionIgnore Action = "ignore"
+	// Contest the transition, a higher tier proof is expected to follow up.
+	ActionContest Action = "contest"
+	// Override the transition with a higher tier proof directly.
+	ActionProve Action = "prove"
+)
+
+var (
+	// Metrics of the decisions, and of the decisions failed to be carried out, by action.
+	decisionCounters = map[Action]metrics.Counter{
+		ActionIgnore:  taikoMetrics.ProverContestDecisionIgnoreCounter,
+		ActionContest: taikoMetrics.ProverContestDecisionContestCounter,
+		ActionProve:   taikoMetrics.ProverContestDecisionProveCounter,
+	}
+	failedCounters = map[Action]metrics.Counter{
+		ActionContest: taikoMetrics.ProverContestFailedContestCounter,
+		ActionProve:   taikoMetrics.ProverContestFailedProveCounter,
+	}
+)
+
+// Config contains all configurations of the contest strategy engine.
+type Config struct {
+	// Number of verifiers which must independently confirm a transition is wrong, zero means all.
+	MinConfirmations int
+	// A transition is not contested if less than this much of its cooldown window is left.
+	MinCooldownRemaining time.Duration
+	// Maximum contest bond to risk, if a tier's contest bond exceeds it, the transition is proven at a
+	// higher tier directly instead. Nil means no limit.
+	MaxContestBond *big.Int
+	// Whether to only contest a transition if the prover can follow up with a higher tier proof.
+	RequireFollowUp bool
+}
+
+// minIndependentVerifiers is the number of verifiers required to act on a wrong transition, a single
+// L2 node re-deriving a different block is more likely to be faulty itself than the transition.
+const minIndependentVerifiers = 2
+
+// Transition is a transition to decide on.
+type Transition struct {
+	BlockID    *big.Int
+	ParentHash common.Hash
+	State      *bindings.TaikoDataTransitionState
+}
+
+// Decision is the action decided for a transition, and the reasoning behind it.
+type Decision struct {
+	BlockID           *big.Int
+	Action            Action
+	Tier              uint16 // tier of the higher tier proof
+	Reason            string
+	Confirmations     int
+	ContestBond       *big.Int
+	CooldownRemaining time.Duration
+	FollowUp          bool
+
+	blockHash common.Hash
+}
+
+// Engine decides whether to contest a transition, prove it at a higher tier, or ignore it, based on the
+// contest bond, the confidence that the transition is wrong, the remaining cooldown window, and whether
+// a higher tier proof producer is available to follow up.
+type Engine struct {
+	cfg         *Config
+	verifiers   []Verifier
+	tiers       map[uint16]*rpc.TierProviderTierWithID
+	maxTier     uint16
+	hasProducer func(minTier uint16) bool
+	now         func() time.Time
+
+	mutex   sync.Mutex
+	pending map[uint64]*Decision
+}
+
+// New creates a new Engine instance, the hasProducer function reports whether the prover has a proof
+// producer of the given tier or a higher one.
+func New(
+	cfg *Config,
+	verifiers []Verifier,
+	tiers []*rpc.TierProviderTierWithID,
+	hasProducer func(minTier uint16) bool,
+) (*Engine, error) {
+	if len(verifiers) == 0 {
+		return nil, fmt.Errorf("no transition verifier")
+	}
+	if cfg.MinConfirmations > len(verifiers) {
+		return nil, fmt.Errorf(
+			"minimum confirmations %d exceeds the number of verifiers %d",
+			cfg.MinConfirmations,
+			len(verifiers),
+		)
+	}
+
+	e := &Engine{
+		cfg:         cfg,
+		verifiers:   verifiers,
+		tiers:       make(map[uint16]*rpc.TierProviderTierWithID, len(tiers)),
+		hasProducer: hasProducer,
+		now:         time.Now,
+		pending:     make(map[uint64]*Decision),
+	}
+	for _, tier := range tiers {
+		e.tiers[tier.ID] = tier
+		if tier.ID > e.maxTier {
+			e.maxTier = tier.ID
+		}
+	}
+
+	return e, nil
+}
+
+// Decide decides the action for the given transition, the decision is logged and tracked until the
+// block is verified.
+func (e *Engine) Decide(ctx context.Context, t *Transition) *Decision {
+	d := &Decision{
+		BlockID:   t.BlockID,
+		Action:    ActionIgnore,
+		Tier:      t.State.Tier + 1,
+		blockHash: t.State.BlockHash,
+	}
+	d.Action, d.Reason = e.decide(ctx, t, d)
+
+	log.Info(
+		"Contest decision",
+		"blockID", d.BlockID,
+		"action", d.Action,
+		"reason", d.Reason,
+		"tier", t.State.Tier,
+		"blockHash", common.Hash(t.State.BlockHash),
+		"contester", t.State.Contester,
+		"confirmations", d.Confirmations,
+		"verifiers", len(e.verifiers),
+		"contestBond", d.ContestBond,
+		"cooldownRemaining", d.CooldownRemaining,
+		"followUp", d.FollowUp,
+	)
+	decisionCounters[d.Action].Inc(1)
+
+	e.mutex.Lock()
+	e.pending[d.BlockID.Uint64()] = d
+	e.mutex.Unlock()
+
+	return d
+}
+
+// decide returns the action for the given transition and the reason, the decision details are filled
+// in along the way.
+func (e *Engine) decide(ctx context.Context, t *Transition, d *Decision) (Action, string) {
+	tier, ok := e.tiers[t.State.Tier]
+	if !ok {
+		return ActionIgnore, "unknown transition tier"
+	}
+
+	cooldownWindow := time.Duration(tier.CooldownWindow.Uint64()) * time.Second
+	d.ContestBond = tier.ContestBond
+	d.CooldownRemaining = time.Unix(int64(t.State.Timestamp), 0).Add(cooldownWindow).Sub(e.now()).Truncate(time.Second)
+	d.FollowUp = t.State.Tier < e.maxTier && e.hasProducer(d.Tier)
+
+	var validOn string
+	d.Confirmations, validOn = e.verify(ctx, t)
+	if validOn != "" {
+		return ActionIgnore, fmt.Sprintf("transition matches the canonical chain of %s", validOn)
+	}
+	if d.Confirmations < e.minConfirmations() {
+		return ActionIgnore, "not enough verifiers confirmed the transition is wrong"
+	}
+	if len(e.verifiers) < minIndependentVerifiers {
+		return ActionIgnore, "not enough independent verifiers configured to act on a wrong transition"
+	}
+
+	if t.State.Contester != (common.Address{}) {
+		if !d.FollowUp {
+			return ActionIgnore, "transition already contested, no higher tier producer to follow up"
+		}
+		return ActionProve, "transition already contested, follow up with a higher tier proof"
+	}
+
+	if t.State.Tier >= e.maxTier {
+		return ActionIgnore, "transition is of the highest tier"
+	}
+	if e.cfg.MinCooldownRemaining > 0 && d.CooldownRemaining < e.cfg.MinCooldownRemaining {
+		return ActionIgnore, "not enough cooldown window left to contest"
+	}
+	if e.cfg.MaxContestBond != nil && d.ContestBond != nil && d.ContestBond.Cmp(e.cfg.MaxContestBond) > 0 {
+		if !d.FollowUp {
+			return ActionIgnore, "contest bond exceeds the limit, no higher tier producer to prove instead"
+		}
+		return ActionProve, "contest bond exceeds the limit, prove at a higher tier instead"
+	}
+	if !d.FollowUp && e.cfg.RequireFollowUp {
+		return ActionIgnore, "no higher tier producer to follow up the contest"
+	}
+
+	return ActionContest, "transition is wrong"
+}
+
+// verify checks the given transition with all verifiers, and returns the number of verifiers which
+// confirmed it's wrong, or the name of the verifier on which it's valid.
+func (e *Engine) verify(ctx context.Context, t *Transition) (int, string) {
+	var confirmations int
+	for _, v := range e.verifiers {
+		valid, err := v.IsValid(ctx, t.BlockID, t.ParentHash, t.State.BlockHash, t.State.SignalRoot)
+		if err != nil {
+			log.Warn("Failed to verify transition", "blockID", t.BlockID, "verifier", v.Name(), "error", err)
+			continue
+		}
+		if valid {
+			return 0, v.Name()
+		}
+		confirmations++
+	}
+
+	return confirmations, ""
+}
+
+// minConfirmations returns the number of verifiers which must confirm a transition is wrong.
+func (e *Engine) minConfirmations() int {
+	if e.cfg.MinConfirmations == 0 {
+		return len(e.verifiers)
+	}
+
+	return e.cfg.MinConfirmations
+}
+
+// RecordExecution logs the result of carrying out the given decision.
+func (e *Engine) RecordExecution(d *Decision, err error) {
+	if err != nil {
+		log.Error("Failed to carry out contest decision", "blockID", d.BlockID, "action", d.Action, "error", err)
+		if counter, ok := failedCounters[d.Action]; ok {
+			counter.Inc(1)
+		}
+		return
+	}
+
+	log.Info("Contest decision carried out", "blockID", d.BlockID, "action", d.Action, "tier", d.Tier)
+}
+
+// OnBlockVerified logs the outcome of the decision made for the given block, a contested or overridden
+// transition is won if it's not the verified one.
+func (e *Engine) OnBlockVerified(ev *bindings.TaikoL1ClientBlockVerified) {
+	e.mutex.Lock()
+	d, ok := e.pending[ev.BlockId.Uint64()]
+	delete(e.pending, ev.BlockId.Uint64())
+	e.mutex.Unlock()
+
+	if !ok {
+		return
+	}
+
+	var (
+		overridden = d.blockHash != ev.BlockHash
+		outcome    = "verified"
+		counter    = taikoMetrics.ProverContestOutcomeVerifiedCounter
+	)
+	switch {
+	case d.Action != ActionIgnore && overridden:
+		outcome, counter = "won", taikoMetrics.ProverContestOutcomeWonCounter
+	case d.Action != ActionIgnore:
+		outcome, counter = "lost", taikoMetrics.ProverContestOutcomeLostCounter
+	case overridden:
+		outcome, counter = "overridden", taikoMetrics.ProverContestOutcomeOverriddenCounter
+	}
+
+	log.Info(
+		"Contest decision outcome",
+		"blockID", d.BlockID,
+		"action", d.Action,
+		"outcome", outcome,
+		"reason", d.Reason,
+		"decidedHash", d.blockHash,
+		"verifiedHash", common.Hash(ev.BlockHash),
+	)
+	counter.Inc(1)
+}
@@ -0,0 +1,235 @@
+package contest
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+var (
+	testNow   = time.Unix(1_700_000_000, 0)
+	testTiers = []*rpc.TierProviderTierWithID{
+		{ID: 100, ITierProviderTier: bindings.ITierProviderTier{
+			ContestBond:    big.NewInt(10),
+			CooldownWindow: big.NewInt(3600),
+		}},
+		{ID: 200, ITierProviderTier: bindings.ITierProviderTier{
+			ContestBond:    big.NewInt(100),
+			CooldownWindow: big.NewInt(3600),
+		}},
+		{ID: 1000, ITierProviderTier: bindings.ITierProviderTier{
+			ContestBond:    big.NewInt(0),
+			CooldownWindow: big.NewInt(3600),
+		}},
+	}
+)
+
+// testVerifier is a Verifier for tests with a fixed result.
+type testVerifier struct {
+	valid bool
+	err   error
+}
+
+func (v *testVerifier) Name() string { return "test" }
+
+func (v *testVerifier) IsValid(context.Context, *big.Int, common.Hash, common.Hash, common.Hash) (bool, error) {
+	return v.valid, v.err
+}
+
+func newTestEngine(t *testing.T, cfg *Config, maxProducerTier uint16, verifiers ...Verifier) *Engine {
+	if len(verifiers) == 0 {
+		verifiers = []Verifier{&testVerifier{}, &testVerifier{}}
+	}
+
+	e, err := New(cfg, verifiers, testTiers, func(minTier uint16) bool { return minTier <= maxProducerTier })
+	require.Nil(t, err)
+	e.now = func() time.Time { return testNow }
+
+	return e
+}
+
+func newTestTransition(tier uint16, contester common.Address, age time.Duration) *Transition {
+	return &Transition{
+		BlockID:    common.Big1,
+		ParentHash: common.HexToHash("0x01"),
+		State: &bindings.TaikoDataTransitionState{
+			BlockHash: common.HexToHash("0x02"),
+			Timestamp: uint64(testNow.Add(-age).Unix()),
+			Contester: contester,
+			Tier:      tier,
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(&Config{}, nil, testTiers, nil)
+	require.NotNil(t, err)
+
+	_, err = New(&Config{MinConfirmations: 2}, []Verifier{&testVerifier{}}, testTiers, nil)
+	require.NotNil(t, err)
+}
+
+func TestDecide(t *testing.T) {
+	contester := common.HexToAddress("0x03")
+
+	tests := []struct {
+		name            string
+		cfg             *Config
+		maxProducerTier uint16
+		verifiers       []Verifier
+		transition      *Transition
+		action          Action
+	}{
+		{
+			"contest by default",
+			&Config{},
+			200,
+			nil,
+			newTestTransition(100, common.Address{}, 0),
+			ActionContest,
+		},
+		{
+			"single verifier",
+			&Config{},
+			200,
+			[]Verifier{&testVerifier{}},
+			newTestTransition(100, common.Address{}, 0),
+			ActionIgnore,
+		},
+		{
+			"single verifier already contested",
+			&Config{},
+			200,
+			[]Verifier{&testVerifier{}},
+			newTestTransition(100, contester, 0),
+			ActionIgnore,
+		},
+		{
+			"valid transition",
+			&Config{},
+			200,
+			[]Verifier{&testVerifier{}, &testVerifier{valid: true}},
+			newTestTransition(100, common.Address{}, 0),
+			ActionIgnore,
+		},
+		{
+			"not enough confirmations",
+			&Config{},
+			200,
+			[]Verifier{&testVerifier{}, &testVerifier{err: errors.New("test")}},
+			newTestTransition(100, common.Address{}, 0),
+			ActionIgnore,
+		},
+		{
+			"enough confirmations",
+			&Config{MinConfirmations: 1},
+			200,
+			[]Verifier{&testVerifier{}, &testVerifier{err: errors.New("test")}},
+			newTestTransition(100, common.Address{}, 0),
+			ActionContest,
+		},
+		{
+			"already contested",
+			&Config{},
+			200,
+			nil,
+			newTestTransition(100, contester, 0),
+			ActionProve,
+		},
+		{
+			"already contested without follow up",
+			&Config{},
+			100,
+			nil,
+			newTestTransition(100, contester, 0),
+			ActionIgnore,
+		},
+		{
+			"highest tier",
+			&Config{},
+			1000,
+			nil,
+			newTestTransition(1000, common.Address{}, 0),
+			ActionIgnore,
+		},
+		{
+			"not enough cooldown window left",
+			&Config{MinCooldownRemaining: 30 * time.Minute},
+			200,
+			nil,
+			newTestTransition(100, common.Address{}, 45*time.Minute),
+			ActionIgnore,
+		},
+		{
+			"enough cooldown window left",
+			&Config{MinCooldownRemaining: 30 * time.Minute},
+			200,
+			nil,
+			newTestTransition(100, common.Address{}, 15*time.Minute),
+			ActionContest,
+		},
+		{
+			"contest bond exceeds the limit",
+			&Config{MaxContestBond: big.NewInt(50)},
+			1000,
+			nil,
+			newTestTransition(200, common.Address{}, 0),
+			ActionProve,
+		},
+		{
+			"contest bond exceeds the limit without follow up",
+			&Config{MaxContestBond: big.NewInt(50)},
+			200,
+			nil,
+			newTestTransition(200, common.Address{}, 0),
+			ActionIgnore,
+		},
+		{
+			"follow up required",
+			&Config{RequireFollowUp: true},
+			100,
+			nil,
+			newTestTransition(100, common.Address{}, 0),
+			ActionIgnore,
+		},
+		{
+			"unknown tier",
+			&Config{},
+			1000,
+			nil,
+			newTestTransition(300, common.Address{}, 0),
+			ActionIgnore,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newTestEngine(t, tt.cfg, tt.maxProducerTier, tt.verifiers...)
+			d := e.Decide(context.Background(), tt.transition)
+			require.Equal(t, tt.action, d.Action, d.Reason)
+			require.Equal(t, tt.transition.State.Tier+1, d.Tier)
+		})
+	}
+}
+
+func TestOnBlockVerified(t *testing.T) {
+	e := newTestEngine(t, &Config{}, 200)
+
+	d := e.Decide(context.Background(), newTestTransition(100, common.Address{}, 0))
+	require.Equal(t, ActionContest, d.Action)
+	require.Len(t, e.pending, 1)
+
+	e.OnBlockVerified(&bindings.TaikoL1ClientBlockVerified{BlockId: common.Big2})
+	require.Len(t, e.pending, 1)
+
+	e.OnBlockVerified(&bindings.TaikoL1ClientBlockVerified{BlockId: common.Big1, BlockHash: common.HexToHash("0x04")})
+	require.Empty(t, e.pending)
+}
@@ -0,0 +1,106 @@
+package contest
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+// Verifier checks transitions against the canonical chain of an L2 node.
+type Verifier interface {
+	// Name is used to label the logs.
+	Name() string
+	// IsValid returns whether the given transition matches the node's canonical chain.
+	IsValid(
+		ctx context.Context,
+		blockID *big.Int,
+		parentHash common.Hash,
+		blockHash common.Hash,
+		signalRoot common.Hash,
+	) (bool, error)
+}
+
+// NodeVerifier re-derives the block hash and the signal root of a transition from an L2 node.
+type NodeVerifier struct {
+	name    string
+	client  *rpc.EthClient
+	taikoL2 *bindings.TaikoL2Client
+}
+
+// NewNodeVerifier creates a new NodeVerifier instance of the given L2 node.
+func NewNodeVerifier(name string, client *rpc.EthClient, taikoL2Address common.Address) (*NodeVerifier, error) {
+	taikoL2, err := bindings.NewTaikoL2Client(taikoL2Address, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NodeVerifier{name: name, client: client, taikoL2: taikoL2}, nil
+}
+
+// Name implements the Verifier interface.
+func (v *NodeVerifier) Name() string {
+	return v.name
+}
+
+// IsValid implements the Verifier interface.
+func (v *NodeVerifier) IsValid(
+	ctx context.Context,
+	blockID *big.Int,
+	parentHash common.Hash,
+	blockHash common.Hash,
+	signalRoot common.Hash,
+) (bool, error) {
+	parent, err := v.parentByBlockID(ctx, blockID)
+	if err != nil {
+		return false, err
+	}
+
+	header, err := v.client.HeaderByNumber(ctx, blockID)
+	if err != nil {
+		return false, err
+	}
+
+	l2SignalService, err := v.taikoL2.Resolve0(
+		&bind.CallOpts{Context: ctx, BlockNumber: blockID},
+		rpc.StringToBytes32("signal_service"),
+		false,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	proof, err := v.client.GetProof(
+		ctx,
+		l2SignalService,
+		[]string{"0x0000000000000000000000000000000000000000000000000000000000000000"},
+		blockID,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return parent.Hash() == parentHash &&
+		header.Hash() == blockHash &&
+		proof.StorageHash == signalRoot, nil
+}
+
+// parentByBlockID fetches the parent header of the given block, based on the L1 origin of the parent.
+func (v *NodeVerifier) parentByBlockID(ctx context.Context, blockID *big.Int) (*types.Header, error) {
+	parentBlockID := new(big.Int).Sub(blockID, common.Big1)
+	if parentBlockID.Sign() == 0 {
+		return v.client.HeaderByNumber(ctx, common.Big0)
+	}
+
+	l1Origin, err := v.client.L1OriginByID(ctx, parentBlockID)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.client.HeaderByHash(ctx, l1Origin.L2BlockHash)
+}
@@ -2,22 +2,32 @@ package db
 
 import (
 	"bytes"
+	"encoding/binary"
+	"fmt"
 	"math/big"
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 var (
-	blockKeyPrefix = "block"
-	separator      = "++"
+	blockKeyPrefix   = "block"
+	blockIDKeyPrefix = "blockID"
+	separator        = "++"
+
+	// A block value is the block hash, the signature and the 32 bytes big-endian block ID.
+	blockValueLength = common.HashLength + crypto.SignatureLength + common.HashLength
+	// A legacy block value is the block hash, the signature and the block ID joined by the separator,
+	// the block ID is at most 8 bytes, so it's always shorter than a block value.
+	legacyBlockValueMinLength = common.HashLength + crypto.SignatureLength + 2*len(separator)
 )
 
 // SignedBlockData is the data stored in the db for a signed block
 type SignedBlockData struct {
-	BlockID   *big.Int
-	BlockHash common.Hash
-	Signature string
+	BlockID   *big.Int    `json:"blockID"`
+	BlockHash common.Hash `json:"blockHash"`
+	Signature string      `json:"signature"`
 }
 
 // BuildBlockKey will build a block key for a signed block
@@ -30,23 +40,71 @@ func BuildBlockKey(blockTimestamp uint64, blockNumber uint64) []byte {
 		}, []byte(separator))
 }
 
-// BuildBlockValue will build a block value for a signed block
-func BuildBlockValue(hash []byte, signature []byte, blockID *big.Int) []byte {
-	return bytes.Join(
-		[][]byte{
-			hash,
-			signature,
-			blockID.Bytes(),
-		}, []byte(separator))
+// ParseBlockKey will parse the block timestamp and block number from a signed block key
+func ParseBlockKey(key []byte) (uint64, uint64, error) {
+	v := bytes.Split(key, []byte(separator))
+	if len(v) != 3 || string(v[0]) != blockKeyPrefix {
+		return 0, 0, fmt.Errorf("invalid signed block key: %s", key)
+	}
+
+	blockTimestamp, err := strconv.ParseUint(string(v[1]), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid signed block key timestamp: %s", key)
+	}
+	blockNumber, err := strconv.ParseUint(string(v[2]), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid signed block key number: %s", key)
+	}
+
+	return blockTimestamp, blockNumber, nil
+}
+
+// BuildBlockIDKey will build a key which indexes the block key of a signed block by block ID,
+// the block ID is big-endian encoded, so that the keys are ordered by block ID.
+func BuildBlockIDKey(blockID uint64) []byte {
+	return append([]byte(blockIDKeyPrefix+separator), blockIDKeySuffix(blockID)...)
 }
 
-// SignedBlockDataFromValue will build a SignedBlockData from a value
-func SignedBlockDataFromValue(val []byte) SignedBlockData {
-	v := bytes.Split(val, []byte(separator))
+// blockIDKeySuffix returns the part of a block ID key after the prefix.
+func blockIDKeySuffix(blockID uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, blockID)
+}
 
-	return SignedBlockData{
-		BlockID:   new(big.Int).SetBytes(v[2]),
-		BlockHash: common.BytesToHash(v[0]),
-		Signature: common.Bytes2Hex(v[1]),
+// BuildBlockValue will build a fixed-length block value for a signed block
+func BuildBlockValue(hash []byte, signature []byte, blockID *big.Int) ([]byte, error) {
+	if len(hash) != common.HashLength {
+		return nil, fmt.Errorf("invalid signed block hash length: %d", len(hash))
+	}
+	if len(signature) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid signed block signature length: %d", len(signature))
+	}
+
+	return bytes.Join([][]byte{hash, signature, common.BigToHash(blockID).Bytes()}, nil), nil
+}
+
+// SignedBlockDataFromValue will build a SignedBlockData from a value, the legacy values joined
+// by the separator are also supported.
+func SignedBlockDataFromValue(val []byte) (SignedBlockData, error) {
+	var hash, signature, blockID []byte
+	switch {
+	case len(val) == blockValueLength:
+		hash = val[:common.HashLength]
+		signature = val[common.HashLength : common.HashLength+crypto.SignatureLength]
+		blockID = val[common.HashLength+crypto.SignatureLength:]
+	case len(val) >= legacyBlockValueMinLength &&
+		len(val) <= legacyBlockValueMinLength+8 &&
+		string(val[common.HashLength:common.HashLength+len(separator)]) == separator &&
+		string(val[legacyBlockValueMinLength-len(separator):legacyBlockValueMinLength]) == separator:
+		hash = val[:common.HashLength]
+		signature = val[common.HashLength+len(separator) : legacyBlockValueMinLength-len(separator)]
+		blockID = val[legacyBlockValueMinLength:]
+	default:
+		return SignedBlockData{}, fmt.Errorf("invalid signed block value length: %d", len(val))
 	}
+
+	return SignedBlockData{
+		BlockID:   new(big.Int).SetBytes(blockID),
+		BlockHash: common.BytesToHash(hash),
+		Signature: common.Bytes2Hex(signature),
+	}, nil
 }
@@ -14,11 +14,14 @@ func Test_BuildBlockKey(t *testing.T) {
 }
 
 func Test_BuildBlockValue(t *testing.T) {
-	v := BuildBlockValue([]byte("hash"), []byte("sig"), big.NewInt(1))
-	spl := bytes.Split(v, []byte(separator))
-	assert.Equal(t, "hash", string(spl[0]))
-	assert.Equal(t, "sig", string(spl[1]))
-	assert.Equal(t, uint64(1), new(big.Int).SetBytes(spl[2]).Uint64())
+	_, err := BuildBlockValue([]byte("hash"), make([]byte, 65), big.NewInt(1))
+	assert.NotNil(t, err)
+	_, err = BuildBlockValue(common.Hash{}.Bytes(), []byte("sig"), big.NewInt(1))
+	assert.NotNil(t, err)
+
+	v, err := BuildBlockValue(common.Hash{}.Bytes(), make([]byte, 65), big.NewInt(1))
+	assert.Nil(t, err)
+	assert.Len(t, v, blockValueLength)
 }
 
 func Test_SignedBlockDataFromValue(t *testing.T) {
@@ -26,10 +29,46 @@ func Test_SignedBlockDataFromValue(t *testing.T) {
 	// nolint: lll
 	sig := common.Hex2Bytes("789a80053e4927d0a898db8e065e948f5cf086e32f9ccaa54c1908e22ac430c62621578113ddbb62d509bf6049b8fb544ab06d36f916685a2eb8e57ffadde02301")
 
-	v := BuildBlockValue(hash.Bytes(), sig, big.NewInt(1))
-	data := SignedBlockDataFromValue(v)
+	v, err := BuildBlockValue(hash.Bytes(), sig, big.NewInt(1))
+	assert.Nil(t, err)
+	data, err := SignedBlockDataFromValue(v)
+	assert.Nil(t, err)
 
 	assert.Equal(t, common.Bytes2Hex(sig), data.Signature)
 	assert.Equal(t, hash, data.BlockHash)
 	assert.Equal(t, data.BlockID, big.NewInt(1))
+
+	// The separator bytes in the hash and the signature.
+	hash = common.HexToHash("2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b")
+	sig = bytes.Repeat([]byte(separator), 33)[:65]
+	for _, v := range [][]byte{
+		bytes.Join([][]byte{hash.Bytes(), sig, big.NewInt(0x2b2b).Bytes()}, []byte(separator)),
+		bytes.Join([][]byte{hash.Bytes(), sig, common.BigToHash(big.NewInt(0x2b2b)).Bytes()}, nil),
+	} {
+		data, err = SignedBlockDataFromValue(v)
+		assert.Nil(t, err)
+		assert.Equal(t, common.Bytes2Hex(sig), data.Signature)
+		assert.Equal(t, hash, data.BlockHash)
+		assert.Equal(t, big.NewInt(0x2b2b), data.BlockID)
+	}
+
+	_, err = SignedBlockDataFromValue([]byte("hash++sig++1"))
+	assert.NotNil(t, err)
+}
+
+func Test_ParseBlockKey(t *testing.T) {
+	blockTimestamp, blockNumber, err := ParseBlockKey(BuildBlockKey(1, 300))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), blockTimestamp)
+	assert.Equal(t, uint64(300), blockNumber)
+
+	_, _, err = ParseBlockKey([]byte("block++1"))
+	assert.NotNil(t, err)
+	_, _, err = ParseBlockKey([]byte("block++a++300"))
+	assert.NotNil(t, err)
+}
+
+func Test_BuildBlockIDKey(t *testing.T) {
+	assert.Equal(t, append([]byte("blockID++"), 0, 0, 0, 0, 0, 0, 1, 44), BuildBlockIDKey(300))
+	assert.Equal(t, -1, bytes.Compare(BuildBlockIDKey(9), BuildBlockIDKey(10)))
 }
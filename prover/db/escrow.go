@@ -0,0 +1,65 @@
+package db
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+
+	bond "github.com/taikoxyz/taiko-client/prover/bond_manager"
+)
+
+var escrowKeyPrefix = "escrow"
+
+// BuildEscrowKey will build a key for the L1 escrow of the given account.
+func BuildEscrowKey(account common.Address) []byte {
+	return bytes.Join(
+		[][]byte{
+			[]byte(escrowKeyPrefix),
+			[]byte(account.Hex()),
+		}, []byte(separator))
+}
+
+// EscrowStore persists the prover's L1 escrow, it implements the bond.EscrowStore interface.
+type EscrowStore struct {
+	db ethdb.KeyValueStore
+}
+
+// NewEscrowStore creates a new EscrowStore instance.
+func NewEscrowStore(db ethdb.KeyValueStore) *EscrowStore {
+	return &EscrowStore{db: db}
+}
+
+// GetEscrow returns the L1 escrow of the given account, nil is returned if there is no such escrow.
+func (s *EscrowStore) GetEscrow(account common.Address) (*bond.Escrow, error) {
+	key := BuildEscrowKey(account)
+
+	has, err := s.db.Has(key)
+	if err != nil || !has {
+		return nil, err
+	}
+
+	val, err := s.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	escrow := new(bond.Escrow)
+	if err := json.Unmarshal(val, escrow); err != nil {
+		return nil, fmt.Errorf("failed to decode escrow (account %s): %w", account, err)
+	}
+
+	return escrow, nil
+}
+
+// PutEscrow saves the L1 escrow of the given account.
+func (s *EscrowStore) PutEscrow(account common.Address, escrow *bond.Escrow) error {
+	val, err := json.Marshal(escrow)
+	if err != nil {
+		return fmt.Errorf("failed to encode escrow (account %s): %w", account, err)
+	}
+
+	return s.db.Put(BuildEscrowKey(account), val)
+}
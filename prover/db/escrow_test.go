@@ -0,0 +1,40 @@
+package db
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/stretchr/testify/assert"
+
+	bond "github.com/taikoxyz/taiko-client/prover/bond_manager"
+)
+
+func Test_BuildEscrowKey(t *testing.T) {
+	assert.Equal(
+		t,
+		[]byte("escrow++0x0000000000000000000000000000000000000001"),
+		BuildEscrowKey(common.HexToAddress("0x01")),
+	)
+}
+
+func Test_EscrowStore(t *testing.T) {
+	s := NewEscrowStore(memorydb.New())
+
+	escrow, err := s.GetEscrow(common.HexToAddress("0x01"))
+	assert.Nil(t, err)
+	assert.Nil(t, escrow)
+
+	assert.Nil(t, s.PutEscrow(common.HexToAddress("0x01"), &bond.Escrow{Amount: big.NewInt(100), ReplayedTill: 10}))
+
+	escrow, err = s.GetEscrow(common.HexToAddress("0x01"))
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), escrow.Amount)
+	assert.Equal(t, uint64(10), escrow.ReplayedTill)
+
+	// Escrows of other accounts are not affected.
+	escrow, err = s.GetEscrow(common.HexToAddress("0x02"))
+	assert.Nil(t, err)
+	assert.Nil(t, escrow)
+}
@@ -0,0 +1,234 @@
+package db
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	txListValidator "github.com/taikoxyz/taiko-client/pkg/txlistvalidator"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+var (
+	proofJobKeyPrefix = "proofJob"
+
+	// ErrProofJobNotFound is returned when there is no proof job for the given block ID.
+	ErrProofJobNotFound = errors.New("proof job not found")
+)
+
+// ProofJobStatus represents the status of a proof job.
+type ProofJobStatus string
+
+// All proof job statuses.
+const (
+	ProofJobQueued     ProofJobStatus = "queued"
+	ProofJobGenerating ProofJobStatus = "generating"
+	ProofJobGenerated  ProofJobStatus = "generated"
+	ProofJobSubmitted  ProofJobStatus = "submitted"
+	ProofJobAccepted   ProofJobStatus = "accepted"
+	ProofJobFailed     ProofJobStatus = "failed"
+)
+
+// Finished returns true if the proof job won't be processed anymore.
+func (s ProofJobStatus) Finished() bool {
+	return s == ProofJobAccepted || s == ProofJobFailed
+}
+
+// ProofJob is a proof generation / submission job of a L2 block, which is persisted in the
+// db, so that the prover can resume it after a restart. The transactions list hint and diagnostics
+// tell why the block is an empty one, or which proposed transactions it skips.
+type ProofJob struct {
+	BlockID       uint64                               `json:"blockID"`
+	Tier          uint16                               `json:"tier"`
+	Status        ProofJobStatus                       `json:"status"`
+	Event         *bindings.TaikoL1ClientBlockProposed `json:"event,omitempty"`
+	Proof         *proofProducer.ProofWithHeader       `json:"proof,omitempty"`
+	Error         string                               `json:"error,omitempty"`
+	TxListHint    txListValidator.InvalidTxListReason  `json:"txListHint,omitempty"`
+	TxDiagnostics []*txListValidator.TxDiagnostic      `json:"txDiagnostics,omitempty"`
+	UpdatedAt     uint64                               `json:"updatedAt"`
+}
+
+// BuildProofJobKey will build a key for the proof job of the given block.
+func BuildProofJobKey(blockID uint64) []byte {
+	return bytes.Join(
+		[][]byte{
+			[]byte(proofJobKeyPrefix),
+			[]byte(strconv.FormatUint(blockID, 10)),
+		}, []byte(separator))
+}
+
+// ProofJobStore is a crash-safe proof job queue, backed by a key-value store.
+type ProofJobStore struct {
+	db    ethdb.KeyValueStore
+	mutex sync.Mutex
+}
+
+// NewProofJobStore creates a new ProofJobStore instance.
+func NewProofJobStore(db ethdb.KeyValueStore) *ProofJobStore {
+	return &ProofJobStore{db: db}
+}
+
+// Get returns the proof job of the given block.
+func (s *ProofJobStore) Get(blockID uint64) (*ProofJob, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.get(blockID)
+}
+
+// Put saves the given proof job, an existing job of the same block will be overwritten.
+func (s *ProofJobStore) Put(job *ProofJob) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.put(job)
+}
+
+// Update updates the proof job of the given block with the given function, if there is no such job,
+// a new one will be created.
+func (s *ProofJobStore) Update(blockID uint64, update func(job *ProofJob)) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	job, err := s.get(blockID)
+	if err != nil {
+		if !errors.Is(err, ErrProofJobNotFound) {
+			return err
+		}
+		job = &ProofJob{BlockID: blockID}
+	}
+
+	update(job)
+
+	return s.put(job)
+}
+
+// Delete removes the proof job of the given block.
+func (s *ProofJobStore) Delete(blockID uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.db.Delete(BuildProofJobKey(blockID))
+}
+
+// DeleteIfStatus removes the proof job of the given block, only if it is still in the given status.
+func (s *ProofJobStore) DeleteIfStatus(blockID uint64, status ProofJobStatus) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	job, err := s.get(blockID)
+	if err != nil {
+		if errors.Is(err, ErrProofJobNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if job.Status != status {
+		return nil
+	}
+
+	return s.db.Delete(BuildProofJobKey(blockID))
+}
+
+// Unfinished returns all proof jobs which are neither accepted nor failed, ordered by block ID.
+func (s *ProofJobStore) Unfinished() ([]*ProofJob, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	iter := s.db.NewIterator([]byte(proofJobKeyPrefix+separator), nil)
+	defer iter.Release()
+
+	var jobs []*ProofJob
+	for iter.Next() {
+		job := new(ProofJob)
+		if err := json.Unmarshal(iter.Value(), job); err != nil {
+			return nil, fmt.Errorf("failed to decode proof job (key %s): %w", iter.Key(), err)
+		}
+		if job.Status.Finished() {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].BlockID < jobs[j].BlockID })
+
+	return jobs, nil
+}
+
+// Latest returns the proof job with the highest block ID, whatever its status is.
+func (s *ProofJobStore) Latest() (*ProofJob, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	iter := s.db.NewIterator([]byte(proofJobKeyPrefix+separator), nil)
+	defer iter.Release()
+
+	var latest *ProofJob
+	for iter.Next() {
+		job := new(ProofJob)
+		if err := json.Unmarshal(iter.Value(), job); err != nil {
+			return nil, fmt.Errorf("failed to decode proof job (key %s): %w", iter.Key(), err)
+		}
+		if latest == nil || job.BlockID > latest.BlockID {
+			latest = job
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	if latest == nil {
+		return nil, ErrProofJobNotFound
+	}
+
+	return latest, nil
+}
+
+// get fetches and decodes the proof job of the given block, the caller should hold the mutex.
+func (s *ProofJobStore) get(blockID uint64) (*ProofJob, error) {
+	key := BuildProofJobKey(blockID)
+
+	has, err := s.db.Has(key)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, ErrProofJobNotFound
+	}
+
+	val, err := s.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	job := new(ProofJob)
+	if err := json.Unmarshal(val, job); err != nil {
+		return nil, fmt.Errorf("failed to decode proof job (blockID %d): %w", blockID, err)
+	}
+
+	return job, nil
+}
+
+// put encodes and saves the given proof job, the caller should hold the mutex.
+func (s *ProofJobStore) put(job *ProofJob) error {
+	job.UpdatedAt = uint64(time.Now().Unix())
+
+	val, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("failed to encode proof job (blockID %d): %w", job.BlockID, err)
+	}
+
+	return s.db.Put(BuildProofJobKey(job.BlockID), val)
+}
@@ -0,0 +1,158 @@
+package db
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+func Test_BuildProofJobKey(t *testing.T) {
+	assert.Equal(t, []byte("proofJob++300"), BuildProofJobKey(300))
+}
+
+func Test_ProofJobStore_GetNotFound(t *testing.T) {
+	s := NewProofJobStore(memorydb.New())
+
+	_, err := s.Get(1)
+	assert.ErrorIs(t, err, ErrProofJobNotFound)
+}
+
+func Test_ProofJobStore_PutAndGet(t *testing.T) {
+	s := NewProofJobStore(memorydb.New())
+
+	event := testBlockProposedEvent(1)
+	assert.Nil(t, s.Put(&ProofJob{BlockID: 1, Tier: 200, Status: ProofJobQueued, Event: event}))
+
+	job, err := s.Get(1)
+	assert.Nil(t, err)
+	assert.Equal(t, ProofJobQueued, job.Status)
+	assert.Equal(t, uint16(200), job.Tier)
+	assert.Equal(t, event.BlockId, job.Event.BlockId)
+	assert.Equal(t, event.Meta.L1Hash, job.Event.Meta.L1Hash)
+	assert.Equal(t, event.Raw.TxHash, job.Event.Raw.TxHash)
+	assert.NotZero(t, job.UpdatedAt)
+}
+
+func Test_ProofJobStore_Update(t *testing.T) {
+	s := NewProofJobStore(memorydb.New())
+
+	header := &types.Header{
+		ParentHash: common.HexToHash("0x01"),
+		Difficulty: common.Big0,
+		Number:     common.Big1,
+		Extra:      []byte{},
+	}
+	assert.Nil(t, s.Update(1, func(job *ProofJob) {
+		job.Status = ProofJobGenerated
+		job.Proof = &proofProducer.ProofWithHeader{
+			BlockID: common.Big1,
+			Meta:    &bindings.TaikoDataBlockMetadata{Id: 1},
+			Header:  header,
+			Proof:   []byte{0xff},
+			Opts:    &proofProducer.ProofRequestOptions{BlockHash: header.Hash()},
+			Tier:    200,
+		}
+	}))
+
+	job, err := s.Get(1)
+	assert.Nil(t, err)
+	assert.Equal(t, ProofJobGenerated, job.Status)
+	assert.Equal(t, header.Hash(), job.Proof.Header.Hash())
+	assert.Equal(t, []byte{0xff}, job.Proof.Proof)
+
+	assert.Nil(t, s.Update(1, func(job *ProofJob) { job.Status = ProofJobSubmitted }))
+	job, err = s.Get(1)
+	assert.Nil(t, err)
+	assert.Equal(t, ProofJobSubmitted, job.Status)
+	assert.NotNil(t, job.Proof)
+}
+
+func Test_ProofJobStore_Unfinished(t *testing.T) {
+	s := NewProofJobStore(memorydb.New())
+
+	for id, status := range map[uint64]ProofJobStatus{
+		12: ProofJobGenerating,
+		2:  ProofJobQueued,
+		3:  ProofJobAccepted,
+		4:  ProofJobFailed,
+		5:  ProofJobSubmitted,
+	} {
+		assert.Nil(t, s.Put(&ProofJob{BlockID: id, Status: status}))
+	}
+
+	jobs, err := s.Unfinished()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(jobs))
+	assert.Equal(t, uint64(2), jobs[0].BlockID)
+	assert.Equal(t, uint64(5), jobs[1].BlockID)
+	assert.Equal(t, uint64(12), jobs[2].BlockID)
+
+	assert.Nil(t, s.Delete(2))
+	jobs, err = s.Unfinished()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(jobs))
+}
+
+func Test_ProofJobStore_Latest(t *testing.T) {
+	s := NewProofJobStore(memorydb.New())
+
+	_, err := s.Latest()
+	assert.ErrorIs(t, err, ErrProofJobNotFound)
+
+	// The block IDs are not ordered by their keys.
+	for id, status := range map[uint64]ProofJobStatus{
+		9:  ProofJobGenerating,
+		12: ProofJobAccepted,
+		2:  ProofJobQueued,
+	} {
+		assert.Nil(t, s.Put(&ProofJob{BlockID: id, Status: status}))
+	}
+
+	job, err := s.Latest()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(12), job.BlockID)
+}
+
+func Test_ProofJobStore_DeleteIfStatus(t *testing.T) {
+	s := NewProofJobStore(memorydb.New())
+
+	assert.Nil(t, s.Put(&ProofJob{BlockID: 1, Status: ProofJobGenerating}))
+	assert.Nil(t, s.DeleteIfStatus(1, ProofJobQueued))
+	_, err := s.Get(1)
+	assert.Nil(t, err)
+
+	assert.Nil(t, s.DeleteIfStatus(1, ProofJobGenerating))
+	_, err = s.Get(1)
+	assert.ErrorIs(t, err, ErrProofJobNotFound)
+
+	assert.Nil(t, s.DeleteIfStatus(2, ProofJobQueued))
+}
+
+func testBlockProposedEvent(id int64) *bindings.TaikoL1ClientBlockProposed {
+	return &bindings.TaikoL1ClientBlockProposed{
+		BlockId:        big.NewInt(id),
+		AssignedProver: common.HexToAddress("0x01"),
+		LivenessBond:   big.NewInt(1),
+		Meta: bindings.TaikoDataBlockMetadata{
+			L1Hash:   common.HexToHash("0x02"),
+			Id:       uint64(id),
+			L1Height: 10,
+			MinTier:  200,
+		},
+		Raw: types.Log{
+			Address:     common.HexToAddress("0x03"),
+			Topics:      []common.Hash{common.HexToHash("0x04")},
+			Data:        []byte{},
+			BlockNumber: 11,
+			TxHash:      common.HexToHash("0x05"),
+			BlockHash:   common.HexToHash("0x06"),
+		},
+	}
+}
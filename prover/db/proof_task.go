@@ -0,0 +1,74 @@
+package db
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+var proofTaskKeyPrefix = "proofTask"
+
+// BuildProofTaskKey will build a key for the remote proof task of the given block in the given
+// prover service.
+func BuildProofTaskKey(endpoint string, blockID uint64) []byte {
+	return bytes.Join(
+		[][]byte{
+			[]byte(proofTaskKeyPrefix),
+			[]byte(endpoint),
+			[]byte(strconv.FormatUint(blockID, 10)),
+		}, []byte(separator))
+}
+
+// ProofTaskStore persists the proof generation tasks submitted to the remote prover services, it
+// implements the proofProducer.ProofTaskStore interface.
+type ProofTaskStore struct {
+	db ethdb.KeyValueStore
+}
+
+// NewProofTaskStore creates a new ProofTaskStore instance.
+func NewProofTaskStore(db ethdb.KeyValueStore) *ProofTaskStore {
+	return &ProofTaskStore{db: db}
+}
+
+// GetProofTask returns the proof task of the given block in the given prover service, nil is
+// returned if there is no such task.
+func (s *ProofTaskStore) GetProofTask(endpoint string, blockID uint64) (*proofProducer.ProofTask, error) {
+	key := BuildProofTaskKey(endpoint, blockID)
+
+	has, err := s.db.Has(key)
+	if err != nil || !has {
+		return nil, err
+	}
+
+	val, err := s.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	task := new(proofProducer.ProofTask)
+	if err := json.Unmarshal(val, task); err != nil {
+		return nil, fmt.Errorf("failed to decode proof task (blockID %d): %w", blockID, err)
+	}
+
+	return task, nil
+}
+
+// PutProofTask saves the proof task of the given block in the given prover service.
+func (s *ProofTaskStore) PutProofTask(endpoint string, blockID uint64, task *proofProducer.ProofTask) error {
+	val, err := json.Marshal(task)
+	if err != nil {
+		return fmt.Errorf("failed to encode proof task (blockID %d): %w", blockID, err)
+	}
+
+	return s.db.Put(BuildProofTaskKey(endpoint, blockID), val)
+}
+
+// DeleteProofTask removes the proof task of the given block in the given prover service.
+func (s *ProofTaskStore) DeleteProofTask(endpoint string, blockID uint64) error {
+	return s.db.Delete(BuildProofTaskKey(endpoint, blockID))
+}
@@ -0,0 +1,46 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/stretchr/testify/assert"
+
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+var testProofTaskEndpoint = "http://localhost:8080"
+
+func Test_BuildProofTaskKey(t *testing.T) {
+	assert.Equal(t, []byte("proofTask++http://localhost:8080++300"), BuildProofTaskKey(testProofTaskEndpoint, 300))
+}
+
+func Test_ProofTaskStore(t *testing.T) {
+	s := NewProofTaskStore(memorydb.New())
+
+	task, err := s.GetProofTask(testProofTaskEndpoint, 1)
+	assert.Nil(t, err)
+	assert.Nil(t, task)
+
+	assert.Nil(t, s.PutProofTask(
+		testProofTaskEndpoint,
+		1,
+		&proofProducer.ProofTask{ID: "task", BlockHash: common.HexToHash("0x01")},
+	))
+
+	task, err = s.GetProofTask(testProofTaskEndpoint, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, "task", task.ID)
+	assert.Equal(t, common.HexToHash("0x01"), task.BlockHash)
+
+	// Tasks of other prover services are not affected.
+	task, err = s.GetProofTask("http://localhost:8081", 1)
+	assert.Nil(t, err)
+	assert.Nil(t, task)
+
+	assert.Nil(t, s.DeleteProofTask(testProofTaskEndpoint, 1))
+	task, err = s.GetProofTask(testProofTaskEndpoint, 1)
+	assert.Nil(t, err)
+	assert.Nil(t, task)
+}
@@ -0,0 +1,166 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+var (
+	ErrSignedBlockNotFound = errors.New("signed block not found")
+)
+
+// SignedBlock is a signed block stored in the db, along with its block timestamp.
+type SignedBlock struct {
+	SignedBlockData
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// SignedBlockFilter contains the filters of a signed blocks query, all ranges are inclusive,
+// and a zero end means no upper limit.
+type SignedBlockFilter struct {
+	StartTimestamp uint64
+	EndTimestamp   uint64
+	StartBlockID   uint64
+	EndBlockID     uint64
+	Offset         uint64
+	Limit          uint64
+}
+
+// match checks whether the given signed block matches the filter ranges.
+func (f *SignedBlockFilter) match(blockTimestamp uint64, blockID uint64) bool {
+	return blockTimestamp >= f.StartTimestamp &&
+		(f.EndTimestamp == 0 || blockTimestamp <= f.EndTimestamp) &&
+		blockID >= f.StartBlockID &&
+		(f.EndBlockID == 0 || blockID <= f.EndBlockID)
+}
+
+// WriteSignedBlock writes a signed block into the db, along with its block ID index.
+func WriteSignedBlock(
+	db ethdb.KeyValueStore,
+	blockTimestamp uint64,
+	blockID *big.Int,
+	hash []byte,
+	signature []byte,
+) error {
+	key := BuildBlockKey(blockTimestamp, blockID.Uint64())
+	val, err := BuildBlockValue(hash, signature, blockID)
+	if err != nil {
+		return err
+	}
+
+	batch := db.NewBatch()
+	if err := batch.Put(key, val); err != nil {
+		return err
+	}
+	if err := batch.Put(BuildBlockIDKey(blockID.Uint64()), key); err != nil {
+		return err
+	}
+
+	return batch.Write()
+}
+
+// IndexSignedBlocks writes the block ID index of the signed blocks written before the index was
+// introduced, it should be called once when the db is opened.
+func IndexSignedBlocks(db ethdb.KeyValueStore) error {
+	iter := db.NewIterator([]byte(blockKeyPrefix+separator), nil)
+	defer iter.Release()
+
+	batch := db.NewBatch()
+	for iter.Next() {
+		_, blockID, err := ParseBlockKey(iter.Key())
+		if err != nil {
+			return err
+		}
+
+		indexed, err := db.Has(BuildBlockIDKey(blockID))
+		if err != nil {
+			return err
+		}
+		if indexed {
+			continue
+		}
+
+		if err := batch.Put(BuildBlockIDKey(blockID), common.CopyBytes(iter.Key())); err != nil {
+			return err
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("failed to iterate signed blocks: %w", err)
+	}
+
+	return batch.Write()
+}
+
+// GetSignedBlock fetches the signed block with the given block ID.
+func GetSignedBlock(db ethdb.KeyValueStore, blockID uint64) (*SignedBlock, error) {
+	key, err := db.Get(BuildBlockIDKey(blockID))
+	if err != nil {
+		return nil, ErrSignedBlockNotFound
+	}
+
+	return getSignedBlockByKey(db, key)
+}
+
+// getSignedBlockByKey fetches the signed block with the given block key.
+func getSignedBlockByKey(db ethdb.KeyValueStore, key []byte) (*SignedBlock, error) {
+	blockTimestamp, _, err := ParseBlockKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := db.Get(key)
+	if err != nil {
+		return nil, ErrSignedBlockNotFound
+	}
+
+	data, err := SignedBlockDataFromValue(val)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignedBlock{SignedBlockData: data, Timestamp: blockTimestamp}, nil
+}
+
+// GetSignedBlocks fetches the signed blocks which match the given filter, ordered by block ID.
+// The block ID index is iterated from the filter's start block ID, until enough blocks are found.
+func GetSignedBlocks(db ethdb.KeyValueStore, filter *SignedBlockFilter) ([]*SignedBlock, error) {
+	prefix := []byte(blockIDKeyPrefix + separator)
+	iter := db.NewIterator(prefix, blockIDKeySuffix(filter.StartBlockID))
+	defer iter.Release()
+
+	var (
+		blocks  = []*SignedBlock{}
+		skipped uint64
+	)
+	for (filter.Limit == 0 || uint64(len(blocks)) < filter.Limit) && iter.Next() {
+		blockTimestamp, blockID, err := ParseBlockKey(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		if filter.EndBlockID != 0 && blockID > filter.EndBlockID {
+			break
+		}
+		if !filter.match(blockTimestamp, blockID) {
+			continue
+		}
+		if skipped < filter.Offset {
+			skipped++
+			continue
+		}
+
+		block, err := getSignedBlockByKey(db, iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("failed to iterate signed blocks: %w", err)
+	}
+
+	return blocks, nil
+}
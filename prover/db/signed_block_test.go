@@ -0,0 +1,87 @@
+package db
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTestSignedBlocks(t *testing.T, db *memorydb.Database, count uint64) {
+	for i := uint64(1); i <= count; i++ {
+		assert.Nil(t, WriteSignedBlock(
+			db,
+			100+i/2,
+			new(big.Int).SetUint64(i),
+			common.BigToHash(new(big.Int).SetUint64(i)).Bytes(),
+			testSignature(i),
+		))
+	}
+}
+
+func testSignature(i uint64) []byte {
+	sig := make([]byte, 65)
+	sig[0] = byte(i)
+	return sig
+}
+
+func Test_GetSignedBlocks(t *testing.T) {
+	db := memorydb.New()
+	writeTestSignedBlocks(t, db, 12)
+
+	blocks, err := GetSignedBlocks(db, &SignedBlockFilter{})
+	assert.Nil(t, err)
+	assert.Len(t, blocks, 12)
+	for i, block := range blocks {
+		assert.Equal(t, uint64(i+1), block.BlockID.Uint64())
+		assert.Equal(t, 100+uint64(i+1)/2, block.Timestamp)
+		assert.Equal(t, common.BigToHash(block.BlockID), block.BlockHash)
+	}
+
+	blocks, err = GetSignedBlocks(db, &SignedBlockFilter{StartTimestamp: 102, EndTimestamp: 104})
+	assert.Nil(t, err)
+	assert.Len(t, blocks, 6)
+	assert.Equal(t, uint64(4), blocks[0].BlockID.Uint64())
+
+	blocks, err = GetSignedBlocks(db, &SignedBlockFilter{StartBlockID: 9, Offset: 1, Limit: 2})
+	assert.Nil(t, err)
+	assert.Len(t, blocks, 2)
+	assert.Equal(t, uint64(10), blocks[0].BlockID.Uint64())
+	assert.Equal(t, uint64(11), blocks[1].BlockID.Uint64())
+
+	blocks, err = GetSignedBlocks(db, &SignedBlockFilter{Offset: 12})
+	assert.Nil(t, err)
+	assert.Empty(t, blocks)
+}
+
+func Test_GetSignedBlock(t *testing.T) {
+	db := memorydb.New()
+	writeTestSignedBlocks(t, db, 3)
+
+	block, err := GetSignedBlock(db, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), block.BlockID.Uint64())
+	assert.Equal(t, uint64(101), block.Timestamp)
+	assert.Equal(t, common.Bytes2Hex(testSignature(2)), block.Signature)
+
+	_, err = GetSignedBlock(db, 4)
+	assert.ErrorIs(t, err, ErrSignedBlockNotFound)
+
+	// Legacy signed blocks without the block ID index.
+	assert.Nil(t, db.Put(
+		BuildBlockKey(200, 5),
+		bytes.Join([][]byte{common.Hash{}.Bytes(), testSignature(5), big.NewInt(5).Bytes()}, []byte(separator)),
+	))
+	_, err = GetSignedBlock(db, 5)
+	assert.ErrorIs(t, err, ErrSignedBlockNotFound)
+
+	assert.Nil(t, IndexSignedBlocks(db))
+	block, err = GetSignedBlock(db, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(200), block.Timestamp)
+	assert.Equal(t, uint64(5), block.BlockID.Uint64())
+	assert.Equal(t, common.Bytes2Hex(testSignature(5)), block.Signature)
+}
@@ -3,7 +3,6 @@ package guardianproversender
 import (
 	"bytes"
 	"context"
-	"crypto/ecdsa"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -12,11 +11,12 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
 
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
 	"github.com/taikoxyz/taiko-client/prover/db"
 )
 
@@ -44,7 +44,7 @@ type startupReq struct {
 
 // GuardianProverBlockSender is responsible for signing and sending known blocks to the health check server.
 type GuardianProverBlockSender struct {
-	privateKey                *ecdsa.PrivateKey
+	signer                    signer.Signer
 	healthCheckServerEndpoint *url.URL
 	db                        ethdb.KeyValueStore
 	rpc                       *rpc.Client
@@ -53,14 +53,14 @@ type GuardianProverBlockSender struct {
 
 // New creates a new GuardianProverBlockSender instance.
 func New(
-	privateKey *ecdsa.PrivateKey,
+	signer signer.Signer,
 	healthCheckServerEndpoint *url.URL,
 	db ethdb.KeyValueStore,
 	rpc *rpc.Client,
 	proverAddress common.Address,
 ) *GuardianProverBlockSender {
 	return &GuardianProverBlockSender{
-		privateKey:                privateKey,
+		signer:                    signer,
 		healthCheckServerEndpoint: healthCheckServerEndpoint,
 		db:                        db,
 		rpc:                       rpc,
@@ -105,17 +105,13 @@ func (s *GuardianProverBlockSender) SignAndSendBlock(ctx context.Context, blockI
 		return nil
 	}
 
-	if err := s.sendSignedBlockReq(ctx, signed, header.Hash(), blockID); err != nil {
+	// Save the signed block before sending it, so that the health check server can still
+	// fetch it through the prover server API if the request fails.
+	if err := db.WriteSignedBlock(s.db, header.Time, blockID, header.Hash().Bytes(), signed); err != nil {
 		return err
 	}
 
-	return s.db.Put(
-		db.BuildBlockKey(header.Time, header.Number.Uint64()),
-		db.BuildBlockValue(header.Hash().Bytes(),
-			signed,
-			blockID,
-		),
-	)
+	return s.sendSignedBlockReq(ctx, signed, header.Hash(), blockID)
 }
 
 func (s *GuardianProverBlockSender) SendStartup(ctx context.Context, revision string, version string) error {
@@ -124,12 +120,10 @@ func (s *GuardianProverBlockSender) SendStartup(ctx context.Context, revision st
 		return nil
 	}
 
-	sig, err := crypto.Sign(
-		crypto.Keccak256Hash(
-			s.proverAddress.Bytes(),
-			[]byte(revision),
-			[]byte(version)).Bytes(),
-		s.privateKey)
+	sig, err := s.signer.SignMessage(
+		ctx,
+		append(append(s.proverAddress.Bytes(), []byte(revision)...), []byte(version)...),
+	)
 	if err != nil {
 		return err
 	}
@@ -228,7 +222,13 @@ func (s *GuardianProverBlockSender) signBlock(ctx context.Context, blockID *big.
 		"eventBlockID", blockID.Uint64(),
 	)
 
-	signed, err := crypto.Sign(header.Hash().Bytes(), s.privateKey)
+	// The block hash is the keccak256 hash of the RLP encoded header, so we sign the encoded header here.
+	encodedHeader, err := rlp.EncodeToBytes(header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signed, err := s.signer.SignMessage(ctx, encodedHeader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -243,7 +243,7 @@ func (s *GuardianProverBlockSender) Close() error {
 
 // SendHeartbeat sends a heartbeat to the health check server.
 func (s *GuardianProverBlockSender) SendHeartbeat(ctx context.Context) error {
-	sig, err := crypto.Sign(crypto.Keccak256Hash([]byte("HEART_BEAT")).Bytes(), s.privateKey)
+	sig, err := s.signer.SignMessage(ctx, []byte("HEART_BEAT"))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,582 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/prover/pricing"
+)
+
+var (
+	// Default number of settled blocks kept in the ledger, used when it's not configured.
+	defaultMaxSettledBlocks = 1024
+	// unit is the number of the smallest units in one ether, or in one bond token.
+	unit          = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+	errNoFeeFound = errors.New("no assignment fee found")
+)
+
+// Work is a kind of optional work, which the prover can choose to skip if it's not profitable.
+type Work string
+
+// All kinds of optional work.
+const (
+	// Proving a block whose proving window has expired.
+	WorkUnassigned Work = "unassigned"
+	// Contesting an invalid transition, no proof is needed.
+	WorkContest Work = "contest"
+	// Proving a contested block with a higher tier proof.
+	WorkContestProof Work = "contestProof"
+)
+
+// Config contains all configurations of the prover ledger, all costs and rewards are in wei.
+type Config struct {
+	// AssignmentHook contract emitting the BlockAssigned events, which carry the assignment fees.
+	AssignmentHookAddress common.Address
+	// Estimated cost of generating a proof of each tier with the configured proof backends.
+	BackendCosts map[uint16]*big.Int
+	// Estimated gas used by a `TaikoL1.proveBlock` transaction of each tier, pricing.DefaultProveBlockGas
+	// is used for the missing tiers.
+	ProveBlockGas map[uint16]uint64
+	// Price of one bond token in wei used to value the lost bonds, a zero price means the lost bonds
+	// are not counted in the profit.
+	BondTokenPrice *big.Int
+	// Accepted ERC-20 fee tokens, and the number of the smallest units of each token worth one ether.
+	FeeTokenRates map[common.Address]*big.Int
+	// Whether to skip the optional work whose expected profit is negative, and the expected rewards
+	// of proving an unassigned block and of contesting a transition.
+	ProfitFilter     bool
+	UnassignedReward *big.Int
+	ContestReward    *big.Int
+	// Maximum number of settled blocks kept in the ledger, the totals always include all blocks.
+	MaxSettledBlocks int
+}
+
+// Entry is the accounting record of a block the prover was assigned, proved or contested. The fee is
+// denominated in the fee token, the bonds are in bond tokens, and all other amounts are in wei.
+type Entry struct {
+	BlockID      uint64         `json:"blockID"`
+	Assigned     bool           `json:"assigned"`
+	Tier         uint16         `json:"tier"`
+	FeeToken     common.Address `json:"feeToken"`
+	Fee          *big.Int       `json:"fee"`
+	FeeWei       *big.Int       `json:"feeWei"`
+	GasCost      *big.Int       `json:"gasCost"`
+	BackendCost  *big.Int       `json:"backendCost"`
+	BondLocked   *big.Int       `json:"bondLocked"`
+	BondReturned *big.Int       `json:"bondReturned"`
+	BondLost     *big.Int       `json:"bondLost"`
+	Profit       *big.Int       `json:"profit"`
+	Settled      bool           `json:"settled"`
+
+	livenessBond  *big.Int
+	validityBond  *big.Int
+	contestBond   *big.Int
+	provenHash    [32]byte
+	contestedHash [32]byte
+}
+
+// Totals is the accounting summary of all blocks recorded since the prover started.
+type Totals struct {
+	Blocks       uint64   `json:"blocks"`
+	Fees         *big.Int `json:"fees"`
+	GasCost      *big.Int `json:"gasCost"`
+	BackendCost  *big.Int `json:"backendCost"`
+	BondLocked   *big.Int `json:"bondLocked"`
+	BondReturned *big.Int `json:"bondReturned"`
+	BondLost     *big.Int `json:"bondLost"`
+	Profit       *big.Int `json:"profit"`
+}
+
+// Status is a snapshot of the prover ledger.
+type Status struct {
+	Totals *Totals  `json:"totals"`
+	Blocks []*Entry `json:"blocks"`
+}
+
+// Ledger records the fees, costs and bonds of each block handled by the prover, and decides whether
+// the optional work is worth taking. The records are kept in memory only, and the fees and the gas costs
+// are fetched from L1 in the background, so that the event hooks never block the caller. The hooks ignore
+// the verified blocks, which have been settled, even if their entries have been pruned.
+type Ledger struct {
+	cfg     *Config
+	address common.Address
+	pricer  *pricing.Pricer
+	hook    *bindings.AssignmentHookFilterer
+	receipt func(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	wg      sync.WaitGroup
+
+	mutex          sync.RWMutex
+	entries        map[uint64]*Entry
+	settled        []uint64
+	lastVerifiedID uint64
+	totals         *Totals
+}
+
+// New creates a new Ledger instance for the given prover address, the pricer is used to estimate the
+// proveBlock transaction costs. If the given RPC client is nil, the fees and the gas costs are not
+// recorded.
+func New(cli *rpc.Client, address common.Address, pricer *pricing.Pricer, cfg *Config) (*Ledger, error) {
+	if cfg.MaxSettledBlocks == 0 {
+		cfg.MaxSettledBlocks = defaultMaxSettledBlocks
+	}
+
+	hook, err := bindings.NewAssignmentHookFilterer(cfg.AssignmentHookAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AssignmentHook filterer: %w", err)
+	}
+
+	l := &Ledger{
+		cfg:     cfg,
+		address: address,
+		pricer:  pricer,
+		hook:    hook,
+		entries: make(map[uint64]*Entry),
+		totals: &Totals{
+			Fees:         new(big.Int),
+			GasCost:      new(big.Int),
+			BackendCost:  new(big.Int),
+			BondReturned: new(big.Int),
+			BondLost:     new(big.Int),
+		},
+	}
+	if cli != nil {
+		l.receipt = cli.L1.TransactionReceipt
+	}
+
+	return l, nil
+}
+
+// OnBlockProposed records the liveness bond and the assignment fee of the given block, if it's assigned
+// to the prover.
+func (l *Ledger) OnBlockProposed(ctx context.Context, e *bindings.TaikoL1ClientBlockProposed) {
+	if e.AssignedProver != l.address {
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if _, ok := l.entries[e.BlockId.Uint64()]; ok || e.BlockId.Uint64() <= l.lastVerifiedID {
+		return
+	}
+	entry := l.newEntry(e.BlockId.Uint64())
+	entry.Assigned = true
+	entry.Tier = e.Meta.MinTier
+	entry.livenessBond = e.LivenessBond
+
+	l.goFetch(func() { l.recordFee(ctx, e, entry) })
+}
+
+// OnTransitionProved records the proof costs and the validity bond of the given block if it's proven by
+// the prover, and settles the prover's bonds which are returned or lost by the new transition.
+func (l *Ledger) OnTransitionProved(ctx context.Context, e *bindings.TaikoL1ClientTransitionProved) {
+	l.mutex.Lock()
+	entry, ok := l.entries[e.BlockId.Uint64()]
+	if (!ok && e.Prover != l.address) || e.BlockId.Uint64() <= l.lastVerifiedID {
+		l.mutex.Unlock()
+		return
+	}
+	if !ok {
+		entry = l.newEntry(e.BlockId.Uint64())
+	}
+
+	// The contest bond is returned if the contested transition is overridden.
+	if entry.contestBond != nil {
+		l.settleBond(entry, &entry.contestBond, entry.contestedHash != e.Tran.BlockHash)
+	}
+
+	if e.Prover == l.address {
+		// The liveness bond is returned once the block is proven, the previous validity bond is
+		// returned unless the previous transition is overridden.
+		l.settleBond(entry, &entry.livenessBond, true)
+		if entry.validityBond != nil {
+			l.settleBond(entry, &entry.validityBond, entry.provenHash == e.Tran.BlockHash)
+		}
+		entry.validityBond = e.ValidityBond
+		entry.provenHash = e.Tran.BlockHash
+		entry.Tier = e.Tier
+		if cost, ok := l.cfg.BackendCosts[e.Tier]; ok {
+			entry.BackendCost.Add(entry.BackendCost, cost)
+			l.totals.BackendCost.Add(l.totals.BackendCost, cost)
+		}
+	} else {
+		// The block is proven by another prover after the prover's proving window expired, or the
+		// prover's transition is overridden by a higher tier proof with a different block hash.
+		l.settleBond(entry, &entry.livenessBond, false)
+		if entry.validityBond != nil && entry.provenHash != e.Tran.BlockHash {
+			l.settleBond(entry, &entry.validityBond, false)
+		}
+	}
+	if e.Prover == l.address {
+		l.goFetch(func() { l.recordGasCost(ctx, e.BlockId.Uint64(), e.Raw.TxHash) })
+	}
+	l.updateMetrics()
+	l.mutex.Unlock()
+}
+
+// OnTransitionContested records the contest bond and the gas cost of the given block, if the transition
+// is contested by the prover.
+func (l *Ledger) OnTransitionContested(ctx context.Context, e *bindings.TaikoL1ClientTransitionContested) {
+	if e.Contester != l.address {
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if e.BlockId.Uint64() <= l.lastVerifiedID {
+		return
+	}
+	entry, ok := l.entries[e.BlockId.Uint64()]
+	if !ok {
+		entry = l.newEntry(e.BlockId.Uint64())
+	}
+	entry.contestBond = e.ContestBond
+	entry.contestedHash = e.Tran.BlockHash
+	l.updateMetrics()
+
+	l.goFetch(func() { l.recordGasCost(ctx, e.BlockId.Uint64(), e.Raw.TxHash) })
+}
+
+// OnBlockVerified settles all remaining bonds of the given block, they are returned if the verified
+// transition is the prover's one, otherwise they are lost.
+func (l *Ledger) OnBlockVerified(e *bindings.TaikoL1ClientBlockVerified) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if e.BlockId.Uint64() > l.lastVerifiedID {
+		l.lastVerifiedID = e.BlockId.Uint64()
+	}
+
+	entry, ok := l.entries[e.BlockId.Uint64()]
+	if !ok || entry.Settled {
+		return
+	}
+
+	l.settleBond(entry, &entry.livenessBond, e.Prover == l.address)
+	l.settleBond(entry, &entry.validityBond, e.Prover == l.address)
+	l.settleBond(entry, &entry.contestBond, entry.contestedHash != e.BlockHash)
+	entry.Settled = true
+
+	log.Info(
+		"Block settled",
+		"blockID", entry.BlockID,
+		"feeWei", entry.FeeWei,
+		"gasCost", entry.GasCost,
+		"backendCost", entry.BackendCost,
+		"bondLost", entry.BondLost,
+		"profit", l.profit(entry),
+	)
+
+	// Only keep the latest settled blocks.
+	l.settled = append(l.settled, entry.BlockID)
+	for len(l.settled) > l.cfg.MaxSettledBlocks {
+		delete(l.entries, l.settled[0])
+		l.settled = l.settled[1:]
+	}
+	l.updateMetrics()
+}
+
+// ExpectedProfit returns the expected profit in wei of the given work of the given tier, which is the
+// configured reward minus the estimated proveBlock transaction cost and the proof backend cost.
+func (l *Ledger) ExpectedProfit(work Work, tier uint16) *big.Int {
+	profit := new(big.Int)
+	switch work {
+	case WorkUnassigned:
+		if l.cfg.UnassignedReward != nil {
+			profit.Set(l.cfg.UnassignedReward)
+		}
+	case WorkContest, WorkContestProof:
+		if l.cfg.ContestReward != nil {
+			profit.Set(l.cfg.ContestReward)
+		}
+	}
+
+	gas, ok := l.cfg.ProveBlockGas[tier]
+	if !ok {
+		gas = pricing.DefaultProveBlockGas[tier]
+	}
+	profit.Sub(profit, new(big.Int).Mul(l.pricer.GasPrice(), new(big.Int).SetUint64(gas)))
+
+	// No proof is needed to contest a transition.
+	if cost, ok := l.cfg.BackendCosts[tier]; ok && work != WorkContest {
+		profit.Sub(profit, cost)
+	}
+
+	return profit
+}
+
+// ShouldTake checks whether the given work of the given block is worth taking, it's always true if the
+// profit filter is disabled.
+func (l *Ledger) ShouldTake(work Work, blockID *big.Int, tier uint16) bool {
+	if !l.cfg.ProfitFilter {
+		return true
+	}
+
+	profit := l.ExpectedProfit(work, tier)
+	if profit.Sign() >= 0 {
+		return true
+	}
+
+	log.Info("Skip unprofitable work", "blockID", blockID, "work", work, "tier", tier, "expectedProfit", profit)
+	metrics.ProverLedgerSkippedCounter.Inc(1)
+
+	return false
+}
+
+// Status returns a snapshot of the prover ledger, the blocks are sorted by ID.
+func (l *Ledger) Status() *Status {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	status := &Status{
+		Totals: l.snapshotTotals(),
+		Blocks: make([]*Entry, 0, len(l.entries)),
+	}
+	for _, entry := range l.entries {
+		status.Blocks = append(status.Blocks, l.snapshotEntry(entry))
+	}
+	sort.Slice(status.Blocks, func(i, j int) bool { return status.Blocks[i].BlockID < status.Blocks[j].BlockID })
+
+	return status
+}
+
+// newEntry creates and saves a new entry of the given block, the caller must hold the mutex.
+func (l *Ledger) newEntry(blockID uint64) *Entry {
+	entry := &Entry{
+		BlockID:      blockID,
+		Fee:          new(big.Int),
+		FeeWei:       new(big.Int),
+		GasCost:      new(big.Int),
+		BackendCost:  new(big.Int),
+		BondReturned: new(big.Int),
+		BondLost:     new(big.Int),
+	}
+	l.entries[blockID] = entry
+	l.totals.Blocks++
+
+	return entry
+}
+
+// settleBond settles the given bond of the entry as returned or lost, the caller must hold the mutex.
+func (l *Ledger) settleBond(entry *Entry, bond **big.Int, returned bool) {
+	if *bond == nil {
+		return
+	}
+
+	if returned {
+		entry.BondReturned.Add(entry.BondReturned, *bond)
+		l.totals.BondReturned.Add(l.totals.BondReturned, *bond)
+	} else {
+		log.Warn("Prover bond lost", "blockID", entry.BlockID, "amount", *bond)
+		entry.BondLost.Add(entry.BondLost, *bond)
+		l.totals.BondLost.Add(l.totals.BondLost, *bond)
+	}
+	*bond = nil
+}
+
+// fetchFee fetches the assignment fee of the given block from the BlockAssigned event emitted in the
+// same transaction, the fee of the block's minimum tier is charged.
+func (l *Ledger) fetchFee(
+	ctx context.Context,
+	e *bindings.TaikoL1ClientBlockProposed,
+) (common.Address, *big.Int, error) {
+	if l.receipt == nil {
+		return common.Address{}, nil, errNoFeeFound
+	}
+
+	receipt, err := l.receipt(ctx, e.Raw.TxHash)
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("failed to fetch BlockProposed transaction receipt: %w", err)
+	}
+
+	for _, rawLog := range receipt.Logs {
+		if rawLog.Address != l.cfg.AssignmentHookAddress {
+			continue
+		}
+
+		assigned, err := l.hook.ParseBlockAssigned(*rawLog)
+		if err != nil || assigned.Meta.Id != e.BlockId.Uint64() {
+			continue
+		}
+
+		for _, tierFee := range assigned.Assignment.TierFees {
+			if tierFee.Tier == e.Meta.MinTier {
+				return assigned.Assignment.FeeToken, tierFee.Fee, nil
+			}
+		}
+	}
+
+	return common.Address{}, nil, errNoFeeFound
+}
+
+// goFetch runs the given function, which fetches data from L1, in the background.
+func (l *Ledger) goFetch(fetch func()) {
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		fetch()
+	}()
+}
+
+// recordFee fetches and records the assignment fee of the given block.
+func (l *Ledger) recordFee(ctx context.Context, e *bindings.TaikoL1ClientBlockProposed, entry *Entry) {
+	feeToken, fee, err := l.fetchFee(ctx, e)
+	if err != nil {
+		log.Warn("Failed to fetch the assignment fee", "blockID", e.BlockId, "error", err)
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	entry.FeeToken = feeToken
+	entry.Fee = fee
+	entry.FeeWei = l.toWei(feeToken, fee)
+	l.totals.Fees.Add(l.totals.Fees, entry.FeeWei)
+	l.updateMetrics()
+}
+
+// recordGasCost records the gas cost of the given transaction sent by the prover for the given block.
+func (l *Ledger) recordGasCost(ctx context.Context, blockID uint64, txHash common.Hash) {
+	if l.receipt == nil {
+		return
+	}
+
+	receipt, err := l.receipt(ctx, txHash)
+	if err != nil {
+		log.Warn("Failed to fetch transaction receipt for gas cost", "blockID", blockID, "txHash", txHash, "error", err)
+		return
+	}
+	if receipt.EffectiveGasPrice == nil {
+		return
+	}
+
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), receipt.EffectiveGasPrice)
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if entry, ok := l.entries[blockID]; ok {
+		entry.GasCost.Add(entry.GasCost, cost)
+	}
+	l.totals.GasCost.Add(l.totals.GasCost, cost)
+	l.updateMetrics()
+}
+
+// toWei converts the given fee to wei, the zero address means ETH. The fees of unknown tokens are
+// counted as zero.
+func (l *Ledger) toWei(feeToken common.Address, fee *big.Int) *big.Int {
+	if feeToken == (common.Address{}) {
+		return new(big.Int).Set(fee)
+	}
+
+	rate, ok := l.cfg.FeeTokenRates[feeToken]
+	if !ok || rate.Sign() == 0 {
+		log.Warn("Unknown fee token, the fee is not counted", "feeToken", feeToken, "fee", fee)
+		return new(big.Int)
+	}
+
+	return new(big.Int).Div(new(big.Int).Mul(fee, unit), rate)
+}
+
+// bondValue returns the value in wei of the given bond amount.
+func (l *Ledger) bondValue(amount *big.Int) *big.Int {
+	if l.cfg.BondTokenPrice == nil {
+		return new(big.Int)
+	}
+
+	return new(big.Int).Div(new(big.Int).Mul(amount, l.cfg.BondTokenPrice), unit)
+}
+
+// profit returns the realized profit in wei of the given fees, costs and lost bonds.
+func (l *Ledger) profit(entry *Entry) *big.Int {
+	profit := new(big.Int).Sub(entry.FeeWei, entry.GasCost)
+	profit.Sub(profit, entry.BackendCost)
+	return profit.Sub(profit, l.bondValue(entry.BondLost))
+}
+
+// snapshotEntry returns a copy of the given entry, which doesn't share any amount with it, since the amounts
+// are updated in place, the caller must hold the mutex.
+func (l *Ledger) snapshotEntry(entry *Entry) *Entry {
+	return &Entry{
+		BlockID:      entry.BlockID,
+		Assigned:     entry.Assigned,
+		Tier:         entry.Tier,
+		FeeToken:     entry.FeeToken,
+		Fee:          new(big.Int).Set(entry.Fee),
+		FeeWei:       new(big.Int).Set(entry.FeeWei),
+		GasCost:      new(big.Int).Set(entry.GasCost),
+		BackendCost:  new(big.Int).Set(entry.BackendCost),
+		BondLocked:   lockedBond(entry),
+		BondReturned: new(big.Int).Set(entry.BondReturned),
+		BondLost:     new(big.Int).Set(entry.BondLost),
+		Profit:       l.profit(entry),
+		Settled:      entry.Settled,
+	}
+}
+
+// snapshotTotals returns a copy of the totals, the caller must hold the mutex.
+func (l *Ledger) snapshotTotals() *Totals {
+	totals := &Totals{
+		Blocks:       l.totals.Blocks,
+		Fees:         new(big.Int).Set(l.totals.Fees),
+		GasCost:      new(big.Int).Set(l.totals.GasCost),
+		BackendCost:  new(big.Int).Set(l.totals.BackendCost),
+		BondLocked:   new(big.Int),
+		BondReturned: new(big.Int).Set(l.totals.BondReturned),
+		BondLost:     new(big.Int).Set(l.totals.BondLost),
+	}
+	for _, entry := range l.entries {
+		totals.BondLocked.Add(totals.BondLocked, lockedBond(entry))
+	}
+	totals.Profit = l.profit(&Entry{
+		FeeWei:      totals.Fees,
+		GasCost:     totals.GasCost,
+		BackendCost: totals.BackendCost,
+		BondLost:    totals.BondLost,
+	})
+
+	return totals
+}
+
+// updateMetrics updates the ledger metrics, the caller must hold the mutex.
+func (l *Ledger) updateMetrics() {
+	totals := l.snapshotTotals()
+
+	metrics.ProverLedgerFeesGauge.Update(toUnits(totals.Fees))
+	metrics.ProverLedgerGasCostGauge.Update(toUnits(totals.GasCost))
+	metrics.ProverLedgerBackendCostGauge.Update(toUnits(totals.BackendCost))
+	metrics.ProverLedgerBondLockedGauge.Update(toUnits(totals.BondLocked))
+	metrics.ProverLedgerBondLostGauge.Update(toUnits(totals.BondLost))
+	metrics.ProverLedgerProfitGauge.Update(toUnits(totals.Profit))
+}
+
+// lockedBond returns the total bond currently locked for the given entry.
+func lockedBond(entry *Entry) *big.Int {
+	locked := new(big.Int)
+	for _, bond := range []*big.Int{entry.livenessBond, entry.validityBond, entry.contestBond} {
+		if bond != nil {
+			locked.Add(locked, bond)
+		}
+	}
+
+	return locked
+}
+
+// toUnits converts the given amount to ether or bond token units, for the metrics.
+func toUnits(amount *big.Int) float64 {
+	units, _ := new(big.Float).Quo(new(big.Float).SetInt(amount), new(big.Float).SetInt(unit)).Float64()
+	return units
+}
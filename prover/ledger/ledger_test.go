@@ -0,0 +1,371 @@
+package ledger
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/prover/pricing"
+)
+
+var (
+	testProver         = common.HexToAddress("0x01")
+	testOther          = common.HexToAddress("0x02")
+	testAssignmentHook = common.HexToAddress("0x03")
+	testFeeToken       = common.HexToAddress("0x04")
+	testBlockHash      = common.HexToHash("0x05")
+	testOtherBlockHash = common.HexToHash("0x06")
+	testProposeTx      = common.HexToHash("0x07")
+	testProveTx        = common.HexToHash("0x08")
+)
+
+func newTestLedger(t *testing.T, cfg *Config) *Ledger {
+	cfg.AssignmentHookAddress = testAssignmentHook
+
+	l, err := New(nil, testProver, pricing.New(&pricing.Config{}, nil, nil), cfg)
+	require.Nil(t, err)
+
+	return l
+}
+
+// setTestReceipts makes the ledger fetch the given receipts, instead of the L1 node.
+func setTestReceipts(l *Ledger, receipts map[common.Hash]*types.Receipt) {
+	l.receipt = func(_ context.Context, txHash common.Hash) (*types.Receipt, error) {
+		receipt, ok := receipts[txHash]
+		if !ok {
+			return nil, ethereum.NotFound
+		}
+		return receipt, nil
+	}
+}
+
+// newTestBlockAssignedLog builds a BlockAssigned event log of the given block.
+func newTestBlockAssignedLog(
+	t *testing.T,
+	meta *bindings.TaikoDataBlockMetadata,
+	feeToken common.Address,
+	fee *big.Int,
+) *types.Log {
+	hookABI, err := bindings.AssignmentHookMetaData.GetAbi()
+	require.Nil(t, err)
+
+	event := hookABI.Events["BlockAssigned"]
+	data, err := event.Inputs.NonIndexed().Pack(*meta, bindings.AssignmentHookProverAssignment{
+		FeeToken: feeToken,
+		TierFees: []bindings.TaikoDataTierFee{
+			{Tier: encoding.TierOptimisticID, Fee: big.NewInt(1)},
+			{Tier: meta.MinTier, Fee: fee},
+		},
+	})
+	require.Nil(t, err)
+
+	return &types.Log{
+		Address: testAssignmentHook,
+		Topics:  []common.Hash{event.ID, common.BytesToHash(testProver.Bytes())},
+		Data:    data,
+	}
+}
+
+func newTestBlockProposed(t *testing.T, blockID uint64, feeToken common.Address, fee *big.Int) (
+	*bindings.TaikoL1ClientBlockProposed,
+	*types.Receipt,
+) {
+	meta := bindings.TaikoDataBlockMetadata{
+		Id:               blockID,
+		MinTier:          encoding.TierSgxID,
+		TxListByteOffset: common.Big0,
+		TxListByteSize:   common.Big0,
+	}
+
+	return &bindings.TaikoL1ClientBlockProposed{
+		BlockId:        new(big.Int).SetUint64(blockID),
+		AssignedProver: testProver,
+		LivenessBond:   big.NewInt(100),
+		Meta:           meta,
+		Raw:            types.Log{TxHash: testProposeTx},
+	}, &types.Receipt{Logs: []*types.Log{
+		// Logs of other contracts are ignored.
+		{Address: testOther},
+		newTestBlockAssignedLog(t, &meta, feeToken, fee),
+	}}
+}
+
+func TestLedgerAccounting(t *testing.T) {
+	l := newTestLedger(t, &Config{
+		BackendCosts:  map[uint16]*big.Int{encoding.TierSgxID: big.NewInt(50)},
+		FeeTokenRates: map[common.Address]*big.Int{testFeeToken: big.NewInt(2e18)},
+	})
+
+	proposed, proposeReceipt := newTestBlockProposed(t, 1, testFeeToken, big.NewInt(2000))
+	setTestReceipts(l, map[common.Hash]*types.Receipt{
+		testProposeTx: proposeReceipt,
+		testProveTx:   {GasUsed: 100, EffectiveGasPrice: big.NewInt(2)},
+	})
+
+	l.OnBlockProposed(context.Background(), proposed)
+	// Blocks assigned to other provers are ignored.
+	l.OnBlockProposed(context.Background(), &bindings.TaikoL1ClientBlockProposed{
+		BlockId:        common.Big2,
+		AssignedProver: testOther,
+	})
+	l.wg.Wait()
+
+	status := l.Status()
+	require.Len(t, status.Blocks, 1)
+	require.True(t, status.Blocks[0].Assigned)
+	require.Equal(t, testFeeToken, status.Blocks[0].FeeToken)
+	require.Equal(t, big.NewInt(2000), status.Blocks[0].Fee)
+	require.Equal(t, big.NewInt(1000), status.Blocks[0].FeeWei)
+	require.Equal(t, big.NewInt(100), status.Totals.BondLocked)
+
+	l.OnTransitionProved(context.Background(), &bindings.TaikoL1ClientTransitionProved{
+		BlockId:      common.Big1,
+		Tran:         bindings.TaikoDataTransition{BlockHash: testBlockHash},
+		Prover:       testProver,
+		ValidityBond: big.NewInt(200),
+		Tier:         encoding.TierSgxID,
+		Raw:          types.Log{TxHash: testProveTx},
+	})
+	l.wg.Wait()
+
+	status = l.Status()
+	require.Equal(t, big.NewInt(200), status.Blocks[0].GasCost)
+	require.Equal(t, big.NewInt(50), status.Blocks[0].BackendCost)
+	require.Equal(t, big.NewInt(200), status.Blocks[0].BondLocked)
+	require.Equal(t, big.NewInt(100), status.Blocks[0].BondReturned)
+
+	l.OnBlockVerified(&bindings.TaikoL1ClientBlockVerified{BlockId: common.Big1, Prover: testProver})
+
+	status = l.Status()
+	require.True(t, status.Blocks[0].Settled)
+	require.Equal(t, big.NewInt(750), status.Blocks[0].Profit)
+	require.Equal(t, uint64(1), status.Totals.Blocks)
+	require.Equal(t, big.NewInt(1000), status.Totals.Fees)
+	require.Equal(t, big.NewInt(200), status.Totals.GasCost)
+	require.Equal(t, big.NewInt(50), status.Totals.BackendCost)
+	require.Zero(t, status.Totals.BondLocked.Sign())
+	require.Equal(t, big.NewInt(300), status.Totals.BondReturned)
+	require.Zero(t, status.Totals.BondLost.Sign())
+	require.Equal(t, big.NewInt(750), status.Totals.Profit)
+}
+
+func TestLedgerBondLost(t *testing.T) {
+	l := newTestLedger(t, &Config{BondTokenPrice: big.NewInt(1e17)})
+
+	proposed, proposeReceipt := newTestBlockProposed(t, 1, common.Address{}, big.NewInt(1000))
+	setTestReceipts(l, map[common.Hash]*types.Receipt{testProposeTx: proposeReceipt})
+	l.OnBlockProposed(context.Background(), proposed)
+	l.wg.Wait()
+
+	// The block is proven by another prover after the proving window expired.
+	l.OnTransitionProved(context.Background(), &bindings.TaikoL1ClientTransitionProved{
+		BlockId: common.Big1,
+		Tran:    bindings.TaikoDataTransition{BlockHash: testBlockHash},
+		Prover:  testOther,
+		Tier:    encoding.TierSgxID,
+	})
+
+	status := l.Status()
+	require.Equal(t, big.NewInt(1000), status.Blocks[0].FeeWei)
+	require.Equal(t, big.NewInt(100), status.Blocks[0].BondLost)
+	require.Equal(t, big.NewInt(990), status.Blocks[0].Profit)
+
+	// The prover proves a block, whose transition is then overridden by another prover.
+	l.OnTransitionProved(context.Background(), &bindings.TaikoL1ClientTransitionProved{
+		BlockId:      common.Big2,
+		Tran:         bindings.TaikoDataTransition{BlockHash: testBlockHash},
+		Prover:       testProver,
+		ValidityBond: big.NewInt(200),
+		Tier:         encoding.TierOptimisticID,
+	})
+	l.OnTransitionProved(context.Background(), &bindings.TaikoL1ClientTransitionProved{
+		BlockId: common.Big2,
+		Tran:    bindings.TaikoDataTransition{BlockHash: testOtherBlockHash},
+		Prover:  testOther,
+		Tier:    encoding.TierSgxID,
+	})
+	l.OnBlockVerified(&bindings.TaikoL1ClientBlockVerified{BlockId: common.Big2, Prover: testOther})
+
+	status = l.Status()
+	require.Len(t, status.Blocks, 2)
+	require.False(t, status.Blocks[1].Assigned)
+	require.Equal(t, big.NewInt(200), status.Blocks[1].BondLost)
+	require.Equal(t, big.NewInt(300), status.Totals.BondLost)
+	require.Equal(t, big.NewInt(970), status.Totals.Profit)
+}
+
+func TestLedgerContest(t *testing.T) {
+	l := newTestLedger(t, &Config{})
+	setTestReceipts(l, map[common.Hash]*types.Receipt{
+		testProveTx: {GasUsed: 10, EffectiveGasPrice: big.NewInt(1)},
+	})
+
+	for i, overridden := range []bool{true, false} {
+		blockID := big.NewInt(int64(i + 1))
+
+		l.OnTransitionContested(context.Background(), &bindings.TaikoL1ClientTransitionContested{
+			BlockId:     blockID,
+			Tran:        bindings.TaikoDataTransition{BlockHash: testBlockHash},
+			Contester:   testProver,
+			ContestBond: big.NewInt(500),
+			Raw:         types.Log{TxHash: testProveTx},
+		})
+		// Contests of other contesters are ignored.
+		l.OnTransitionContested(context.Background(), &bindings.TaikoL1ClientTransitionContested{
+			BlockId:   big.NewInt(100),
+			Contester: testOther,
+		})
+
+		blockHash := testBlockHash
+		if overridden {
+			blockHash = testOtherBlockHash
+		}
+		l.OnTransitionProved(context.Background(), &bindings.TaikoL1ClientTransitionProved{
+			BlockId: blockID,
+			Tran:    bindings.TaikoDataTransition{BlockHash: blockHash},
+			Prover:  testOther,
+			Tier:    encoding.TierSgxAndPseZkevmID,
+		})
+	}
+	l.wg.Wait()
+
+	status := l.Status()
+	require.Len(t, status.Blocks, 2)
+	require.Equal(t, big.NewInt(500), status.Blocks[0].BondReturned)
+	require.Equal(t, big.NewInt(500), status.Blocks[1].BondLost)
+	require.Equal(t, big.NewInt(20), status.Totals.GasCost)
+	require.Equal(t, big.NewInt(-20), status.Totals.Profit)
+}
+
+func TestLedgerMaxSettledBlocks(t *testing.T) {
+	l := newTestLedger(t, &Config{MaxSettledBlocks: 1})
+
+	for i := int64(1); i <= 3; i++ {
+		l.OnBlockProposed(context.Background(), &bindings.TaikoL1ClientBlockProposed{
+			BlockId:        big.NewInt(i),
+			AssignedProver: testProver,
+			LivenessBond:   big.NewInt(10),
+		})
+	}
+	for i := int64(1); i <= 2; i++ {
+		l.OnBlockVerified(&bindings.TaikoL1ClientBlockVerified{BlockId: big.NewInt(i), Prover: testProver})
+	}
+
+	status := l.Status()
+	require.Len(t, status.Blocks, 2)
+	require.Equal(t, uint64(2), status.Blocks[0].BlockID)
+	require.Equal(t, uint64(3), status.Blocks[1].BlockID)
+	require.Equal(t, uint64(3), status.Totals.Blocks)
+	require.Equal(t, big.NewInt(20), status.Totals.BondReturned)
+	require.Equal(t, big.NewInt(10), status.Totals.BondLocked)
+}
+
+func TestLedgerVerifiedBlocks(t *testing.T) {
+	l := newTestLedger(t, &Config{MaxSettledBlocks: 1})
+
+	proposed, proposeReceipt := newTestBlockProposed(t, 1, common.Address{}, big.NewInt(1000))
+	setTestReceipts(l, map[common.Hash]*types.Receipt{testProposeTx: proposeReceipt})
+	l.OnBlockProposed(context.Background(), proposed)
+	l.OnBlockProposed(context.Background(), &bindings.TaikoL1ClientBlockProposed{
+		BlockId:        common.Big2,
+		AssignedProver: testProver,
+		LivenessBond:   big.NewInt(10),
+	})
+	l.wg.Wait()
+	l.OnBlockVerified(&bindings.TaikoL1ClientBlockVerified{BlockId: common.Big1, Prover: testProver})
+	l.OnBlockVerified(&bindings.TaikoL1ClientBlockVerified{BlockId: common.Big2, Prover: testProver})
+	require.Len(t, l.Status().Blocks, 1)
+
+	// The verified blocks are not recorded again, even if they are proposed again in a rescan after
+	// their entries have been pruned.
+	l.OnBlockProposed(context.Background(), proposed)
+	l.OnTransitionProved(context.Background(), &bindings.TaikoL1ClientTransitionProved{
+		BlockId: common.Big2,
+		Prover:  testProver,
+		Tier:    encoding.TierSgxID,
+	})
+	l.OnTransitionContested(context.Background(), &bindings.TaikoL1ClientTransitionContested{
+		BlockId:     common.Big2,
+		Contester:   testProver,
+		ContestBond: big.NewInt(500),
+	})
+	l.wg.Wait()
+
+	status := l.Status()
+	require.Len(t, status.Blocks, 1)
+	require.Equal(t, uint64(2), status.Blocks[0].BlockID)
+	require.Equal(t, uint64(2), status.Totals.Blocks)
+	require.Equal(t, big.NewInt(1000), status.Totals.Fees)
+	require.Zero(t, status.Totals.BondLocked.Sign())
+}
+
+// TestLedgerStatusWhileFetching checks, when run with `-race`, that the returned status doesn't share
+// any amount with the entries updated by the background fetches.
+func TestLedgerStatusWhileFetching(t *testing.T) {
+	l := newTestLedger(t, &Config{})
+	setTestReceipts(l, map[common.Hash]*types.Receipt{
+		testProveTx: {GasUsed: 100, EffectiveGasPrice: big.NewInt(2)},
+	})
+
+	for i := int64(1); i <= 10; i++ {
+		l.OnBlockProposed(context.Background(), &bindings.TaikoL1ClientBlockProposed{
+			BlockId:        big.NewInt(i),
+			AssignedProver: testProver,
+			LivenessBond:   big.NewInt(10),
+		})
+	}
+	l.wg.Wait()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			_, err := json.Marshal(l.Status())
+			require.Nil(t, err)
+		}
+	}()
+
+	for i := 0; i < 10; i++ {
+		for blockID := int64(1); blockID <= 10; blockID++ {
+			l.OnTransitionProved(context.Background(), &bindings.TaikoL1ClientTransitionProved{
+				BlockId: big.NewInt(blockID),
+				Prover:  testProver,
+				Tier:    encoding.TierSgxID,
+				Raw:     types.Log{TxHash: testProveTx},
+			})
+		}
+	}
+	l.wg.Wait()
+	<-done
+
+	require.Equal(t, big.NewInt(100*200), l.Status().Totals.GasCost)
+}
+
+func TestLedgerShouldTake(t *testing.T) {
+	l := newTestLedger(t, &Config{
+		BackendCosts:  map[uint16]*big.Int{encoding.TierSgxID: big.NewInt(100)},
+		ContestReward: big.NewInt(50),
+	})
+
+	// The profit filter is disabled.
+	require.True(t, l.ShouldTake(WorkUnassigned, common.Big1, encoding.TierSgxID))
+
+	l.cfg.ProfitFilter = true
+	require.Equal(t, big.NewInt(-100), l.ExpectedProfit(WorkUnassigned, encoding.TierSgxID))
+	require.False(t, l.ShouldTake(WorkUnassigned, common.Big1, encoding.TierSgxID))
+	require.True(t, l.ShouldTake(WorkUnassigned, common.Big1, encoding.TierOptimisticID))
+
+	// No proof is needed to contest a transition.
+	require.Equal(t, big.NewInt(50), l.ExpectedProfit(WorkContest, encoding.TierSgxID))
+	require.True(t, l.ShouldTake(WorkContest, common.Big1, encoding.TierSgxID))
+	require.Equal(t, big.NewInt(-50), l.ExpectedProfit(WorkContestProof, encoding.TierSgxID))
+	require.False(t, l.ShouldTake(WorkContestProof, common.Big1, encoding.TierSgxID))
+}
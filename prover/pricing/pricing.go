@@ -0,0 +1,221 @@
+package pricing
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+var (
+	// DefaultProveBlockGas is the default estimated gas used by a `TaikoL1.proveBlock` transaction of each tier.
+	DefaultProveBlockGas = map[uint16]uint64{
+		encoding.TierOptimisticID:     200_000,
+		encoding.TierSgxID:            250_000,
+		encoding.TierPseZkevmID:       600_000,
+		encoding.TierSgxAndPseZkevmID: 650_000,
+	}
+	// bondTokenUnit is the number of the smallest units in one bond token.
+	bondTokenUnit = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+	// etherUnit is the number of wei in one ether.
+	etherUnit = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+	year      = 365 * 24 * time.Hour
+)
+
+// Config contains the configurations of the tier fee pricing engine, all percentages are integers,
+// e.g. 20 means 20%.
+type Config struct {
+	// Static minimum accepted fee of each tier, the computed fees will never be lower than these.
+	MinTierFees map[uint16]*big.Int
+	// Estimated gas used by a `TaikoL1.proveBlock` transaction of each tier, DefaultProveBlockGas
+	// is used for the missing tiers.
+	ProveBlockGas map[uint16]uint64
+	// Liveness bond locked for each assigned block, and the time it can be locked for of each tier.
+	LivenessBond  *big.Int
+	BondLockTimes map[uint16]time.Duration
+	// Price of one bond token in wei and its annual opportunity cost percentage, a zero price
+	// means the bond opportunity cost is ignored.
+	BondTokenPrice *big.Int
+	BondAPR        uint64
+	// Margin percentage added to the estimated cost.
+	Margin uint64
+	// Extra percentage added when the prover is fully loaded, scaled linearly by the queue depth.
+	QueuePremium uint64
+	// Interval to refresh the L1 gas price.
+	UpdateInterval time.Duration
+	// Accepted ERC-20 fee tokens, and the number of the smallest units of each token worth one ether,
+	// ETH is always accepted.
+	FeeTokenRates map[common.Address]*big.Int
+}
+
+// Pricer computes the minimum acceptable fee of each tier, based on the current L1 gas price,
+// the liveness bond opportunity cost, the proving queue depth and a configured margin.
+type Pricer struct {
+	cfg   *Config
+	rpc   *rpc.Client
+	queue chan struct{}
+
+	mutex    sync.RWMutex
+	gasPrice *big.Int
+}
+
+// New creates a new Pricer instance, the queue is the prover concurrency guard channel, whose
+// length is used as the queue depth. If the given RPC client is nil, the L1 gas price is
+// always zero.
+func New(cfg *Config, rpc *rpc.Client, queue chan struct{}) *Pricer {
+	return &Pricer{cfg: cfg, rpc: rpc, queue: queue, gasPrice: new(big.Int)}
+}
+
+// Start starts a loop to refresh the L1 gas price periodically.
+func (p *Pricer) Start(ctx context.Context) {
+	if p.rpc == nil || p.cfg.UpdateInterval == 0 {
+		return
+	}
+
+	ticker := time.NewTicker(p.cfg.UpdateInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.UpdateGasPrice(ctx); err != nil {
+				log.Warn("Failed to update L1 gas price for tier fee pricing", "error", err)
+			}
+		}
+	}
+}
+
+// UpdateGasPrice fetches the current L1 gas price.
+func (p *Pricer) UpdateGasPrice(ctx context.Context) error {
+	if p.rpc == nil {
+		return nil
+	}
+
+	gasPrice, err := p.rpc.L1.SuggestGasPrice(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L1 gas price: %w", err)
+	}
+
+	p.mutex.Lock()
+	p.gasPrice = gasPrice
+	p.mutex.Unlock()
+
+	return nil
+}
+
+// GasPrice returns the latest fetched L1 gas price.
+func (p *Pricer) GasPrice() *big.Int {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return new(big.Int).Set(p.gasPrice)
+}
+
+// MinTierFee returns the current minimum acceptable fee of the given tier, the returned bool
+// is false if the tier is not supported.
+func (p *Pricer) MinTierFee(tier uint16) (*big.Int, bool) {
+	minTierFee, ok := p.cfg.MinTierFees[tier]
+	if !ok {
+		return nil, false
+	}
+
+	fee := p.estimateCost(tier, p.GasPrice())
+
+	// Add the margin and the queue premium.
+	fee.Mul(fee, new(big.Int).SetUint64(100+p.cfg.Margin))
+	fee.Div(fee, big.NewInt(100))
+	if p.queue != nil && cap(p.queue) != 0 {
+		premium := p.cfg.QueuePremium * uint64(len(p.queue)) * 100 / uint64(cap(p.queue))
+		fee.Mul(fee, new(big.Int).SetUint64(10000+premium))
+		fee.Div(fee, big.NewInt(10000))
+	}
+
+	if minTierFee != nil && fee.Cmp(minTierFee) < 0 {
+		return new(big.Int).Set(minTierFee), true
+	}
+
+	return fee, true
+}
+
+// MinTierFees returns the current minimum acceptable fees of all supported tiers.
+func (p *Pricer) MinTierFees() map[uint16]*big.Int {
+	fees := make(map[uint16]*big.Int, len(p.cfg.MinTierFees))
+	for tier := range p.cfg.MinTierFees {
+		fees[tier], _ = p.MinTierFee(tier)
+	}
+
+	return fees
+}
+
+// FeeTokens returns all accepted ERC-20 fee tokens.
+func (p *Pricer) FeeTokens() []common.Address {
+	tokens := make([]common.Address, 0, len(p.cfg.FeeTokenRates))
+	for token := range p.cfg.FeeTokenRates {
+		tokens = append(tokens, token)
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].Cmp(tokens[j]) < 0 })
+
+	return tokens
+}
+
+// IsFeeTokenAccepted checks whether the given fee token is accepted, the zero address means ETH.
+func (p *Pricer) IsFeeTokenAccepted(feeToken common.Address) bool {
+	if feeToken == (common.Address{}) {
+		return true
+	}
+
+	_, ok := p.cfg.FeeTokenRates[feeToken]
+	return ok
+}
+
+// MinTierFeeInToken returns the current minimum acceptable fee of the given tier denominated in
+// the given fee token, the zero address means ETH. The returned bool is false if either the tier
+// or the fee token is not supported.
+func (p *Pricer) MinTierFeeInToken(tier uint16, feeToken common.Address) (*big.Int, bool) {
+	fee, ok := p.MinTierFee(tier)
+	if !ok || feeToken == (common.Address{}) {
+		return fee, ok
+	}
+
+	rate, ok := p.cfg.FeeTokenRates[feeToken]
+	if !ok {
+		return nil, false
+	}
+
+	// Round up, so that the converted fee is never worth less than the ETH one.
+	fee.Mul(fee, rate)
+	fee.Add(fee, new(big.Int).Sub(etherUnit, common.Big1))
+	return fee.Div(fee, etherUnit), true
+}
+
+// estimateCost estimates the cost of proving a block with the given tier, which is the
+// `TaikoL1.proveBlock` transaction fee plus the liveness bond opportunity cost.
+func (p *Pricer) estimateCost(tier uint16, gasPrice *big.Int) *big.Int {
+	gas, ok := p.cfg.ProveBlockGas[tier]
+	if !ok {
+		gas = DefaultProveBlockGas[tier]
+	}
+	cost := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gas))
+
+	if p.cfg.LivenessBond == nil || p.cfg.BondTokenPrice == nil || p.cfg.BondTokenPrice.Sign() == 0 {
+		return cost
+	}
+
+	// bond * price * APR * lockTime / year
+	bondCost := new(big.Int).Mul(p.cfg.LivenessBond, p.cfg.BondTokenPrice)
+	bondCost.Mul(bondCost, new(big.Int).SetUint64(p.cfg.BondAPR))
+	bondCost.Mul(bondCost, big.NewInt(int64(p.cfg.BondLockTimes[tier])))
+	bondCost.Div(bondCost, new(big.Int).Mul(bondTokenUnit, big.NewInt(100*int64(year))))
+
+	return cost.Add(cost, bondCost)
+}
@@ -0,0 +1,107 @@
+package pricing
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+func newTestPricer(queue chan struct{}) *Pricer {
+	p := New(&Config{
+		MinTierFees: map[uint16]*big.Int{
+			encoding.TierOptimisticID: common.Big1,
+			encoding.TierSgxID:        big.NewInt(params.Ether),
+		},
+		ProveBlockGas: map[uint16]uint64{encoding.TierOptimisticID: 100_000},
+		Margin:        20,
+		QueuePremium:  50,
+	}, nil, queue)
+	p.gasPrice = big.NewInt(10 * params.GWei)
+
+	return p
+}
+
+func TestMinTierFee(t *testing.T) {
+	p := newTestPricer(nil)
+
+	// 100_000 gas * 10 gwei * 120%
+	fee, ok := p.MinTierFee(encoding.TierOptimisticID)
+	require.True(t, ok)
+	require.Equal(t, big.NewInt(1_200_000*params.GWei), fee)
+
+	// The static minimum tier fee is higher than the computed one.
+	fee, ok = p.MinTierFee(encoding.TierSgxID)
+	require.True(t, ok)
+	require.Equal(t, big.NewInt(params.Ether), fee)
+
+	_, ok = p.MinTierFee(encoding.TierPseZkevmID)
+	require.False(t, ok)
+
+	require.Len(t, p.MinTierFees(), 2)
+}
+
+func TestMinTierFeeQueuePremium(t *testing.T) {
+	queue := make(chan struct{}, 4)
+	p := newTestPricer(queue)
+
+	queue <- struct{}{}
+	queue <- struct{}{}
+
+	// 100_000 gas * 10 gwei * 120% * (100% + 50% * 2 / 4)
+	fee, ok := p.MinTierFee(encoding.TierOptimisticID)
+	require.True(t, ok)
+	require.Equal(t, big.NewInt(1_500_000*params.GWei), fee)
+}
+
+func TestMinTierFeeBondCost(t *testing.T) {
+	p := newTestPricer(nil)
+	p.cfg.LivenessBond = new(big.Int).Mul(big.NewInt(250), bondTokenUnit)
+	p.cfg.BondTokenPrice = big.NewInt(params.Ether / 1000)
+	p.cfg.BondAPR = 10
+	p.cfg.BondLockTimes = map[uint16]time.Duration{encoding.TierOptimisticID: year / 1000}
+
+	// (100_000 gas * 10 gwei + 250 tokens * 0.001 ether * 10% / 1000) * 120%
+	fee, ok := p.MinTierFee(encoding.TierOptimisticID)
+	require.True(t, ok)
+	require.Equal(t, big.NewInt((1_000_000+25_000)*params.GWei*12/10), fee)
+}
+
+func TestMinTierFeeInToken(t *testing.T) {
+	var (
+		p     = newTestPricer(nil)
+		token = common.HexToAddress("0x01")
+	)
+	// 1 ether = 3000 * 10^6 token units.
+	p.cfg.FeeTokenRates = map[common.Address]*big.Int{token: big.NewInt(3_000_000_000)}
+
+	require.True(t, p.IsFeeTokenAccepted(common.Address{}))
+	require.True(t, p.IsFeeTokenAccepted(token))
+	require.False(t, p.IsFeeTokenAccepted(common.HexToAddress("0x02")))
+	require.Equal(t, []common.Address{token}, p.FeeTokens())
+
+	fee, ok := p.MinTierFeeInToken(encoding.TierOptimisticID, common.Address{})
+	require.True(t, ok)
+	require.Equal(t, big.NewInt(1_200_000*params.GWei), fee)
+
+	// 0.0012 ether * 3000 * 10^6
+	fee, ok = p.MinTierFeeInToken(encoding.TierOptimisticID, token)
+	require.True(t, ok)
+	require.Equal(t, big.NewInt(3_600_000), fee)
+
+	// The converted fee is rounded up.
+	p.cfg.FeeTokenRates[token] = common.Big1
+	fee, ok = p.MinTierFeeInToken(encoding.TierOptimisticID, token)
+	require.True(t, ok)
+	require.Equal(t, common.Big1, fee)
+
+	_, ok = p.MinTierFeeInToken(encoding.TierOptimisticID, common.HexToAddress("0x02"))
+	require.False(t, ok)
+	_, ok = p.MinTierFeeInToken(encoding.TierPseZkevmID, token)
+	require.False(t, ok)
+}
@@ -0,0 +1,254 @@
+package prover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/prover/db"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+// updateProofJob updates the persisted proof job of the given block, does nothing
+// if the prover database is not enabled.
+func (p *Prover) updateProofJob(blockID *big.Int, update func(job *db.ProofJob)) {
+	if p.proofJobs == nil {
+		return
+	}
+
+	if err := p.proofJobs.Update(blockID.Uint64(), update); err != nil {
+		log.Error("Failed to update proof job", "blockID", blockID, "error", err)
+	}
+}
+
+// setProofJobStatus sets the status of the persisted proof job of the given block.
+func (p *Prover) setProofJobStatus(blockID *big.Int, status db.ProofJobStatus, reason error) {
+	p.updateProofJob(blockID, func(job *db.ProofJob) {
+		job.Status = status
+		job.Error = ""
+		if reason != nil {
+			job.Error = reason.Error()
+		}
+	})
+}
+
+// deleteProofJob removes the persisted proof job of the given block.
+func (p *Prover) deleteProofJob(blockID *big.Int) {
+	if p.proofJobs == nil {
+		return
+	}
+
+	if err := p.proofJobs.Delete(blockID.Uint64()); err != nil {
+		log.Error("Failed to delete proof job", "blockID", blockID, "error", err)
+	}
+}
+
+// deleteProofJobIfStatus removes the persisted proof job of the given block, only if it's still in
+// the given status.
+func (p *Prover) deleteProofJobIfStatus(blockID *big.Int, status db.ProofJobStatus) {
+	if p.proofJobs == nil {
+		return
+	}
+
+	if err := p.proofJobs.DeleteIfStatus(blockID.Uint64(), status); err != nil {
+		log.Error("Failed to delete proof job", "blockID", blockID, "error", err)
+	}
+}
+
+// markProofJobGenerating marks the persisted proof job of the given block as generating.
+func (p *Prover) markProofJobGenerating(e *bindings.TaikoL1ClientBlockProposed, tier uint16) {
+	p.updateProofJob(e.BlockId, func(job *db.ProofJob) {
+		job.Status = db.ProofJobGenerating
+		job.Tier = tier
+		job.Event = e
+		job.Error = ""
+	})
+}
+
+// isProofJobInFlight checks whether there is already an unfinished proof job for the given
+// BlockProposed event, which has been resumed from the database or queued before.
+func (p *Prover) isProofJobInFlight(e *bindings.TaikoL1ClientBlockProposed) bool {
+	if p.proofJobs == nil {
+		return false
+	}
+
+	job, err := p.proofJobs.Get(e.BlockId.Uint64())
+	if err != nil {
+		if !errors.Is(err, db.ErrProofJobNotFound) {
+			log.Error("Failed to get proof job", "blockID", e.BlockId, "error", err)
+		}
+		return false
+	}
+
+	// If the block was proposed again after a L1 reorg, the persisted job is stale.
+	if job.Event == nil || job.Event.Raw.BlockHash != e.Raw.BlockHash {
+		return false
+	}
+
+	return !job.Status.Finished()
+}
+
+// finalizeProofJob checks the proof status in protocol after a proof submission, and marks
+// the persisted proof job as accepted or failed.
+func (p *Prover) finalizeProofJob(ctx context.Context, proofWithHeader *proofProducer.ProofWithHeader) {
+	if p.proofJobs == nil {
+		return
+	}
+
+	proofStatus, err := rpc.GetBlockProofStatus(ctx, p.rpc, proofWithHeader.BlockID, p.proverAddress)
+	if err != nil {
+		// Keep the job in submitted status, it will be reconciled after the next restart.
+		log.Warn("Failed to check block proof status", "blockID", proofWithHeader.BlockID, "error", err)
+		return
+	}
+
+	if proofStatus.IsSubmitted &&
+		!proofStatus.Invalid &&
+		proofStatus.CurrentTransitionState.Prover == p.proverAddress &&
+		proofStatus.CurrentTransitionState.Tier == proofWithHeader.Tier {
+		p.setProofJobStatus(proofWithHeader.BlockID, db.ProofJobAccepted, nil)
+		return
+	}
+
+	p.setProofJobStatus(proofWithHeader.BlockID, db.ProofJobFailed, errors.New("proof not accepted by protocol"))
+}
+
+// resumeProofJobs resumes all unfinished proof jobs persisted in the database, the already generated
+// proofs will be submitted directly, and the others will be requested again. The L1Current cursor is
+// also moved forward, so that the blocks handled before the last shutdown won't be rescanned.
+func (p *Prover) resumeProofJobs(ctx context.Context) error {
+	if p.proofJobs == nil {
+		return nil
+	}
+
+	if err := p.skipHandledBlocks(ctx); err != nil {
+		return fmt.Errorf("failed to skip handled blocks: %w", err)
+	}
+
+	jobs, err := p.proofJobs.Unfinished()
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobs {
+		log.Info(
+			"Resume proof job",
+			"blockID", job.BlockID,
+			"tier", job.Tier,
+			"status", job.Status,
+		)
+
+		switch job.Status {
+		case db.ProofJobGenerated, db.ProofJobSubmitted:
+			if job.Proof == nil {
+				p.setProofJobStatus(new(big.Int).SetUint64(job.BlockID), db.ProofJobFailed, errors.New("missing proof"))
+				continue
+			}
+
+			// The proof generation channel is only consumed after the event loop started, and may
+			// not be large enough for all the resumed proofs.
+			go func(proofWithHeader *proofProducer.ProofWithHeader) {
+				select {
+				case <-p.ctx.Done():
+				case p.proofGenerationCh <- proofWithHeader:
+				}
+			}(job.Proof)
+		default:
+			if job.Event == nil {
+				p.setProofJobStatus(new(big.Int).SetUint64(job.BlockID), db.ProofJobFailed, errors.New("missing event"))
+				continue
+			}
+
+			p.requestProofForEvent(p.ctx, job.Event)
+		}
+	}
+
+	return nil
+}
+
+// skipHandledBlocks moves the L1Current cursor forward to the L1 block of the latest persisted proof job,
+// all the blocks proposed before it have been handled before the last shutdown.
+func (p *Prover) skipHandledBlocks(ctx context.Context) error {
+	job, err := p.proofJobs.Latest()
+	if err != nil {
+		if errors.Is(err, db.ErrProofJobNotFound) {
+			return nil
+		}
+		return err
+	}
+	if job.Event == nil || job.Event.Raw.BlockNumber <= p.l1Current.Number.Uint64() {
+		return nil
+	}
+
+	header, err := p.rpc.L1.HeaderByNumber(ctx, new(big.Int).SetUint64(job.Event.Raw.BlockNumber))
+	if err != nil {
+		return err
+	}
+	// The block may have been reorged out after the last shutdown, then rescan from the current cursor.
+	if header.Hash() != job.Event.Raw.BlockHash {
+		log.Warn(
+			"L1 block of the latest proof job has been reorged",
+			"blockID", job.BlockID,
+			"l1Height", job.Event.Raw.BlockNumber,
+			"l1Hash", job.Event.Raw.BlockHash,
+		)
+		return nil
+	}
+
+	log.Info(
+		"Skip the blocks handled before the last shutdown",
+		"l1CurrentOld", p.l1Current.Number,
+		"l1CurrentNew", header.Number,
+		"lastHandledBlockID", job.BlockID,
+	)
+	p.l1Current = header
+	p.lastHandledBlockID = job.BlockID
+
+	return nil
+}
+
+// requestProofForEvent tries generating a proof for the proposed block with the given backoff policy.
+func (p *Prover) requestProofForEvent(ctx context.Context, e *bindings.TaikoL1ClientBlockProposed) {
+	p.updateProofJob(e.BlockId, func(job *db.ProofJob) {
+		job.Status = db.ProofJobQueued
+		job.Tier = e.Meta.MinTier
+		job.Event = e
+		job.Proof = nil
+		job.Error = ""
+	})
+
+	go func() {
+		if err := backoff.Retry(
+			func() error {
+				p.proposeConcurrencyGuard <- struct{}{}
+				defer func() { <-p.proposeConcurrencyGuard }()
+
+				if err := p.handleNewBlockProposedEvent(ctx, e); err != nil {
+					log.Error(
+						"Failed to handle BlockProposed event",
+						"error", err,
+						"blockID", e.BlockId,
+						"minTier", e.Meta.MinTier,
+						"maxRetrys", p.cfg.BackOffMaxRetrys,
+					)
+					return err
+				}
+
+				// If the job is still queued, no proof has been requested for this block, so
+				// there is nothing to resume for it.
+				p.deleteProofJobIfStatus(e.BlockId, db.ProofJobQueued)
+				return nil
+			},
+			backoff.WithMaxRetries(backoff.NewConstantBackOff(p.cfg.BackOffRetryInterval), p.cfg.BackOffMaxRetrys),
+		); err != nil {
+			log.Error("Handle new BlockProposed event error", "error", err)
+			p.setProofJobStatus(e.BlockId, db.ProofJobFailed, err)
+		}
+	}()
+}
@@ -0,0 +1,173 @@
+package prover
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/prover/db"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+// testL1HeaderService is a mock of the `eth` namespace of a L1 node, which serves the canonical headers.
+type testL1HeaderService struct {
+	headers map[uint64]*types.Header
+}
+
+func (s *testL1HeaderService) GetBlockByNumber(number hexutil.Uint64, _ bool) (*types.Header, error) {
+	return s.headers[uint64(number)], nil
+}
+
+func newTestL1Header(number uint64) *types.Header {
+	return &types.Header{Number: new(big.Int).SetUint64(number), Difficulty: common.Big0}
+}
+
+func newTestProofJobsProver(t *testing.T, headers ...*types.Header) *Prover {
+	service := &testL1HeaderService{headers: make(map[uint64]*types.Header)}
+	for _, header := range headers {
+		service.headers[header.Number.Uint64()] = header
+	}
+
+	rpcServer := gethRPC.NewServer()
+	require.Nil(t, rpcServer.RegisterName("eth", service))
+	t.Cleanup(rpcServer.Stop)
+
+	server := httptest.NewServer(rpcServer)
+	t.Cleanup(server.Close)
+
+	l1, err := rpc.NewEthClient(context.Background(), server.URL, time.Second)
+	require.Nil(t, err)
+	t.Cleanup(l1.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	return &Prover{
+		ctx:               ctx,
+		rpc:               &rpc.Client{L1: l1},
+		proofJobs:         db.NewProofJobStore(memorydb.New()),
+		proofGenerationCh: make(chan *proofProducer.ProofWithHeader),
+		l1Current:         newTestL1Header(10),
+	}
+}
+
+func newTestProofJobEvent(blockID uint64, l1Header *types.Header) *bindings.TaikoL1ClientBlockProposed {
+	return &bindings.TaikoL1ClientBlockProposed{
+		BlockId: new(big.Int).SetUint64(blockID),
+		Raw: types.Log{
+			Topics:      []common.Hash{},
+			Data:        []byte{},
+			BlockNumber: l1Header.Number.Uint64(),
+			BlockHash:   l1Header.Hash(),
+		},
+	}
+}
+
+func TestResumeProofJobs(t *testing.T) {
+	p := newTestProofJobsProver(t)
+
+	proof := &proofProducer.ProofWithHeader{BlockID: common.Big1, Proof: []byte{1}, Tier: 200}
+	for _, job := range []*db.ProofJob{
+		{BlockID: 1, Status: db.ProofJobGenerated, Proof: proof},
+		{BlockID: 2, Status: db.ProofJobSubmitted},
+		{BlockID: 3, Status: db.ProofJobQueued},
+		{BlockID: 4, Status: db.ProofJobAccepted},
+	} {
+		require.Nil(t, p.proofJobs.Put(job))
+	}
+
+	require.Nil(t, p.resumeProofJobs(p.ctx))
+
+	// The generated proof is submitted again, once the event loop consumes it.
+	select {
+	case resumed := <-p.proofGenerationCh:
+		require.Equal(t, proof.BlockID, resumed.BlockID)
+		require.Equal(t, proof.Proof, resumed.Proof)
+	case <-time.After(time.Second):
+		t.Fatal("generated proof not resumed")
+	}
+
+	// The jobs which can't be resumed are failed.
+	for _, id := range []uint64{2, 3} {
+		job, err := p.proofJobs.Get(id)
+		require.Nil(t, err)
+		require.Equal(t, db.ProofJobFailed, job.Status)
+	}
+
+	// The finished jobs are left untouched, and the L1Current cursor is kept, since the latest
+	// job has no event.
+	job, err := p.proofJobs.Get(4)
+	require.Nil(t, err)
+	require.Equal(t, db.ProofJobAccepted, job.Status)
+	require.Equal(t, uint64(10), p.l1Current.Number.Uint64())
+}
+
+func TestSkipHandledBlocks(t *testing.T) {
+	var (
+		canonical = newTestL1Header(20)
+		reorged   = &types.Header{Number: big.NewInt(20), Difficulty: common.Big1}
+		behind    = newTestL1Header(5)
+	)
+
+	tests := []struct {
+		name               string
+		jobs               []*db.ProofJob
+		l1Current          uint64
+		lastHandledBlockID uint64
+	}{
+		{
+			"no jobs",
+			nil,
+			10,
+			0,
+		},
+		{
+			"latest job ahead of the cursor",
+			[]*db.ProofJob{
+				{BlockID: 3, Status: db.ProofJobQueued, Event: newTestProofJobEvent(3, behind)},
+				{BlockID: 7, Status: db.ProofJobAccepted, Event: newTestProofJobEvent(7, canonical)},
+			},
+			20,
+			7,
+		},
+		{
+			"latest job behind the cursor",
+			[]*db.ProofJob{{BlockID: 3, Status: db.ProofJobGenerating, Event: newTestProofJobEvent(3, behind)}},
+			10,
+			0,
+		},
+		{
+			"latest job reorged",
+			[]*db.ProofJob{{BlockID: 7, Status: db.ProofJobGenerating, Event: newTestProofJobEvent(7, reorged)}},
+			10,
+			0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestProofJobsProver(t, canonical, behind)
+			for _, job := range tt.jobs {
+				require.Nil(t, p.proofJobs.Put(job))
+			}
+
+			require.Nil(t, p.skipHandledBlocks(p.ctx))
+			require.Equal(t, tt.l1Current, p.l1Current.Number.Uint64())
+			require.Equal(t, tt.lastHandledBlockID, p.lastHandledBlockID)
+			if tt.l1Current == canonical.Number.Uint64() {
+				require.Equal(t, canonical.Hash(), p.l1Current.Hash())
+			}
+		})
+	}
+}
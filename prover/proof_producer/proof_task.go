@@ -0,0 +1,334 @@
+package producer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// JSON-RPC methods of the asynchronous proof task protocol, which are served by both raiko host and
+// zkevm-chain proverd services.
+const (
+	ProofTaskSubmitMethod = "proof_submit"
+	ProofTaskStatusMethod = "proof_status"
+	ProofTaskCancelMethod = "proof_cancel"
+)
+
+// ProofTaskStatus represents the status of a remote proof generation task.
+type ProofTaskStatus string
+
+// All remote proof generation task statuses.
+const (
+	ProofTaskPending   ProofTaskStatus = "pending"
+	ProofTaskRunning   ProofTaskStatus = "running"
+	ProofTaskSucceeded ProofTaskStatus = "success"
+	ProofTaskFailed    ProofTaskStatus = "failed"
+	ProofTaskCancelled ProofTaskStatus = "cancelled"
+	ProofTaskNotFound  ProofTaskStatus = "not_found"
+)
+
+var (
+	errProofCancelled = errors.New("proof generation cancelled")
+	// proofTaskRequestTimeout is the timeout of each request to the remote prover services, the
+	// proofs are generated asynchronously, so the requests are expected to be answered quickly.
+	proofTaskRequestTimeout = 1 * time.Minute
+)
+
+// ProofTask is a proof generation task submitted to a remote prover service.
+type ProofTask struct {
+	ID        string      `json:"id"`
+	BlockHash common.Hash `json:"blockHash"`
+}
+
+// ProofTaskStore persists the remote proof generation tasks, so that the prover can keep polling
+// them after a restart, instead of submitting them again.
+type ProofTaskStore interface {
+	// GetProofTask returns nil if there is no task of the given block.
+	GetProofTask(endpoint string, blockID uint64) (*ProofTask, error)
+	PutProofTask(endpoint string, blockID uint64, task *ProofTask) error
+	DeleteProofTask(endpoint string, blockID uint64) error
+}
+
+// ProofTaskParam represents the JSON body of the `param` field of the status / cancel requests.
+type ProofTaskParam struct {
+	TaskID string `json:"taskId"`
+}
+
+// ProofTaskRequestBody represents the JSON body of the status / cancel requests.
+type ProofTaskRequestBody struct {
+	JsonRPC string            `json:"jsonrpc"` //nolint:revive,stylecheck
+	ID      *big.Int          `json:"id"`
+	Method  string            `json:"method"`
+	Params  []*ProofTaskParam `json:"params"`
+}
+
+// ProofTaskResponse represents the JSON body of the responses of all proof task requests.
+type ProofTaskResponse struct {
+	JsonRPC string          `json:"jsonrpc"` //nolint:revive,stylecheck
+	ID      *big.Int        `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *struct {
+		Code    *big.Int `json:"code"`
+		Message string   `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// ProofTaskSubmitResult represents the JSON body of the `result` field of the submit responses.
+type ProofTaskSubmitResult struct {
+	TaskID string `json:"taskId"`
+}
+
+// ProofTaskStatusResult represents the JSON body of the `result` field of the status responses,
+// the output is only set when the task succeeded.
+type ProofTaskStatusResult struct {
+	Status ProofTaskStatus `json:"status"`
+	Output json.RawMessage `json:"output,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// proofTaskClient submits proof generation tasks to a remote prover service, keeps polling their
+// statuses, and cancels them when the proofs are not needed anymore.
+type proofTaskClient struct {
+	endpoint   string
+	producer   string
+	store      ProofTaskStore // optional
+	httpClient *http.Client
+
+	tasks   map[uint64]*ProofTask
+	cancels map[uint64]context.CancelFunc
+	mutex   sync.Mutex
+}
+
+// newProofTaskClient creates a new proofTaskClient instance.
+func newProofTaskClient(endpoint string, producer string, store ProofTaskStore) *proofTaskClient {
+	return &proofTaskClient{
+		endpoint:   endpoint,
+		producer:   producer,
+		store:      store,
+		httpClient: &http.Client{Timeout: proofTaskRequestTimeout},
+		tasks:      make(map[uint64]*ProofTask),
+		cancels:    make(map[uint64]context.CancelFunc),
+	}
+}
+
+// generate submits a proof generation task of the given block, or resumes the existing one, and keeps
+// polling it until the proof is generated, the task output is decoded into the given output.
+func (c *proofTaskClient) generate(
+	ctx context.Context,
+	blockID *big.Int,
+	blockHash common.Hash,
+	submitBody interface{},
+	output interface{},
+) error {
+	taskCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	c.mutex.Lock()
+	c.cancels[blockID.Uint64()] = cancel
+	c.mutex.Unlock()
+	defer func() {
+		c.mutex.Lock()
+		delete(c.cancels, blockID.Uint64())
+		c.mutex.Unlock()
+	}()
+
+	var (
+		task  = c.getTask(blockID, blockHash)
+		start = time.Now()
+	)
+	if task != nil {
+		log.Info("Resume proof task", "blockID", blockID, "taskID", task.ID, "producer", c.producer)
+	}
+
+	err := backoff.Retry(func() error {
+		if task == nil {
+			var result ProofTaskSubmitResult
+			if err := c.call(taskCtx, submitBody, &result); err != nil {
+				log.Error("Failed to submit proof task", "blockID", blockID, "error", err, "endpoint", c.endpoint)
+				return err
+			}
+			if result.TaskID == "" {
+				return errors.New("empty proof task ID")
+			}
+			task = &ProofTask{ID: result.TaskID, BlockHash: blockHash}
+			c.putTask(blockID, task)
+
+			log.Info("Proof task submitted", "blockID", blockID, "taskID", task.ID, "producer", c.producer)
+		}
+
+		var status ProofTaskStatusResult
+		if err := c.call(taskCtx, c.newRequestBody(ProofTaskStatusMethod, task), &status); err != nil {
+			log.Error("Failed to get proof task status", "blockID", blockID, "error", err, "endpoint", c.endpoint)
+			return err
+		}
+
+		switch status.Status {
+		case ProofTaskPending, ProofTaskRunning:
+			log.Info(
+				"Proof generating",
+				"height", blockID,
+				"taskID", task.ID,
+				"status", status.Status,
+				"time", time.Since(start),
+				"producer", c.producer,
+			)
+			return errProofGenerating
+		case ProofTaskSucceeded:
+			c.deleteTask(blockID)
+			if err := json.Unmarshal(status.Output, output); err != nil {
+				return backoff.Permanent(fmt.Errorf("failed to decode proof task output: %w", err))
+			}
+			log.Info("Proof generated", "height", blockID, "time", time.Since(start), "producer", c.producer)
+			return nil
+		default:
+			c.deleteTask(blockID)
+			return backoff.Permanent(fmt.Errorf("proof task %s %s: %s", task.ID, status.Status, status.Error))
+		}
+	}, backoff.WithContext(backoff.NewConstantBackOff(proofPollingInterval), taskCtx))
+	if err != nil && ctx.Err() == nil && taskCtx.Err() != nil {
+		return errProofCancelled
+	}
+
+	return err
+}
+
+// cancel stops polling the proof generation task of the given block, and cancels the remote task.
+func (c *proofTaskClient) cancel(ctx context.Context, blockID *big.Int) error {
+	c.mutex.Lock()
+	cancel, ok := c.cancels[blockID.Uint64()]
+	c.mutex.Unlock()
+
+	if ok {
+		cancel()
+	}
+
+	task := c.getTask(blockID, common.Hash{})
+	if task == nil {
+		return nil
+	}
+
+	log.Info("Cancel proof task", "blockID", blockID, "taskID", task.ID, "producer", c.producer)
+
+	if err := c.call(ctx, c.newRequestBody(ProofTaskCancelMethod, task), nil); err != nil {
+		return fmt.Errorf("failed to cancel proof task %s: %w", task.ID, err)
+	}
+
+	c.deleteTask(blockID)
+
+	return nil
+}
+
+// getTask returns the task of the given block, the persisted tasks of a different block hash are
+// discarded, since the block has been reorged, a zero block hash matches any task.
+func (c *proofTaskClient) getTask(blockID *big.Int, blockHash common.Hash) *ProofTask {
+	c.mutex.Lock()
+	task, ok := c.tasks[blockID.Uint64()]
+	c.mutex.Unlock()
+
+	if !ok && c.store != nil {
+		var err error
+		if task, err = c.store.GetProofTask(c.endpoint, blockID.Uint64()); err != nil {
+			log.Error("Failed to get proof task", "blockID", blockID, "error", err)
+		}
+	}
+
+	if task == nil || (blockHash != (common.Hash{}) && task.BlockHash != blockHash) {
+		return nil
+	}
+
+	return task
+}
+
+// putTask saves the task of the given block.
+func (c *proofTaskClient) putTask(blockID *big.Int, task *ProofTask) {
+	c.mutex.Lock()
+	c.tasks[blockID.Uint64()] = task
+	c.mutex.Unlock()
+
+	if c.store == nil {
+		return
+	}
+	if err := c.store.PutProofTask(c.endpoint, blockID.Uint64(), task); err != nil {
+		log.Error("Failed to save proof task", "blockID", blockID, "error", err)
+	}
+}
+
+// deleteTask removes the task of the given block.
+func (c *proofTaskClient) deleteTask(blockID *big.Int) {
+	c.mutex.Lock()
+	delete(c.tasks, blockID.Uint64())
+	c.mutex.Unlock()
+
+	if c.store == nil {
+		return
+	}
+	if err := c.store.DeleteProofTask(c.endpoint, blockID.Uint64()); err != nil {
+		log.Error("Failed to delete proof task", "blockID", blockID, "error", err)
+	}
+}
+
+// newRequestBody builds the JSON body of a status / cancel request of the given task.
+func (c *proofTaskClient) newRequestBody(method string, task *ProofTask) *ProofTaskRequestBody {
+	return &ProofTaskRequestBody{
+		JsonRPC: "2.0",
+		ID:      common.Big1,
+		Method:  method,
+		Params:  []*ProofTaskParam{{TaskID: task.ID}},
+	}
+}
+
+// call sends a JSON-RPC request to the remote prover service, and decodes its result into the given
+// result, if it is not nil.
+func (c *proofTaskClient) call(ctx context.Context, body interface{}, result interface{}) error {
+	jsonValue, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewBuffer(jsonValue))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to call %s, statusCode: %d", c.endpoint, res.StatusCode)
+	}
+
+	resBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	var output ProofTaskResponse
+	if err := json.Unmarshal(resBytes, &output); err != nil {
+		return err
+	}
+
+	if output.Error != nil {
+		return errors.New(output.Error.Message)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	return json.Unmarshal(output.Result, result)
+}
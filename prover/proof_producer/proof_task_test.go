@@ -0,0 +1,203 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var testProofTaskSubmitBody = &ProofTaskRequestBody{JsonRPC: "2.0", ID: common.Big1, Method: ProofTaskSubmitMethod}
+
+// testProofTaskServer is a remote prover service for tests, which serves the asynchronous proof task
+// protocol, each task succeeds after being polled the given times.
+type testProofTaskServer struct {
+	*httptest.Server
+	polls  int
+	output interface{}
+
+	submitted int
+	statuses  map[string]int
+	cancelled map[string]bool
+	mutex     sync.Mutex
+}
+
+func newTestProofTaskServer(t *testing.T, polls int, output interface{}) *testProofTaskServer {
+	s := &testProofTaskServer{
+		polls:     polls,
+		output:    output,
+		statuses:  make(map[string]int),
+		cancelled: make(map[string]bool),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req ProofTaskRequestBody
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		var result interface{}
+		switch req.Method {
+		case ProofTaskSubmitMethod:
+			s.submitted++
+			result = &ProofTaskSubmitResult{TaskID: fmt.Sprintf("task-%d", s.submitted)}
+		case ProofTaskStatusMethod:
+			taskID := req.Params[0].TaskID
+			s.statuses[taskID]++
+			switch {
+			case s.cancelled[taskID]:
+				result = &ProofTaskStatusResult{Status: ProofTaskCancelled}
+			case s.statuses[taskID] < s.polls:
+				result = &ProofTaskStatusResult{Status: ProofTaskRunning}
+			default:
+				output, err := json.Marshal(s.output)
+				require.Nil(t, err)
+				result = &ProofTaskStatusResult{Status: ProofTaskSucceeded, Output: output}
+			}
+		case ProofTaskCancelMethod:
+			s.cancelled[req.Params[0].TaskID] = true
+		default:
+			t.Errorf("unexpected method: %s", req.Method)
+		}
+
+		rawResult, err := json.Marshal(result)
+		require.Nil(t, err)
+		require.Nil(t, json.NewEncoder(w).Encode(&ProofTaskResponse{JsonRPC: "2.0", ID: req.ID, Result: rawResult}))
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// testProofTaskStore is an in-memory ProofTaskStore for tests.
+type testProofTaskStore struct {
+	tasks map[string]*ProofTask
+	mutex sync.Mutex
+}
+
+func newTestProofTaskStore() *testProofTaskStore {
+	return &testProofTaskStore{tasks: make(map[string]*ProofTask)}
+}
+
+func (s *testProofTaskStore) GetProofTask(endpoint string, blockID uint64) (*ProofTask, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.tasks[fmt.Sprintf("%s/%d", endpoint, blockID)], nil
+}
+
+func (s *testProofTaskStore) PutProofTask(endpoint string, blockID uint64, task *ProofTask) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.tasks[fmt.Sprintf("%s/%d", endpoint, blockID)] = task
+	return nil
+}
+
+func (s *testProofTaskStore) DeleteProofTask(endpoint string, blockID uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.tasks, fmt.Sprintf("%s/%d", endpoint, blockID))
+	return nil
+}
+
+func (s *testProofTaskStore) len() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return len(s.tasks)
+}
+
+func setTestProofPollingInterval(t *testing.T) {
+	interval := proofPollingInterval
+	proofPollingInterval = 10 * time.Millisecond
+	t.Cleanup(func() { proofPollingInterval = interval })
+}
+
+func TestProofTaskClientGenerate(t *testing.T) {
+	setTestProofPollingInterval(t)
+
+	var (
+		server    = newTestProofTaskServer(t, 3, &RaikoHostOutput{Proof: "0x01"})
+		store     = newTestProofTaskStore()
+		client    = newProofTaskClient(server.URL, "test", store)
+		blockHash = randHash()
+		output    RaikoHostOutput
+	)
+	require.Nil(t, client.generate(context.Background(), common.Big1, blockHash, testProofTaskSubmitBody, &output))
+	require.Equal(t, "0x01", output.Proof)
+	require.Equal(t, 1, server.submitted)
+	require.Equal(t, 3, server.statuses["task-1"])
+
+	// The finished task is removed.
+	require.Zero(t, store.len())
+	require.Nil(t, client.getTask(common.Big1, blockHash))
+}
+
+func TestProofTaskClientResume(t *testing.T) {
+	setTestProofPollingInterval(t)
+
+	var (
+		server    = newTestProofTaskServer(t, 1, &RaikoHostOutput{Proof: "0x01"})
+		store     = newTestProofTaskStore()
+		blockHash = randHash()
+		output    RaikoHostOutput
+	)
+	require.Nil(t, store.PutProofTask(server.URL, 1, &ProofTask{ID: "task-0", BlockHash: blockHash}))
+	require.Nil(t, store.PutProofTask(server.URL, 2, &ProofTask{ID: "task-reorged", BlockHash: randHash()}))
+
+	// The persisted task is polled, instead of submitting a new one.
+	client := newProofTaskClient(server.URL, "test", store)
+	require.Nil(t, client.generate(context.Background(), common.Big1, blockHash, testProofTaskSubmitBody, &output))
+	require.Zero(t, server.submitted)
+	require.Equal(t, 1, server.statuses["task-0"])
+
+	// The persisted task of a reorged block is discarded.
+	require.Nil(t, client.generate(context.Background(), common.Big2, blockHash, testProofTaskSubmitBody, &output))
+	require.Equal(t, 1, server.submitted)
+	require.Zero(t, server.statuses["task-reorged"])
+}
+
+func TestProofTaskClientCancel(t *testing.T) {
+	setTestProofPollingInterval(t)
+
+	var (
+		server = newTestProofTaskServer(t, 1000, &RaikoHostOutput{})
+		store  = newTestProofTaskStore()
+		client = newProofTaskClient(server.URL, "test", store)
+		errCh  = make(chan error)
+	)
+	go func() {
+		errCh <- client.generate(context.Background(), common.Big1, randHash(), testProofTaskSubmitBody, &RaikoHostOutput{})
+	}()
+
+	require.Eventually(t, func() bool { return store.len() == 1 }, time.Second, 10*time.Millisecond)
+	require.Nil(t, client.cancel(context.Background(), common.Big1))
+	require.ErrorIs(t, <-errCh, errProofCancelled)
+
+	require.True(t, server.cancelled["task-1"])
+	require.Zero(t, store.len())
+
+	// Nothing to cancel.
+	require.Nil(t, client.cancel(context.Background(), common.Big2))
+}
+
+func TestProofTaskClientTimeout(t *testing.T) {
+	// An unresponsive remote prover service.
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) { <-release }))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+
+	client := newProofTaskClient(server.URL, "test", nil)
+	client.httpClient.Timeout = 50 * time.Millisecond
+	client.putTask(common.Big1, &ProofTask{ID: "task-1"})
+
+	start := time.Now()
+	require.NotNil(t, client.cancel(context.Background(), common.Big1))
+	require.Less(t, time.Since(start), time.Second)
+}
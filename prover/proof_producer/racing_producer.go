@@ -0,0 +1,324 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+var (
+	errEmptyProof = errors.New("empty proof")
+	// Weight of the newest sample in the exponential moving average of a backend's latency.
+	latencyEMAWeight = 0.2
+	// Every this many proof requests, one of the backends left out by the fanout is also requested as
+	// a probe, so that a backend which failed during a temporary outage can recover its statistics.
+	racingProbeInterval uint64 = 10
+)
+
+// RacingBackend is a proof producer backend raced by the RacingProofProducer.
+type RacingBackend struct {
+	Name     string
+	Producer ProofProducer
+}
+
+// RacingBackendStats is the statistics of a proof producer backend.
+type RacingBackendStats struct {
+	Name        string
+	Requests    uint64
+	Failures    uint64
+	Wins        uint64
+	FailureRate float64
+	Latency     time.Duration
+}
+
+// racingBackendMetrics contains the metrics of a single proof producer backend.
+type racingBackendMetrics struct {
+	requests    metrics.Counter
+	failures    metrics.Counter
+	wins        metrics.Counter
+	latency     metrics.Gauge
+	failureRate metrics.GaugeFloat64
+}
+
+// racingBackend is a proof producer backend, along with its statistics.
+type racingBackend struct {
+	RacingBackend
+	stats   RacingBackendStats
+	metrics *racingBackendMetrics
+	// Sequence number of the last proof request this backend was selected for.
+	lastSelected uint64
+}
+
+// RacingProofProducer sends each proof request to several redundant backends of the same tier, and
+// returns the first valid proof, the requests to the other backends are cancelled then.
+type RacingProofProducer struct {
+	backends []*racingBackend
+	// Number of the backends to request for each proof, the ones with the lowest failure rates and
+	// latencies are selected, zero means all backends.
+	fanout int
+	tier   uint16
+	// Number of the proof requests, used to schedule the probes.
+	requests uint64
+
+	// Cancel functions of the ongoing races.
+	races map[uint64]context.CancelFunc
+	mutex sync.Mutex
+}
+
+// NewRacingProofProducer creates a new RacingProofProducer instance, all the given backends must
+// produce proofs of the same tier.
+func NewRacingProofProducer(backends []*RacingBackend, fanout int) (*RacingProofProducer, error) {
+	if len(backends) == 0 {
+		return nil, errors.New("no proof producer backend")
+	}
+
+	r := &RacingProofProducer{
+		fanout: fanout,
+		tier:   backends[0].Producer.Tier(),
+		races:  make(map[uint64]context.CancelFunc),
+	}
+	for i, backend := range backends {
+		if backend.Producer.Tier() != r.tier {
+			return nil, fmt.Errorf("proof producer backend %s tier mismatch: %d", backend.Name, backend.Producer.Tier())
+		}
+
+		// Backend names are usually endpoint URLs, so the metrics are keyed by the backend indexes.
+		name := fmt.Sprintf("prover/proof/backend/%d/%d", r.tier, i)
+		r.backends = append(r.backends, &racingBackend{
+			RacingBackend: *backend,
+			stats:         RacingBackendStats{Name: backend.Name},
+			metrics: &racingBackendMetrics{
+				requests:    metrics.GetOrRegisterCounter(name+"/requests", nil),
+				failures:    metrics.GetOrRegisterCounter(name+"/failures", nil),
+				wins:        metrics.GetOrRegisterCounter(name+"/wins", nil),
+				latency:     metrics.GetOrRegisterGauge(name+"/latency", nil),
+				failureRate: metrics.GetOrRegisterGaugeFloat64(name+"/failureRate", nil),
+			},
+		})
+	}
+
+	return r, nil
+}
+
+// racingResult is the result of a proof request to a single backend.
+type racingResult struct {
+	backend *racingBackend
+	proof   *ProofWithHeader
+	err     error
+}
+
+// RequestProof implements the ProofProducer interface.
+func (r *RacingProofProducer) RequestProof(
+	ctx context.Context,
+	opts *ProofRequestOptions,
+	blockID *big.Int,
+	meta *bindings.TaikoDataBlockMetadata,
+	header *types.Header,
+) (*ProofWithHeader, error) {
+	backends := r.selectBackends()
+
+	log.Info(
+		"Request proof from redundant backends",
+		"blockID", blockID,
+		"tier", r.tier,
+		"backends", len(backends),
+	)
+
+	raceCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	r.mutex.Lock()
+	r.races[blockID.Uint64()] = cancel
+	r.mutex.Unlock()
+	defer func() {
+		r.mutex.Lock()
+		delete(r.races, blockID.Uint64())
+		r.mutex.Unlock()
+	}()
+
+	resultCh := make(chan *racingResult, len(backends))
+	for _, backend := range backends {
+		go func(backend *racingBackend) {
+			start := time.Now()
+			proof, err := backend.Producer.RequestProof(raceCtx, opts, blockID, meta, header)
+			if err == nil && (proof == nil || len(proof.Proof) == 0) {
+				err = errEmptyProof
+			}
+			// The backends losing the race are not penalized.
+			if raceCtx.Err() == nil || err == nil {
+				r.recordResult(backend, time.Since(start), err)
+			}
+			resultCh <- &racingResult{backend: backend, proof: proof, err: err}
+		}(backend)
+	}
+
+	var errs []error
+	for range backends {
+		res := <-resultCh
+		if res.err != nil {
+			log.Warn("Proof producer backend failed", "blockID", blockID, "backend", res.backend.Name, "error", res.err)
+			errs = append(errs, fmt.Errorf("%s: %w", res.backend.Name, res.err))
+			continue
+		}
+
+		log.Info("Proof producer backend won the race", "blockID", blockID, "backend", res.backend.Name)
+		r.markWinner(res.backend)
+		cancel()
+		r.cancelLosers(ctx, blockID, backends, res.backend)
+
+		res.proof.Tier = r.tier
+		return res.proof, nil
+	}
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	return nil, fmt.Errorf("all proof producer backends failed: %w", errors.Join(errs...))
+}
+
+// selectBackends selects the backends to request a proof from, sorted by their failure rates and
+// latencies. Every racingProbeInterval requests, the least recently selected backend of the ones left
+// out is selected as well.
+func (r *RacingProofProducer) selectBackends() []*racingBackend {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.requests++
+
+	backends := make([]*racingBackend, len(r.backends))
+	copy(backends, r.backends)
+
+	if r.fanout <= 0 || r.fanout >= len(backends) {
+		return backends
+	}
+
+	sort.SliceStable(backends, func(i, j int) bool {
+		if backends[i].stats.FailureRate != backends[j].stats.FailureRate {
+			return backends[i].stats.FailureRate < backends[j].stats.FailureRate
+		}
+		return backends[i].stats.Latency < backends[j].stats.Latency
+	})
+
+	selected := backends[:r.fanout:r.fanout]
+	if r.requests%racingProbeInterval == 0 {
+		probe := backends[r.fanout]
+		for _, backend := range backends[r.fanout+1:] {
+			if backend.lastSelected < probe.lastSelected {
+				probe = backend
+			}
+		}
+		log.Debug("Probe proof producer backend", "backend", probe.Name, "failureRate", probe.stats.FailureRate)
+		selected = append(selected, probe)
+	}
+	for _, backend := range selected {
+		backend.lastSelected = r.requests
+	}
+
+	return selected
+}
+
+// recordResult updates the statistics of the given backend with a finished proof request.
+func (r *RacingProofProducer) recordResult(backend *racingBackend, latency time.Duration, err error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	backend.stats.Requests++
+	backend.metrics.requests.Inc(1)
+	if err != nil {
+		backend.stats.Failures++
+		backend.metrics.failures.Inc(1)
+	} else if backend.stats.Latency == 0 {
+		backend.stats.Latency = latency
+	} else {
+		backend.stats.Latency = time.Duration(
+			latencyEMAWeight*float64(latency) + (1-latencyEMAWeight)*float64(backend.stats.Latency),
+		)
+	}
+	backend.stats.FailureRate = float64(backend.stats.Failures) / float64(backend.stats.Requests)
+
+	backend.metrics.latency.Update(backend.stats.Latency.Milliseconds())
+	backend.metrics.failureRate.Update(backend.stats.FailureRate)
+}
+
+// markWinner counts a won race of the given backend.
+func (r *RacingProofProducer) markWinner(backend *racingBackend) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	backend.stats.Wins++
+	backend.metrics.wins.Inc(1)
+}
+
+// cancelLosers cancels the proof generations of the backends losing the race, if they are cancellable.
+func (r *RacingProofProducer) cancelLosers(
+	ctx context.Context,
+	blockID *big.Int,
+	backends []*racingBackend,
+	winner *racingBackend,
+) {
+	for _, backend := range backends {
+		if backend == winner || !backend.Producer.Cancellable() {
+			continue
+		}
+		if err := backend.Producer.Cancel(ctx, blockID); err != nil {
+			log.Warn("Failed to cancel proof generation", "blockID", blockID, "backend", backend.Name, "error", err)
+		}
+	}
+}
+
+// Stats returns the statistics of all backends.
+func (r *RacingProofProducer) Stats() []RacingBackendStats {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	stats := make([]RacingBackendStats, 0, len(r.backends))
+	for _, backend := range r.backends {
+		stats = append(stats, backend.stats)
+	}
+
+	return stats
+}
+
+// Tier implements the ProofProducer interface.
+func (r *RacingProofProducer) Tier() uint16 {
+	return r.tier
+}
+
+// Cancellable implements the ProofProducer interface, an ongoing race can always be cancelled.
+func (r *RacingProofProducer) Cancellable() bool {
+	return true
+}
+
+// Cancel cancels an ongoing race, and the proof generations of the cancellable backends.
+func (r *RacingProofProducer) Cancel(ctx context.Context, blockID *big.Int) error {
+	r.mutex.Lock()
+	cancel, ok := r.races[blockID.Uint64()]
+	r.mutex.Unlock()
+
+	if ok {
+		cancel()
+	}
+
+	var errs []error
+	for _, backend := range r.backends {
+		if !backend.Producer.Cancellable() {
+			continue
+		}
+		if err := backend.Producer.Cancel(ctx, blockID); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", backend.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
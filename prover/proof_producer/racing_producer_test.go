@@ -0,0 +1,177 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+var errTestBackend = errors.New("test backend error")
+
+// testBackendProducer is a proof producer backend for tests, which returns a proof or an error after a delay.
+type testBackendProducer struct {
+	delay     time.Duration
+	err       error
+	tier      uint16
+	requested atomic.Bool
+	cancelled atomic.Bool
+}
+
+func (p *testBackendProducer) RequestProof(
+	ctx context.Context,
+	opts *ProofRequestOptions,
+	blockID *big.Int,
+	meta *bindings.TaikoDataBlockMetadata,
+	header *types.Header,
+) (*ProofWithHeader, error) {
+	p.requested.Store(true)
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(p.delay):
+	}
+
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return new(DummyProofProducer).RequestProof(ctx, opts, blockID, meta, header, p.tier)
+}
+
+func (p *testBackendProducer) Cancellable() bool { return true }
+
+func (p *testBackendProducer) Cancel(_ context.Context, _ *big.Int) error {
+	p.cancelled.Store(true)
+	return nil
+}
+
+func (p *testBackendProducer) Tier() uint16 { return p.tier }
+
+func requestRacingProof(r *RacingProofProducer) (*ProofWithHeader, error) {
+	return r.RequestProof(
+		context.Background(),
+		&ProofRequestOptions{},
+		common.Big1,
+		&bindings.TaikoDataBlockMetadata{},
+		&types.Header{},
+	)
+}
+
+func TestRacingProducerRequestProof(t *testing.T) {
+	var (
+		slow   = &testBackendProducer{delay: time.Second, tier: 100}
+		fast   = &testBackendProducer{delay: 10 * time.Millisecond, tier: 100}
+		failed = &testBackendProducer{err: errTestBackend, tier: 100}
+	)
+	r, err := NewRacingProofProducer([]*RacingBackend{
+		{Name: "slow", Producer: slow},
+		{Name: "fast", Producer: fast},
+		{Name: "failed", Producer: failed},
+	}, 0)
+	require.Nil(t, err)
+	require.Equal(t, uint16(100), r.Tier())
+
+	res, err := requestRacingProof(r)
+	require.Nil(t, err)
+	require.NotEmpty(t, res.Proof)
+	require.Equal(t, uint16(100), res.Tier)
+
+	// The losing backends are cancelled, the winner is not.
+	require.True(t, slow.cancelled.Load())
+	require.True(t, failed.cancelled.Load())
+	require.False(t, fast.cancelled.Load())
+
+	stats := r.Stats()
+	// The slow backend lost the race, which is not counted as a failure.
+	require.Zero(t, stats[0].Requests)
+	require.Equal(t, uint64(1), stats[1].Wins)
+	require.NotZero(t, stats[1].Latency)
+	require.Equal(t, 1.0, stats[2].FailureRate)
+}
+
+func TestRacingProducerAllBackendsFailed(t *testing.T) {
+	r, err := NewRacingProofProducer([]*RacingBackend{
+		{Name: "a", Producer: &testBackendProducer{err: errTestBackend}},
+		{Name: "b", Producer: &testBackendProducer{err: errTestBackend}},
+	}, 0)
+	require.Nil(t, err)
+
+	_, err = requestRacingProof(r)
+	require.ErrorIs(t, err, errTestBackend)
+	require.ErrorContains(t, err, "all proof producer backends failed")
+}
+
+func TestRacingProducerFanout(t *testing.T) {
+	var (
+		failed  = &testBackendProducer{err: errTestBackend}
+		healthy = &testBackendProducer{}
+	)
+	r, err := NewRacingProofProducer([]*RacingBackend{
+		{Name: "failed", Producer: failed},
+		{Name: "healthy", Producer: healthy},
+	}, 1)
+	require.Nil(t, err)
+
+	// Both backends have no statistics yet, so the first one is picked.
+	_, err = requestRacingProof(r)
+	require.ErrorIs(t, err, errTestBackend)
+	require.False(t, healthy.requested.Load())
+
+	// The failed backend is skipped afterwards.
+	_, err = requestRacingProof(r)
+	require.Nil(t, err)
+	require.True(t, healthy.requested.Load())
+}
+
+func TestRacingProducerProbe(t *testing.T) {
+	var (
+		failed  = &testBackendProducer{err: errTestBackend}
+		healthy = &testBackendProducer{}
+	)
+	r, err := NewRacingProofProducer([]*RacingBackend{
+		{Name: "failed", Producer: failed},
+		{Name: "healthy", Producer: healthy},
+	}, 1)
+	require.Nil(t, err)
+
+	_, err = requestRacingProof(r)
+	require.ErrorIs(t, err, errTestBackend)
+
+	// The failed backend recovers, but is left out until it's probed.
+	failed.err = nil
+	healthy.delay = 100 * time.Millisecond
+	for i := uint64(1); i < racingProbeInterval-1; i++ {
+		_, err = requestRacingProof(r)
+		require.Nil(t, err)
+	}
+	require.Equal(t, uint64(1), r.Stats()[0].Requests)
+
+	_, err = requestRacingProof(r)
+	require.Nil(t, err)
+
+	stats := r.Stats()
+	require.Equal(t, uint64(2), stats[0].Requests)
+	require.Equal(t, uint64(1), stats[0].Wins)
+	require.Equal(t, 0.5, stats[0].FailureRate)
+}
+
+func TestNewRacingProducerTierMismatch(t *testing.T) {
+	_, err := NewRacingProofProducer(nil, 0)
+	require.NotNil(t, err)
+
+	_, err = NewRacingProofProducer([]*RacingBackend{
+		{Name: "a", Producer: &testBackendProducer{tier: 100}},
+		{Name: "b", Producer: &testBackendProducer{tier: 200}},
+	}, 0)
+	require.ErrorContains(t, err, "tier mismatch")
+}
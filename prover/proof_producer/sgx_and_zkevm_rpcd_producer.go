@@ -2,6 +2,7 @@ package producer
 
 import (
 	"context"
+	"errors"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/core/types"
@@ -71,10 +72,13 @@ func (o *SGXAndZkevmRpcdProducer) Tier() uint16 {
 
 // Cancellable implements the ProofProducer interface.
 func (o *SGXAndZkevmRpcdProducer) Cancellable() bool {
-	return false
+	return true
 }
 
-// Cancel cancels an existing proof generation.
+// Cancel cancels both the SGX and PSE ZKEVM proof generations.
 func (o *SGXAndZkevmRpcdProducer) Cancel(ctx context.Context, blockID *big.Int) error {
-	return nil
+	return errors.Join(
+		o.SGXProofProducer.Cancel(ctx, blockID),
+		o.ZkevmRpcdProducer.Cancel(ctx, blockID),
+	)
 }
@@ -1,17 +1,9 @@
 package producer
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
-	"errors"
-	"fmt"
-	"io"
 	"math/big"
-	"net/http"
-	"time"
 
-	"github.com/cenkalti/backoff/v4"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
@@ -26,6 +18,7 @@ type SGXProofProducer struct {
 	RaikoHostEndpoint string // a proverd RPC endpoint
 	L1Endpoint        string // a L1 node RPC endpoint
 	L2Endpoint        string // a L2 execution engine's RPC endpoint
+	tasks             *proofTaskClient
 	*DummyProofProducer
 }
 
@@ -47,33 +40,25 @@ type SGXRequestProofBodyParam struct {
 	Graffiti string   `json:"graffiti"`
 }
 
-// SGXRequestProofBodyResponse represents the JSON body of the response of the proof requests.
-type SGXRequestProofBodyResponse struct {
-	JsonRPC string           `json:"jsonrpc"` //nolint:revive,stylecheck
-	ID      *big.Int         `json:"id"`
-	Result  *RaikoHostOutput `json:"result"`
-	Error   *struct {
-		Code    *big.Int `json:"code"`
-		Message string   `json:"message"`
-	} `json:"error,omitempty"`
-}
-
-// RaikoHostOutput represents the JSON body of SGXRequestProofBodyResponse's `result` field.
+// RaikoHostOutput represents the JSON body of the `output` field of a succeeded proof task.
 type RaikoHostOutput struct {
 	Type  string `json:"type"`
 	Proof string `json:"proof"`
 }
 
-// NewSGXProducer creates a new `SGXProofProducer` instance.
+// NewSGXProducer creates a new `SGXProofProducer` instance, the proof tasks are persisted in the given
+// store, if it's not nil.
 func NewSGXProducer(
 	raikoHostEndpoint string,
 	l1Endpoint string,
 	l2Endpoint string,
+	taskStore ProofTaskStore,
 ) (*SGXProofProducer, error) {
 	return &SGXProofProducer{
 		RaikoHostEndpoint: raikoHostEndpoint,
 		L1Endpoint:        l1Endpoint,
 		L2Endpoint:        l2Endpoint,
+		tasks:             newProofTaskClient(raikoHostEndpoint, "SGXProofProducer", taskStore),
 	}, nil
 }
 
@@ -115,55 +100,12 @@ func (s *SGXProofProducer) RequestProof(
 	}, nil
 }
 
-// callProverDaemon keeps polling the proverd service to get the requested proof.
+// callProverDaemon submits a proof task to the raiko host, and keeps polling it to get the requested proof.
 func (s *SGXProofProducer) callProverDaemon(ctx context.Context, opts *ProofRequestOptions) ([]byte, error) {
-	var (
-		proof []byte
-		start = time.Now()
-	)
-	if err := backoff.Retry(func() error {
-		if ctx.Err() != nil {
-			return nil
-		}
-		output, err := s.requestProof(opts)
-		if err != nil {
-			log.Error("Failed to request proof", "height", opts.BlockID, "err", err, "endpoint", s.RaikoHostEndpoint)
-			return err
-		}
-
-		if output == nil {
-			log.Info(
-				"Proof generating",
-				"height", opts.BlockID,
-				"time", time.Since(start),
-				"producer", "SGXProofProducer",
-			)
-			return errProofGenerating
-		}
-
-		log.Debug("Proof generation output", "output", output)
-
-		proof = common.Hex2Bytes(output.Proof[2:])
-		log.Info(
-			"Proof generated",
-			"height", opts.BlockID,
-			"time", time.Since(start),
-			"producer", "SGXProofProducer",
-		)
-		return nil
-	}, backoff.NewConstantBackOff(proofPollingInterval)); err != nil {
-		return nil, err
-	}
-
-	return proof, nil
-}
-
-// requestProof sends a RPC request to proverd to try to get the requested proof.
-func (s *SGXProofProducer) requestProof(opts *ProofRequestOptions) (*RaikoHostOutput, error) {
 	reqBody := SGXRequestProofBody{
 		JsonRPC: "2.0",
 		ID:      common.Big1,
-		Method:  "proof",
+		Method:  ProofTaskSubmitMethod,
 		Params: []*SGXRequestProofBodyParam{{
 			Type:     "Sgx",
 			Block:    opts.BlockID,
@@ -174,36 +116,14 @@ func (s *SGXProofProducer) requestProof(opts *ProofRequestOptions) (*RaikoHostOu
 		}},
 	}
 
-	jsonValue, err := json.Marshal(reqBody)
-	if err != nil {
+	var output RaikoHostOutput
+	if err := s.tasks.generate(ctx, opts.BlockID, opts.BlockHash, reqBody, &output); err != nil {
 		return nil, err
 	}
 
-	res, err := http.Post(s.RaikoHostEndpoint, "application/json", bytes.NewBuffer(jsonValue))
-	if err != nil {
-		return nil, err
-	}
+	log.Debug("Proof generation output", "output", output)
 
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to request proof, id: %d, statusCode: %d", opts.BlockID, res.StatusCode)
-	}
-
-	resBytes, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	var output SGXRequestProofBodyResponse
-	if err := json.Unmarshal(resBytes, &output); err != nil {
-		return nil, err
-	}
-
-	if output.Error != nil {
-		return nil, errors.New(output.Error.Message)
-	}
-
-	return output.Result, nil
+	return common.FromHex(output.Proof), nil
 }
 
 // Tier implements the ProofProducer interface.
@@ -213,12 +133,14 @@ func (s *SGXProofProducer) Tier() uint16 {
 
 // Cancellable implements the ProofProducer interface.
 func (s *SGXProofProducer) Cancellable() bool {
-	return false
+	return true
 }
 
-// Cancel cancels an existing proof generation.
-//
-//nolint:golint
+// Cancel cancels an existing proof generation, and its task in the raiko host.
 func (s *SGXProofProducer) Cancel(ctx context.Context, blockID *big.Int) error {
-	return nil
+	if s.tasks == nil {
+		return nil
+	}
+
+	return s.tasks.cancel(ctx, blockID)
 }
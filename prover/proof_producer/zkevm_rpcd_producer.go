@@ -1,17 +1,11 @@
 package producer
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
-	"io"
 	"math/big"
-	"net/http"
 	"time"
 
-	"github.com/cenkalti/backoff/v4"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
@@ -36,6 +30,7 @@ type ZkevmRpcdProducer struct {
 	ProofTimeTarget     uint64                         // used for calculating proof delay
 	ProtocolConfig      *bindings.TaikoDataConfig      // protocol configurations
 	CustomProofHook     func() ([]byte, uint64, error) // only for testing purposes
+	tasks               *proofTaskClient               // remote proof tasks
 	*DummyProofProducer                                // only for testing purposes
 }
 
@@ -100,18 +95,7 @@ type ProtocolInstance struct {
 	RequestMetaData         *RequestMetaData `json:"request_meta_data"`
 }
 
-// RequestProofBodyResponse represents the JSON body of the response of the proof requests.
-type RequestProofBodyResponse struct {
-	JsonRPC string      `json:"jsonrpc"` //nolint:revive,stylecheck
-	ID      *big.Int    `json:"id"`
-	Result  *RpcdOutput `json:"result"`
-	Error   *struct {
-		Code    *big.Int `json:"code"`
-		Message string   `json:"message"`
-	} `json:"error,omitempty"`
-}
-
-// RpcdOutput represents the JSON body of RequestProofBodyResponse's `result` field.
+// RpcdOutput represents the JSON body of the `output` field of a succeeded proof task.
 type RpcdOutput struct {
 	Circuit struct {
 		Instances []string `json:"instance"`
@@ -125,7 +109,8 @@ type RpcdOutput struct {
 	} `json:"aggregation"`
 }
 
-// NewZkevmRpcdProducer creates a new `ZkevmRpcdProducer` instance.
+// NewZkevmRpcdProducer creates a new `ZkevmRpcdProducer` instance, the proof tasks are persisted in
+// the given store, if it's not nil.
 func NewZkevmRpcdProducer(
 	rpcdEndpoint string,
 	param string,
@@ -133,6 +118,7 @@ func NewZkevmRpcdProducer(
 	l2Endpoint string,
 	retry bool,
 	protocolConfig *bindings.TaikoDataConfig,
+	taskStore ProofTaskStore,
 ) (*ZkevmRpcdProducer, error) {
 	return &ZkevmRpcdProducer{
 		RpcdEndpoint:   rpcdEndpoint,
@@ -141,6 +127,7 @@ func NewZkevmRpcdProducer(
 		L2Endpoint:     l2Endpoint,
 		Retry:          retry,
 		ProtocolConfig: protocolConfig,
+		tasks:          newProofTaskClient(rpcdEndpoint, "ZkevmRpcdProducer", taskStore),
 	}, nil
 }
 
@@ -195,71 +182,41 @@ func (p *ZkevmRpcdProducer) RequestProof(
 	}, nil
 }
 
-// callProverDaemon keeps polling the proverd service to get the requested proof.
+// callProverDaemon submits a proof task to the proverd service, and keeps polling it to get the
+// requested proof.
 func (p *ZkevmRpcdProducer) callProverDaemon(
 	ctx context.Context,
 	opts *ProofRequestOptions,
 	meta *bindings.TaikoDataBlockMetadata,
 ) ([]byte, uint64, error) {
 	var (
-		proof  []byte
-		degree uint64
-		start  = time.Now()
+		reqBody = p.newRequestProofBody(opts, meta)
+		output  RpcdOutput
 	)
-	if err := backoff.Retry(func() error {
-		if ctx.Err() != nil {
-			return nil
-		}
-		output, err := p.requestProof(opts, meta)
-		if err != nil {
-			log.Error("Failed to request proof", "height", opts.BlockID, "err", err, "endpoint", p.RpcdEndpoint)
-			return err
-		}
-
-		if output == nil {
-			log.Info(
-				"Proof generating",
-				"height", opts.BlockID,
-				"time", time.Since(start),
-				"producer", "ZkevmRpcdProducer",
-			)
-			return errProofGenerating
-		}
-
-		log.Debug("Proof generation output", "output", output)
+	if err := p.tasks.generate(ctx, opts.BlockID, opts.BlockHash, reqBody, &output); err != nil {
+		return nil, 0, err
+	}
 
-		var proofOutput string
-		for _, instance := range output.Aggregation.Instances {
-			proofOutput += instance[2:]
-		}
-		proofOutput += output.Aggregation.Proof[2:]
+	log.Debug("Proof generation output", "output", output)
 
-		proof = common.Hex2Bytes(proofOutput)
-		degree = output.Aggregation.Degree
-		log.Info(
-			"Proof generated",
-			"height", opts.BlockID,
-			"degree", degree,
-			"time", time.Since(start),
-			"producer", "ZkevmRpcdProducer",
-		)
-		return nil
-	}, backoff.NewConstantBackOff(proofPollingInterval)); err != nil {
-		return nil, 0, err
+	var proofOutput string
+	for _, instance := range output.Aggregation.Instances {
+		proofOutput += instance[2:]
 	}
+	proofOutput += output.Aggregation.Proof[2:]
 
-	return proof, degree, nil
+	return common.Hex2Bytes(proofOutput), output.Aggregation.Degree, nil
 }
 
-// requestProof sends a RPC request to proverd to try to get the requested proof.
-func (p *ZkevmRpcdProducer) requestProof(
+// newRequestProofBody builds the JSON body for submitting a proof task to proverd.
+func (p *ZkevmRpcdProducer) newRequestProofBody(
 	opts *ProofRequestOptions,
 	meta *bindings.TaikoDataBlockMetadata,
-) (*RpcdOutput, error) {
-	reqBody := RequestProofBody{
+) *RequestProofBody {
+	return &RequestProofBody{
 		JsonRPC: "2.0",
 		ID:      common.Big1,
-		Method:  "proof",
+		Method:  ProofTaskSubmitMethod,
 		Params: []*RequestProofBodyParam{{
 			Circuit:      "super",
 			Block:        opts.BlockID,
@@ -306,37 +263,6 @@ func (p *ZkevmRpcdProducer) requestProof(
 			},
 		}},
 	}
-
-	jsonValue, err := json.Marshal(reqBody)
-	if err != nil {
-		return nil, err
-	}
-
-	res, err := http.Post(p.RpcdEndpoint, "application/json", bytes.NewBuffer(jsonValue))
-	if err != nil {
-		return nil, err
-	}
-
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to request proof, id: %d, statusCode: %d", opts.BlockID, res.StatusCode)
-	}
-
-	resBytes, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	var output RequestProofBodyResponse
-	if err := json.Unmarshal(resBytes, &output); err != nil {
-		return nil, err
-	}
-
-	if output.Error != nil {
-		return nil, errors.New(output.Error.Message)
-	}
-
-	return output.Result, nil
 }
 
 // Tier implements the ProofProducer interface.
@@ -346,13 +272,14 @@ func (p *ZkevmRpcdProducer) Tier() uint16 {
 
 // Cancellable implements the ProofProducer interface.
 func (p *ZkevmRpcdProducer) Cancellable() bool {
-	return false
+	return true
 }
 
-// Cancel cancels an existing proof generation.
-// Right now, it is just a stub that does nothing, because it is not possible to cancel the proof
-// with the current zkevm software.
+// Cancel cancels an existing proof generation, and its task in proverd.
 func (p *ZkevmRpcdProducer) Cancel(ctx context.Context, blockID *big.Int) error {
-	log.Info("Cancel proof generation for block", "blockId", blockID)
-	return nil
+	if p.tasks == nil {
+		return nil
+	}
+
+	return p.tasks.cancel(ctx, blockID)
 }
@@ -20,9 +20,10 @@ func TestNewZkevmRpcdProducer(t *testing.T) {
 		"",
 		false,
 		&bindings.TaikoDataConfig{},
+		nil,
 	)
 	require.Nil(t, err)
-	require.False(t, dummyZkevmRpcdProducer.Cancellable())
+	require.True(t, dummyZkevmRpcdProducer.Cancellable())
 
 	dummyZkevmRpcdProducer.CustomProofHook = func() ([]byte, uint64, error) {
 		return []byte{0}, CircuitsIdx, nil
@@ -63,8 +64,16 @@ func TestNewZkevmRpcdProducer(t *testing.T) {
 }
 
 func TestZkevmRpcdProducerCalls(t *testing.T) {
+	setTestProofPollingInterval(t)
+
+	output := new(RpcdOutput)
+	output.Aggregation.Instances = []string{"0x01"}
+	output.Aggregation.Proof = "0x02"
+	output.Aggregation.Degree = 1
+	server := newTestProofTaskServer(t, 2, output)
+
 	dummyZkevmRpcdProducer, err := NewZkevmRpcdProducer(
-		"",
+		server.URL,
 		"",
 		"",
 		"",
@@ -73,21 +82,19 @@ func TestZkevmRpcdProducerCalls(t *testing.T) {
 			BlockMaxGasLimit:    uint32(randHash().Big().Uint64()),
 			BlockMaxTxListBytes: randHash().Big(),
 		},
+		nil,
 	)
 	require.Nil(t, err)
-	require.False(t, dummyZkevmRpcdProducer.Cancellable())
-
-	dummyZkevmRpcdProducer.CustomProofHook = func() ([]byte, uint64, error) {
-		return []byte{0}, CircuitsIdx, nil
-	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
-	_, _, err = dummyZkevmRpcdProducer.callProverDaemon(
+	proof, degree, err := dummyZkevmRpcdProducer.callProverDaemon(
 		ctx,
 		&ProofRequestOptions{BlockID: common.Big32},
 		&bindings.TaikoDataBlockMetadata{},
 	)
 
 	require.Nil(t, err)
+	require.Equal(t, []byte{0x01, 0x02}, proof)
+	require.Equal(t, uint64(1), degree)
 }
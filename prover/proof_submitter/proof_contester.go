@@ -2,7 +2,6 @@ package submitter
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"math/big"
@@ -16,6 +15,7 @@ import (
 	"github.com/taikoxyz/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
 	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
 	"github.com/taikoxyz/taiko-client/prover/proof_submitter/transaction"
 )
@@ -34,7 +34,7 @@ type ProofContester struct {
 // NewProofContester creates a new ProofContester instance.
 func NewProofContester(
 	rpcClient *rpc.Client,
-	proverPrivKey *ecdsa.PrivateKey,
+	proverSigner signer.Signer,
 	proveBlockTxGasLimit *uint64,
 	txReplacementTipMultiplier uint64,
 	proveBlockMaxTxGasTipCap *big.Int,
@@ -61,7 +61,7 @@ func NewProofContester(
 		rpc: rpcClient,
 		txBuilder: transaction.NewProveBlockTxBuilder(
 			rpcClient,
-			proverPrivKey,
+			proverSigner,
 			txGasLimit,
 			proveBlockMaxTxGasTipCap,
 			new(big.Int).SetUint64(txReplacementTipMultiplier),
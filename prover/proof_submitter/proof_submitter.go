@@ -2,7 +2,6 @@ package submitter
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"math/big"
@@ -10,13 +9,13 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/taikoxyz/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-client/internal/metrics"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
 	validator "github.com/taikoxyz/taiko-client/prover/anchor_tx_validator"
 	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
 	"github.com/taikoxyz/taiko-client/prover/proof_submitter/transaction"
@@ -38,6 +37,8 @@ type ProofSubmitter struct {
 	l1SignalService common.Address
 	l2SignalService common.Address
 	graffiti        [32]byte
+	// Whether to simulate the proof submission against the latest L1 state before sending it.
+	verifyLocally bool
 }
 
 // New creates a new ProofSubmitter instance.
@@ -46,7 +47,7 @@ func New(
 	proofProducer proofProducer.ProofProducer,
 	resultCh chan *proofProducer.ProofWithHeader,
 	taikoL2Address common.Address,
-	proverPrivKey *ecdsa.PrivateKey,
+	proverSigner signer.Signer,
 	graffiti string,
 	submissionMaxRetry uint64,
 	retryInterval time.Duration,
@@ -54,6 +55,7 @@ func New(
 	proveBlockTxGasLimit *uint64,
 	txReplacementTipMultiplier uint64,
 	proveBlockMaxTxGasTipCap *big.Int,
+	verifyLocally bool,
 ) (*ProofSubmitter, error) {
 	anchorValidator, err := validator.New(taikoL2Address, rpcClient.L2ChainID, rpcClient)
 	if err != nil {
@@ -92,17 +94,18 @@ func New(
 		anchorValidator: anchorValidator,
 		txBuilder: transaction.NewProveBlockTxBuilder(
 			rpcClient,
-			proverPrivKey,
+			proverSigner,
 			txGasLimit,
 			proveBlockMaxTxGasTipCap,
 			new(big.Int).SetUint64(txReplacementTipMultiplier),
 		),
 		txSender:        transaction.NewSender(rpcClient, retryInterval, maxRetry, waitReceiptTimeout),
-		proverAddress:   crypto.PubkeyToAddress(proverPrivKey.PublicKey),
+		proverAddress:   proverSigner.Address(),
 		l1SignalService: l1SignalService,
 		l2SignalService: l2SignalService,
 		taikoL2Address:  taikoL2Address,
 		graffiti:        rpc.StringToBytes32(graffiti),
+		verifyLocally:   verifyLocally,
 	}, nil
 }
 
@@ -211,21 +214,36 @@ func (s *ProofSubmitter) SubmitProof(
 		return fmt.Errorf("failed to fetch anchor transaction receipt: %w", err)
 	}
 
-	txBuilder := s.txBuilder.Build(
-		ctx,
-		proofWithHeader.BlockID,
-		proofWithHeader.Meta,
-		&bindings.TaikoDataTransition{
+	var (
+		transition = &bindings.TaikoDataTransition{
 			ParentHash: proofWithHeader.Header.ParentHash,
 			BlockHash:  proofWithHeader.Opts.BlockHash,
 			SignalRoot: proofWithHeader.Opts.SignalRoot,
 			Graffiti:   s.graffiti,
-		},
-		&bindings.TaikoDataTierProof{
+		}
+		tierProof = &bindings.TaikoDataTierProof{
 			Tier: proofWithHeader.Tier,
 			Data: proofWithHeader.Proof,
-		},
-		proofWithHeader.Tier == encoding.TierGuardianID,
+		}
+		guardian = proofWithHeader.Tier == encoding.TierGuardianID
+	)
+
+	if s.verifyLocally {
+		if err := s.verifyProof(ctx, proofWithHeader, transition, tierProof, guardian); err != nil {
+			if errors.Is(err, transaction.ErrUnretryable) {
+				return nil
+			}
+			return err
+		}
+	}
+
+	txBuilder := s.txBuilder.Build(
+		ctx,
+		proofWithHeader.BlockID,
+		proofWithHeader.Meta,
+		transition,
+		tierProof,
+		guardian,
 	)
 
 	if err := s.txSender.Send(ctx, proofWithHeader, txBuilder); err != nil {
@@ -243,6 +261,43 @@ func (s *ProofSubmitter) SubmitProof(
 	return nil
 }
 
+// verifyProof simulates the proof submission against the latest L1 state, a deterministic failure
+// returns an `ErrUnretryable` error, otherwise the proof should be submitted again later.
+func (s *ProofSubmitter) verifyProof(
+	ctx context.Context,
+	proofWithHeader *proofProducer.ProofWithHeader,
+	transition *bindings.TaikoDataTransition,
+	tierProof *bindings.TaikoDataTierProof,
+	guardian bool,
+) error {
+	err := s.txBuilder.Simulate(ctx, proofWithHeader.BlockID, proofWithHeader.Meta, transition, tierProof, guardian)
+	if err == nil {
+		return nil
+	}
+
+	err = encoding.TryParsingCustomError(err)
+	if transaction.IsDeterministicProofError(err) {
+		log.Error(
+			"Proof failed local verification, skip submitting",
+			"blockID", proofWithHeader.BlockID,
+			"tier", proofWithHeader.Tier,
+			"error", err,
+		)
+		metrics.ProverLocalVerifyFailedCounter.Inc(1)
+		return transaction.ErrUnretryable
+	}
+
+	log.Warn(
+		"Proof failed local verification with the current L1 state, requeue it",
+		"blockID", proofWithHeader.BlockID,
+		"tier", proofWithHeader.Tier,
+		"error", err,
+	)
+	metrics.ProverLocalVerifyRequeueCounter.Inc(1)
+
+	return fmt.Errorf("failed to verify proof locally: %w", err)
+}
+
 // Producer returns the inner proof producer.
 func (s *ProofSubmitter) Producer() proofProducer.ProofProducer {
 	return s.proofProducer
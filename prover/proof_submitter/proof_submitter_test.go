@@ -19,6 +19,7 @@ import (
 	"github.com/taikoxyz/taiko-client/driver/state"
 	"github.com/taikoxyz/taiko-client/internal/testutils"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
 	"github.com/taikoxyz/taiko-client/proposer"
 	producer "github.com/taikoxyz/taiko-client/prover/proof_producer"
 )
@@ -45,7 +46,7 @@ func (s *ProofSubmitterTestSuite) SetupTest() {
 		&producer.OptimisticProofProducer{},
 		s.proofCh,
 		common.HexToAddress(os.Getenv("TAIKO_L2_ADDRESS")),
-		l1ProverPrivKey,
+		signer.NewLocalSigner(l1ProverPrivKey),
 		"test",
 		1,
 		12*time.Second,
@@ -53,11 +54,12 @@ func (s *ProofSubmitterTestSuite) SetupTest() {
 		nil,
 		2,
 		nil,
+		true,
 	)
 	s.Nil(err)
 	s.contester, err = NewProofContester(
 		s.RPCClient,
-		l1ProverPrivKey,
+		signer.NewLocalSigner(l1ProverPrivKey),
 		nil,
 		2,
 		common.Big256,
@@ -80,6 +82,8 @@ func (s *ProofSubmitterTestSuite) SetupTest() {
 		testState,
 		tracker,
 		common.HexToAddress(os.Getenv("L1_SIGNAL_SERVICE_CONTRACT_ADDRESS")),
+		nil,
+		nil,
 	)
 	s.Nil(err)
 
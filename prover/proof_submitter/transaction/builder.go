@@ -2,19 +2,18 @@ package transaction
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"math/big"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/taikoxyz/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
 )
 
 // TxBuilder will build a transaction with the given nonce.
@@ -23,7 +22,7 @@ type TxBuilder func(nonce *big.Int) (*types.Transaction, error)
 // ProveBlockTxBuilder is responsible for building ProveBlock transactions.
 type ProveBlockTxBuilder struct {
 	rpc              *rpc.Client
-	proverPrivateKey *ecdsa.PrivateKey
+	proverSigner     signer.Signer
 	proverAddress    common.Address
 	gasLimit         *big.Int
 	gasTipCap        *big.Int
@@ -34,15 +33,15 @@ type ProveBlockTxBuilder struct {
 // NewProveBlockTxBuilder creates a new ProveBlockTxBuilder instance.
 func NewProveBlockTxBuilder(
 	rpc *rpc.Client,
-	proverPrivateKey *ecdsa.PrivateKey,
+	proverSigner signer.Signer,
 	gasLimit *big.Int,
 	gasTipCap *big.Int,
 	gasTipMultiplier *big.Int,
 ) *ProveBlockTxBuilder {
 	return &ProveBlockTxBuilder{
 		rpc:              rpc,
-		proverPrivateKey: proverPrivateKey,
-		proverAddress:    crypto.PubkeyToAddress(proverPrivateKey.PublicKey),
+		proverSigner:     proverSigner,
+		proverAddress:    proverSigner.Address(),
 		gasLimit:         gasLimit,
 		gasTipCap:        gasTipCap,
 		gasTipMultiplier: gasTipMultiplier,
@@ -63,7 +62,7 @@ func (a *ProveBlockTxBuilder) Build(
 		a.mutex.Lock()
 		defer a.mutex.Unlock()
 
-		txOpts, err := getProveBlocksTxOpts(ctx, a.rpc.L1, a.rpc.L1ChainID, a.proverPrivateKey)
+		txOpts, err := getProveBlocksTxOpts(ctx, a.rpc.L1, a.rpc.L1ChainID, a.proverSigner)
 		if err != nil {
 			return nil, err
 		}
@@ -109,15 +108,45 @@ func (a *ProveBlockTxBuilder) Build(
 	}
 }
 
-// getProveBlocksTxOpts creates a bind.TransactOpts instance using the given private key.
+// Simulate simulates the TaikoL1.ProveBlock transaction built by Build with the same calldata, via an
+// `eth_call` against the latest L1 state, without sending it.
+func (a *ProveBlockTxBuilder) Simulate(
+	ctx context.Context,
+	blockID *big.Int,
+	meta *bindings.TaikoDataBlockMetadata,
+	transition *bindings.TaikoDataTransition,
+	tierProof *bindings.TaikoDataTierProof,
+	guardian bool,
+) error {
+	opts := &bind.CallOpts{Context: ctx, From: a.proverAddress}
+
+	if !guardian {
+		input, err := encoding.EncodeProveBlockInput(meta, transition, tierProof)
+		if err != nil {
+			return err
+		}
+		return (&bindings.TaikoL1ClientRaw{Contract: a.rpc.TaikoL1}).Call(opts, nil, "proveBlock", blockID.Uint64(), input)
+	}
+
+	return (&bindings.GuardianProverRaw{Contract: a.rpc.GuardianProver}).Call(
+		opts,
+		nil,
+		"approve",
+		*meta,
+		*transition,
+		*tierProof,
+	)
+}
+
+// getProveBlocksTxOpts creates a bind.TransactOpts instance using the given signer.
 // Used for creating TaikoL1.proveBlock and TaikoL1.proveBlockInvalid transactions.
 func getProveBlocksTxOpts(
 	ctx context.Context,
 	cli *rpc.EthClient,
 	chainID *big.Int,
-	proverPrivKey *ecdsa.PrivateKey,
+	proverSigner signer.Signer,
 ) (*bind.TransactOpts, error) {
-	opts, err := bind.NewKeyedTransactorWithChainID(proverPrivKey, chainID)
+	opts, err := signer.NewTransactor(ctx, proverSigner, chainID)
 	if err != nil {
 		return nil, err
 	}
@@ -6,14 +6,16 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
 )
 
 func (s *TransactionTestSuite) TestGetProveBlocksTxOpts() {
-	optsL1, err := getProveBlocksTxOpts(context.Background(), s.RPCClient.L1, s.RPCClient.L1ChainID, s.TestAddrPrivKey)
+	testSigner := signer.NewLocalSigner(s.TestAddrPrivKey)
+	optsL1, err := getProveBlocksTxOpts(context.Background(), s.RPCClient.L1, s.RPCClient.L1ChainID, testSigner)
 	s.Nil(err)
 	s.Greater(optsL1.GasTipCap.Uint64(), uint64(0))
 
-	optsL2, err := getProveBlocksTxOpts(context.Background(), s.RPCClient.L2, s.RPCClient.L2ChainID, s.TestAddrPrivKey)
+	optsL2, err := getProveBlocksTxOpts(context.Background(), s.RPCClient.L2, s.RPCClient.L2ChainID, testSigner)
 	s.Nil(err)
 	s.Greater(optsL2.GasTipCap.Uint64(), uint64(0))
 }
@@ -39,3 +41,14 @@ func (s *TransactionTestSuite) TestBuildTxs() {
 	)(common.Big256)
 	s.NotNil(err)
 }
+
+func (s *TransactionTestSuite) TestSimulate() {
+	s.NotNil(s.builder.Simulate(
+		context.Background(),
+		common.Big256,
+		&bindings.TaikoDataBlockMetadata{},
+		&bindings.TaikoDataTransition{},
+		&bindings.TaikoDataTierProof{},
+		false,
+	))
+}
@@ -21,6 +21,20 @@ import (
 
 var (
 	ErrUnretryable = errors.New("unretryable")
+	// Errors reverted by TaikoL1.proveBlock which will always be reverted again for the same proof, since
+	// they are caused by the proof itself, or the transition has already been proven or contested.
+	deterministicProofErrors = map[string]struct{}{
+		"PROVING_FAILED":        {},
+		"L1_INVALID_PROOF":      {},
+		"L1_INVALID_TIER":       {},
+		"L1_INVALID_TRANSITION": {},
+		"L1_INVALID_PARAM":      {},
+		"L1_BLOCK_MISMATCH":     {},
+		"L1_INVALID_BLOCK_ID":   {},
+		"L1_TRANSITION_ID_ZERO": {},
+		"L1_ALREADY_PROVED":     {},
+		"L1_ALREADY_CONTESTED":  {},
+	}
 )
 
 // Sender is responsible for sending proof submission transactions with a backoff policy, if
@@ -186,6 +200,13 @@ func (s *Sender) validateProof(ctx context.Context, proofWithHeader *producer.Pr
 	return true, nil
 }
 
+// IsDeterministicProofError checks whether the given error reverted by a TaikoL1.proveBlock simulation
+// is deterministic, if not, the proof may be accepted later with a different L1 state.
+func IsDeterministicProofError(err error) bool {
+	_, ok := deterministicProofErrors[err.Error()]
+	return ok
+}
+
 // isSubmitProofTxErrorRetryable checks whether the error returned by a proof submission transaction
 // is retryable.
 func isSubmitProofTxErrorRetryable(err error, blockID *big.Int) bool {
@@ -15,6 +15,7 @@ import (
 
 	"github.com/taikoxyz/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-client/internal/testutils"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
 	producer "github.com/taikoxyz/taiko-client/prover/proof_producer"
 )
 
@@ -36,7 +37,7 @@ func (s *TransactionTestSuite) SetupTest() {
 	s.Nil(err)
 
 	s.sender = NewSender(s.RPCClient, 5*time.Second, nil, 1*time.Minute)
-	s.builder = NewProveBlockTxBuilder(s.RPCClient, l1ProverPrivKey, nil, common.Big256, common.Big2)
+	s.builder = NewProveBlockTxBuilder(s.RPCClient, signer.NewLocalSigner(l1ProverPrivKey), nil, common.Big256, common.Big2)
 }
 
 func (s *TransactionTestSuite) TestIsSubmitProofTxErrorRetryable() {
@@ -46,6 +47,13 @@ func (s *TransactionTestSuite) TestIsSubmitProofTxErrorRetryable() {
 	s.False(isSubmitProofTxErrorRetryable(errors.New("L1_"+testAddr.String()), common.Big0))
 }
 
+func (s *TransactionTestSuite) TestIsDeterministicProofError() {
+	s.True(IsDeterministicProofError(errors.New("L1_INVALID_PROOF")))
+	s.True(IsDeterministicProofError(errors.New("PROVING_FAILED")))
+	s.False(IsDeterministicProofError(errors.New("L1_PROVING_PAUSED")))
+	s.False(IsDeterministicProofError(errors.New(testAddr.String())))
+}
+
 func (s *TransactionTestSuite) TestSendTxWithBackoff() {
 	l1Head, err := s.RPCClient.L1.HeaderByNumber(context.Background(), nil)
 	s.Nil(err)
@@ -2,7 +2,6 @@ package prover
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"math/big"
@@ -15,7 +14,6 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/ethdb/leveldb"
 	"github.com/ethereum/go-ethereum/log"
@@ -27,7 +25,14 @@ import (
 	"github.com/taikoxyz/taiko-client/internal/version"
 	eventIterator "github.com/taikoxyz/taiko-client/pkg/chain_iterator/event_iterator"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
+	txListValidator "github.com/taikoxyz/taiko-client/pkg/txlistvalidator"
+	bond "github.com/taikoxyz/taiko-client/prover/bond_manager"
+	contest "github.com/taikoxyz/taiko-client/prover/contest_strategy"
+	"github.com/taikoxyz/taiko-client/prover/db"
 	guardianproversender "github.com/taikoxyz/taiko-client/prover/guardian_prover_sender"
+	"github.com/taikoxyz/taiko-client/prover/ledger"
+	"github.com/taikoxyz/taiko-client/prover/pricing"
 	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
 	proofSubmitter "github.com/taikoxyz/taiko-client/prover/proof_submitter"
 	"github.com/taikoxyz/taiko-client/prover/server"
@@ -41,9 +46,9 @@ var (
 // Prover keep trying to prove new proposed blocks valid/invalid.
 type Prover struct {
 	// Configurations
-	cfg              *Config
-	proverAddress    common.Address
-	proverPrivateKey *ecdsa.PrivateKey
+	cfg           *Config
+	proverAddress common.Address
+	proverSigner  signer.Signer
 
 	// Clients
 	rpc *rpc.Client
@@ -57,6 +62,9 @@ type Prover struct {
 	// Contract configurations
 	protocolConfigs *bindings.TaikoDataConfig
 
+	// Transactions list validator, the same as the driver's
+	txListValidator *txListValidator.TxListValidator
+
 	// States
 	latestVerifiedL1Height uint64
 	lastHandledBlockID     uint64
@@ -75,11 +83,25 @@ type Prover struct {
 
 	// Proof related
 	proofGenerationCh chan *proofProducer.ProofWithHeader
+	proofJobs         *db.ProofJobStore
+	proofTasks        proofProducer.ProofTaskStore
 
 	// Concurrency guards
 	proposeConcurrencyGuard     chan struct{}
 	submitProofConcurrencyGuard chan struct{}
 
+	// Tier fee pricing engine
+	tierFeePricer *pricing.Pricer
+
+	// Bond and TaikoToken balance manager
+	bondManager *bond.Manager
+
+	// Profitability ledger
+	ledger *ledger.Ledger
+
+	// Contest strategy engine
+	contestStrategy *contest.Engine
+
 	ctx context.Context
 	wg  sync.WaitGroup
 }
@@ -98,12 +120,18 @@ func (p *Prover) InitFromCli(ctx context.Context, c *cli.Context) error {
 func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 	p.cfg = cfg
 	p.ctx = ctx
-	p.proverPrivateKey = cfg.L1ProverPrivKey
+	if p.proverSigner = cfg.L1ProverSigner; p.proverSigner == nil {
+		if p.proverSigner, err = signer.New(&signer.Config{PrivateKey: cfg.L1ProverPrivKey}); err != nil {
+			return fmt.Errorf("invalid L1 prover signer: %w", err)
+		}
+	}
 
 	// Clients
 	if p.rpc, err = rpc.NewClient(p.ctx, &rpc.ClientConfig{
 		L1Endpoint:            cfg.L1WsEndpoint,
+		L1FallbackEndpoints:   cfg.L1FallbackEndpoints,
 		L2Endpoint:            cfg.L2WsEndpoint,
+		L2FallbackEndpoints:   cfg.L2FallbackEndpoints,
 		TaikoL1Address:        cfg.TaikoL1Address,
 		TaikoL2Address:        cfg.TaikoL2Address,
 		TaikoTokenAddress:     cfg.TaikoTokenAddress,
@@ -111,6 +139,7 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 		RetryInterval:         cfg.BackOffRetryInterval,
 		Timeout:               cfg.RPCTimeout,
 		BackOffMaxRetries:     cfg.BackOffMaxRetrys,
+		HealthCheck:           cfg.RPCHealthCheck,
 	}); err != nil {
 		return err
 	}
@@ -124,6 +153,13 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 
 	log.Info("Protocol configs", "configs", p.protocolConfigs)
 
+	p.txListValidator = txListValidator.NewTxListValidator(
+		uint64(p.protocolConfigs.BlockMaxGasLimit),
+		txListValidator.DefaultMaxTransactionsPerBlock,
+		p.protocolConfigs.BlockMaxTxListBytes.Uint64(),
+		p.rpc.L2ChainID,
+	)
+
 	if p.l1SignalService, err = p.rpc.TaikoL1.Resolve0(
 		&bind.CallOpts{Context: ctx},
 		rpc.StringToBytes32("signal_service"),
@@ -132,7 +168,7 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 		return fmt.Errorf("failed to resolve L1 signal service address: %w", err)
 	}
 
-	p.proverAddress = crypto.PubkeyToAddress(p.cfg.L1ProverPrivKey.PublicKey)
+	p.proverAddress = p.proverSigner.Address()
 
 	chBufferSize := p.protocolConfigs.BlockMaxProposals
 	p.proofGenerationCh = make(chan *proofProducer.ProofWithHeader, chBufferSize)
@@ -152,6 +188,41 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 		return err
 	}
 
+	// levelDB
+	var (
+		kvStore     ethdb.KeyValueStore
+		escrowStore bond.EscrowStore
+	)
+	if cfg.DatabasePath != "" {
+		if kvStore, err = leveldb.New(
+			cfg.DatabasePath,
+			int(cfg.DatabaseCacheSize),
+			16, // Minimum number of files handles is 16 in leveldb.
+			"taiko",
+			false,
+		); err != nil {
+			return err
+		}
+		if err := db.IndexSignedBlocks(kvStore); err != nil {
+			return fmt.Errorf("failed to index signed blocks: %w", err)
+		}
+
+		p.proofJobs = db.NewProofJobStore(kvStore)
+		p.proofTasks = db.NewProofTaskStore(kvStore)
+		escrowStore = db.NewEscrowStore(kvStore)
+	}
+
+	// Bond and TaikoToken balance manager
+	p.bondManager = bond.New(p.rpc, p.proverSigner, &bond.Config{
+		TaikoL1Address:     cfg.TaikoL1Address,
+		Spenders:           []common.Address{cfg.TaikoL1Address, cfg.AssignmentHookAddress},
+		Allowance:          cfg.Allowance,
+		AllowanceThreshold: cfg.BondAllowanceThreshold,
+		BalanceThreshold:   cfg.BondBalanceThreshold,
+		CheckInterval:      cfg.BondCheckInterval,
+		StartHeight:        p.genesisHeightL1,
+	}, escrowStore)
+
 	// Proof submitters
 	for _, tier := range p.tiers {
 		var (
@@ -162,14 +233,12 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 		case encoding.TierOptimisticID:
 			producer = &proofProducer.OptimisticProofProducer{DummyProofProducer: new(proofProducer.DummyProofProducer)}
 		case encoding.TierSgxID:
-			sgxProducer, err := proofProducer.NewSGXProducer(cfg.RaikoHostEndpoint, cfg.L1HttpEndpoint, cfg.L2HttpEndpoint)
-			if err != nil {
+			if producer, err = p.newRacingProducer(
+				append([]string{cfg.RaikoHostEndpoint}, cfg.RaikoHostEndpoints...),
+				p.newSGXProducer,
+			); err != nil {
 				return err
 			}
-			if p.cfg.Dummy {
-				sgxProducer.DummyProofProducer = new(proofProducer.DummyProofProducer)
-			}
-			producer = sgxProducer
 		case encoding.TierSgxAndPseZkevmID:
 			zkEvmRpcdProducer, err := proofProducer.NewZkevmRpcdProducer(
 				cfg.ZKEvmRpcdEndpoint,
@@ -178,6 +247,7 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 				cfg.L2HttpEndpoint,
 				true,
 				p.protocolConfigs,
+				p.proofTasks,
 			)
 			if err != nil {
 				return err
@@ -187,6 +257,7 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 				cfg.RaikoHostEndpoint,
 				cfg.L1HttpEndpoint,
 				cfg.L2HttpEndpoint,
+				p.proofTasks,
 			)
 			if err != nil {
 				return err
@@ -202,21 +273,12 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 				ZkevmRpcdProducer: zkEvmRpcdProducer,
 			}
 		case encoding.TierPseZkevmID:
-			zkEvmRpcdProducer, err := proofProducer.NewZkevmRpcdProducer(
-				cfg.ZKEvmRpcdEndpoint,
-				cfg.ZkEvmRpcdParamsPath,
-				cfg.L1HttpEndpoint,
-				cfg.L2HttpEndpoint,
-				true,
-				p.protocolConfigs,
-			)
-			if err != nil {
+			if producer, err = p.newRacingProducer(
+				append([]string{cfg.ZKEvmRpcdEndpoint}, cfg.ZkEvmRpcdEndpoints...),
+				p.newZkevmRpcdProducer,
+			); err != nil {
 				return err
 			}
-			if p.cfg.Dummy {
-				zkEvmRpcdProducer.DummyProofProducer = new(proofProducer.DummyProofProducer)
-			}
-			producer = zkEvmRpcdProducer
 		case encoding.TierGuardianID:
 			producer = proofProducer.NewGuardianProofProducer(p.cfg.EnableLivenessBondProof)
 		}
@@ -226,7 +288,7 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 			producer,
 			p.proofGenerationCh,
 			p.cfg.TaikoL2Address,
-			p.cfg.L1ProverPrivKey,
+			p.proverSigner,
 			p.cfg.Graffiti,
 			p.cfg.ProofSubmissionMaxRetry,
 			p.cfg.BackOffRetryInterval,
@@ -234,6 +296,7 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 			p.cfg.ProveBlockGasLimit,
 			p.cfg.ProveBlockTxReplacementMultiplier,
 			p.cfg.ProveBlockMaxTxGasTipCap,
+			p.cfg.VerifyProofLocally,
 		); err != nil {
 			return err
 		}
@@ -244,7 +307,7 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 	// Proof contester
 	p.proofContester, err = proofSubmitter.NewProofContester(
 		p.rpc,
-		p.cfg.L1ProverPrivKey,
+		p.proverSigner,
 		p.cfg.ProveBlockGasLimit,
 		p.cfg.ProveBlockTxReplacementMultiplier,
 		p.cfg.ProveBlockMaxTxGasTipCap,
@@ -257,27 +320,30 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 		return err
 	}
 
-	// levelDB
-	var db ethdb.KeyValueStore
-	if cfg.DatabasePath != "" {
-		if db, err = leveldb.New(
-			cfg.DatabasePath,
-			int(cfg.DatabaseCacheSize),
-			16, // Minimum number of files handles is 16 in leveldb.
-			"taiko",
-			false,
-		); err != nil {
-			return err
-		}
+	// Tier fee pricing engine
+	p.tierFeePricer = pricing.New(p.tierFeePricingConfig(protocolConfigs.LivenessBond), p.rpc, p.proposeConcurrencyGuard)
+	if err := p.tierFeePricer.UpdateGasPrice(ctx); err != nil {
+		return err
+	}
+
+	// Profitability ledger
+	if p.ledger, err = ledger.New(p.rpc, p.proverAddress, p.tierFeePricer, p.ledgerConfig()); err != nil {
+		return err
+	}
+
+	// Contest strategy engine
+	if p.contestStrategy, err = p.newContestStrategy(ctx); err != nil {
+		return err
 	}
 
 	// Prover server
 	proverServerOpts := &server.NewProverServerOpts{
-		ProverPrivateKey:         p.cfg.L1ProverPrivKey,
+		ProverSigner:             p.proverSigner,
 		MinOptimisticTierFee:     p.cfg.MinOptimisticTierFee,
 		MinSgxTierFee:            p.cfg.MinSgxTierFee,
 		MinPseZkevmTierFee:       p.cfg.MinPseZkevmTierFee,
 		MinSgxAndPseZkevmTierFee: p.cfg.MinSgxAndPseZkevmTierFee,
+		TierFeePricer:            p.tierFeePricer,
 		MaxExpiry:                p.cfg.MaxExpiry,
 		MaxBlockSlippage:         p.cfg.MaxBlockSlippage,
 		TaikoL1Address:           p.cfg.TaikoL1Address,
@@ -287,7 +353,9 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 		ProtocolConfigs:          &protocolConfigs,
 		LivenessBond:             protocolConfigs.LivenessBond,
 		IsGuardian:               p.IsGuardianProver(),
-		DB:                       db,
+		DB:                       kvStore,
+		BondManager:              p.bondManager,
+		Ledger:                   p.ledger,
 	}
 	if p.srv, err = server.New(proverServerOpts); err != nil {
 		return err
@@ -301,9 +369,9 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 		}
 
 		p.guardianProverSender = guardianproversender.New(
-			p.cfg.L1ProverPrivKey,
+			p.proverSigner,
 			p.cfg.GuardianProverHealthCheckServerEndpoint,
-			db,
+			kvStore,
 			p.rpc,
 			p.proverAddress,
 		)
@@ -312,6 +380,154 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 	return nil
 }
 
+// newSGXProducer creates a new SGX proof producer, which requests proofs from the given Raiko host.
+func (p *Prover) newSGXProducer(endpoint string) (proofProducer.ProofProducer, error) {
+	sgxProducer, err := proofProducer.NewSGXProducer(endpoint, p.cfg.L1HttpEndpoint, p.cfg.L2HttpEndpoint, p.proofTasks)
+	if err != nil {
+		return nil, err
+	}
+	if p.cfg.Dummy {
+		sgxProducer.DummyProofProducer = new(proofProducer.DummyProofProducer)
+	}
+
+	return sgxProducer, nil
+}
+
+// newZkevmRpcdProducer creates a new PSE zkEVM proof producer, which requests proofs from the given
+// ZKEVM RPCD service.
+func (p *Prover) newZkevmRpcdProducer(endpoint string) (proofProducer.ProofProducer, error) {
+	zkEvmRpcdProducer, err := proofProducer.NewZkevmRpcdProducer(
+		endpoint,
+		p.cfg.ZkEvmRpcdParamsPath,
+		p.cfg.L1HttpEndpoint,
+		p.cfg.L2HttpEndpoint,
+		true,
+		p.protocolConfigs,
+		p.proofTasks,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if p.cfg.Dummy {
+		zkEvmRpcdProducer.DummyProofProducer = new(proofProducer.DummyProofProducer)
+	}
+
+	return zkEvmRpcdProducer, nil
+}
+
+// newRacingProducer creates a proof producer for each of the given endpoints, if there is more than
+// one endpoint, the proof requests are raced across all of them.
+func (p *Prover) newRacingProducer(
+	endpoints []string,
+	newProducer func(endpoint string) (proofProducer.ProofProducer, error),
+) (proofProducer.ProofProducer, error) {
+	backends := make([]*proofProducer.RacingBackend, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		producer, err := newProducer(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		backends = append(backends, &proofProducer.RacingBackend{Name: endpoint, Producer: producer})
+	}
+
+	if len(backends) == 1 {
+		return backends[0].Producer, nil
+	}
+
+	return proofProducer.NewRacingProofProducer(backends, int(p.cfg.ProofRaceBackends))
+}
+
+// tierFeePricingConfig builds the tier fee pricing engine configurations, the liveness bond of
+// each tier can be locked until its proving window expires.
+func (p *Prover) tierFeePricingConfig(livenessBond *big.Int) *pricing.Config {
+	cfg := &pricing.Config{
+		MinTierFees: map[uint16]*big.Int{
+			encoding.TierOptimisticID:     p.cfg.MinOptimisticTierFee,
+			encoding.TierSgxID:            p.cfg.MinSgxTierFee,
+			encoding.TierPseZkevmID:       p.cfg.MinPseZkevmTierFee,
+			encoding.TierSgxAndPseZkevmID: p.cfg.MinSgxAndPseZkevmTierFee,
+		},
+		ProveBlockGas:  make(map[uint16]uint64),
+		LivenessBond:   livenessBond,
+		BondLockTimes:  make(map[uint16]time.Duration),
+		BondTokenPrice: p.cfg.TierFeeBondTokenPrice,
+		BondAPR:        p.cfg.TierFeeBondAPR,
+		Margin:         p.cfg.TierFeeMargin,
+		QueuePremium:   p.cfg.TierFeeQueuePremium,
+		UpdateInterval: p.cfg.TierFeeUpdateInterval,
+		FeeTokenRates:  p.cfg.FeeTokenRates,
+	}
+
+	for _, tier := range p.tiers {
+		cfg.BondLockTimes[tier.ID] = time.Duration(tier.ProvingWindow) * time.Second
+		if p.cfg.TierFeeProveBlockGas != 0 {
+			cfg.ProveBlockGas[tier.ID] = p.cfg.TierFeeProveBlockGas
+		}
+	}
+
+	return cfg
+}
+
+// ledgerConfig builds the profitability ledger configurations.
+func (p *Prover) ledgerConfig() *ledger.Config {
+	cfg := &ledger.Config{
+		AssignmentHookAddress: p.cfg.AssignmentHookAddress,
+		BackendCosts:          p.cfg.LedgerBackendCosts,
+		ProveBlockGas:         make(map[uint16]uint64),
+		BondTokenPrice:        p.cfg.TierFeeBondTokenPrice,
+		FeeTokenRates:         p.cfg.FeeTokenRates,
+		ProfitFilter:          p.cfg.LedgerProfitFilter,
+		UnassignedReward:      p.cfg.LedgerUnassignedReward,
+		ContestReward:         p.cfg.LedgerContestReward,
+	}
+
+	if p.cfg.TierFeeProveBlockGas != 0 {
+		for _, tier := range p.tiers {
+			cfg.ProveBlockGas[tier.ID] = p.cfg.TierFeeProveBlockGas
+		}
+	}
+
+	return cfg
+}
+
+// newContestStrategy creates the contest strategy engine, the transitions are verified against the
+// L2 node, and the independent L2 node if it's configured. Without the independent L2 node, wrong
+// transitions are only logged by the engine.
+func (p *Prover) newContestStrategy(ctx context.Context) (*contest.Engine, error) {
+	l2Verifier, err := contest.NewNodeVerifier("l2", p.rpc.L2, p.cfg.TaikoL2Address)
+	if err != nil {
+		return nil, err
+	}
+	verifiers := []contest.Verifier{l2Verifier}
+
+	if p.cfg.ContestL2Endpoint != "" {
+		client, err := rpc.NewEthClient(ctx, p.cfg.ContestL2Endpoint, p.cfg.RPCTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to the contest L2 node: %w", err)
+		}
+
+		contestVerifier, err := contest.NewNodeVerifier("contestL2", client, p.cfg.TaikoL2Address)
+		if err != nil {
+			return nil, err
+		}
+		verifiers = append(verifiers, contestVerifier)
+	} else {
+		log.Warn("No independent L2 node configured, wrong transitions will not be contested")
+	}
+
+	return contest.New(
+		&contest.Config{
+			MinConfirmations:     int(p.cfg.ContestMinConfirmations),
+			MinCooldownRemaining: p.cfg.ContestMinCooldownRemaining,
+			MaxContestBond:       p.cfg.ContestMaxBond,
+			RequireFollowUp:      p.cfg.ContestRequireFollowUp,
+		},
+		verifiers,
+		p.tiers,
+		p.hasSubmitter,
+	)
+}
+
 // setApprovalAmount will set the allowance on the TaikoToken contract for the
 // configured proverAddress as owner and the contract as spender,
 // if `--prover.allowance` flag is provided for allowance.
@@ -342,36 +558,9 @@ func (p *Prover) setApprovalAmount(ctx context.Context, contract common.Address)
 		return nil
 	}
 
-	opts, err := bind.NewKeyedTransactorWithChainID(
-		p.cfg.L1ProverPrivKey,
-		p.rpc.L1ChainID,
-	)
-	if err != nil {
+	if err := p.bondManager.Approve(ctx, contract, p.cfg.Allowance); err != nil {
 		return err
 	}
-	opts.Context = ctx
-
-	log.Info("Approving the contract for taiko token", "allowance", p.cfg.Allowance.String(), "contract", contract)
-
-	tx, err := p.rpc.TaikoToken.Approve(
-		opts,
-		contract,
-		p.cfg.Allowance,
-	)
-	if err != nil {
-		return err
-	}
-
-	receipt, err := rpc.WaitReceipt(ctx, p.rpc.L1, tx)
-	if err != nil {
-		return err
-	}
-
-	log.Info(
-		"Approved the contract for taiko token",
-		"txHash", receipt.TxHash.Hex(),
-		"contract", contract,
-	)
 
 	if allowance, err = p.rpc.TaikoToken.Allowance(
 		&bind.CallOpts{Context: ctx},
@@ -413,6 +602,24 @@ func (p *Prover) Start() error {
 		go p.heartbeatInterval(p.ctx)
 	}
 
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		p.tierFeePricer.Start(p.ctx)
+	}()
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		p.bondManager.Start(p.ctx)
+	}()
+
+	// Resume the unfinished proof jobs persisted before the last shutdown, right before the event loop
+	// starts consuming them.
+	if err := p.resumeProofJobs(p.ctx); err != nil {
+		return fmt.Errorf("failed to resume proof jobs: %w", err)
+	}
+
 	p.wg.Add(1)
 	go p.eventLoop()
 
@@ -470,14 +677,21 @@ func (p *Prover) eventLoop() {
 				log.Error("Prove new blocks error", "error", err)
 			}
 		case e := <-blockVerifiedCh:
+			p.bondManager.OnBlockVerified(e)
+			p.ledger.OnBlockVerified(e)
+			p.contestStrategy.OnBlockVerified(e)
 			if err := p.onBlockVerified(p.ctx, e); err != nil {
 				log.Error("Handle BlockVerified event error", "error", err)
 			}
 		case e := <-transitionProvedCh:
+			p.bondManager.OnTransitionProved(e)
+			p.ledger.OnTransitionProved(p.ctx, e)
 			if err := p.onTransitionProved(p.ctx, e); err != nil {
 				log.Error("Handle TransitionProved event error", "error", err)
 			}
 		case e := <-transitionContestedCh:
+			p.bondManager.OnTransitionContested(e)
+			p.ledger.OnTransitionContested(p.ctx, e)
 			if err := p.onTransitionContested(p.ctx, e); err != nil {
 				log.Error("Handle TransitionContested event error", "error", err)
 			}
@@ -485,7 +699,9 @@ func (p *Prover) eventLoop() {
 			if err := p.onProvingWindowExpired(p.ctx, e); err != nil {
 				log.Error("Handle provingWindow expired event error", "error", err)
 			}
-		case <-blockProposedCh:
+		case e := <-blockProposedCh:
+			p.bondManager.OnBlockProposed(e)
+			p.ledger.OnBlockProposed(p.ctx, e)
 			reqProving()
 		case <-forceProvingTicker.C:
 			reqProving()
@@ -651,30 +867,14 @@ func (p *Prover) onBlockProposed(
 	p.l1Current = newL1Current
 	p.lastHandledBlockID = event.BlockId.Uint64()
 
-	// Try generating a proof for the proposed block with the given backoff policy.
-	go func() {
-		if err := backoff.Retry(
-			func() error {
-				p.proposeConcurrencyGuard <- struct{}{}
-				defer func() { <-p.proposeConcurrencyGuard }()
+	// Skip the block if its proof job has been resumed from the database.
+	if p.isProofJobInFlight(event) {
+		log.Info("Proof job is already in flight", "blockID", event.BlockId)
+		return nil
+	}
 
-				if err := p.handleNewBlockProposedEvent(ctx, event); err != nil {
-					log.Error(
-						"Failed to handle BlockProposed event",
-						"error", err,
-						"blockID", event.BlockId,
-						"minTier", event.Meta.MinTier,
-						"maxRetrys", p.cfg.BackOffMaxRetrys,
-					)
-					return err
-				}
-				return nil
-			},
-			backoff.WithMaxRetries(backoff.NewConstantBackOff(p.cfg.BackOffRetryInterval), p.cfg.BackOffMaxRetrys),
-		); err != nil {
-			log.Error("Handle new BlockProposed event error", "error", err)
-		}
-	}()
+	// Try generating a proof for the proposed block with the given backoff policy.
+	p.requestProofForEvent(ctx, event)
 
 	return nil
 }
@@ -730,12 +930,15 @@ func (p *Prover) handleNewBlockProposedEvent(ctx context.Context, e *bindings.Ta
 			e.BlockId,
 			new(big.Int).SetUint64(e.Raw.BlockNumber),
 			proofStatus.ParentHeader.Hash(),
-			proofStatus.CurrentTransitionState.Contester,
 			&e.Meta,
-			proofStatus.CurrentTransitionState.Tier,
+			proofStatus.CurrentTransitionState,
 		)
 	}
 
+	// Track the liveness bond of the unproven blocks, which may be proposed before the prover started.
+	p.bondManager.OnBlockProposed(e)
+	p.ledger.OnBlockProposed(ctx, e)
+
 	provingWindow, err := p.getProvingWindow(e)
 	if err != nil {
 		return fmt.Errorf("failed to get proving window: %w", err)
@@ -775,6 +978,9 @@ func (p *Prover) handleNewBlockProposedEvent(ctx context.Context, e *bindings.Ta
 			)
 			return nil
 		}
+		if !p.ledger.ShouldTake(ledger.WorkUnassigned, e.BlockId, e.Meta.MinTier) {
+			return nil
+		}
 	} else {
 		// If the proving window is not expired, we need to check if the current prover is the assigned prover,
 		// if no and the current prover wants to prove unassigned blocks, then we should wait for its expiration.
@@ -820,6 +1026,8 @@ func (p *Prover) handleNewBlockProposedEvent(ctx context.Context, e *bindings.Ta
 	metrics.ProverProofsAssigned.Inc(1)
 
 	if proofSubmitter := p.selectSubmitter(tier); proofSubmitter != nil {
+		p.markProofJobGenerating(e, proofSubmitter.Tier())
+		p.checkTxList(ctx, e)
 		return proofSubmitter.RequestProof(ctx, e)
 	}
 
@@ -832,9 +1040,8 @@ func (p *Prover) handleInvalidProof(
 	blockID *big.Int,
 	proposedIn *big.Int,
 	parentHash common.Hash,
-	contester common.Address,
 	meta *bindings.TaikoDataBlockMetadata,
-	tier uint16,
+	ts *bindings.TaikoDataTransitionState,
 ) error {
 	// The proof submitted to protocol is invalid.
 	log.Info(
@@ -843,29 +1050,37 @@ func (p *Prover) handleInvalidProof(
 		"parent", parentHash,
 	)
 
-	// If there is no contester, we submit a contest to protocol.
-	if contester == rpc.ZeroAddress {
-		log.Info(
-			"Try submitting a contest",
-			"blockID", blockID,
-			"parent", parentHash,
-		)
+	decision := p.contestStrategy.Decide(ctx, &contest.Transition{BlockID: blockID, ParentHash: parentHash, State: ts})
 
-		return p.proofContester.SubmitContest(ctx, blockID, proposedIn, parentHash, meta, tier)
+	var err error
+	switch decision.Action {
+	case contest.ActionContest:
+		if !p.ledger.ShouldTake(ledger.WorkContest, blockID, ts.Tier) {
+			return nil
+		}
+		err = p.proofContester.SubmitContest(ctx, blockID, proposedIn, parentHash, meta, ts.Tier)
+	case contest.ActionProve:
+		if !p.ledger.ShouldTake(ledger.WorkContestProof, blockID, decision.Tier) {
+			return nil
+		}
+		err = p.requestProofByBlockID(blockID, proposedIn, decision.Tier, nil)
+	default:
+		return nil
 	}
+	p.contestStrategy.RecordExecution(decision, err)
 
-	log.Info(
-		"Try submitting a higher tier proof",
-		"blockID", blockID,
-		"parent", parentHash,
-	)
-
-	// If there is already a contester, we try submitting a proof with a higher tier here.
-	return p.requestProofByBlockID(blockID, proposedIn, tier+1, nil)
+	return err
 }
 
 // submitProofOp performs a proof submission operation.
 func (p *Prover) submitProofOp(ctx context.Context, proofWithHeader *proofProducer.ProofWithHeader) {
+	p.updateProofJob(proofWithHeader.BlockID, func(job *db.ProofJob) {
+		job.Status = db.ProofJobGenerated
+		job.Tier = proofWithHeader.Tier
+		job.Proof = proofWithHeader
+		job.Error = ""
+	})
+
 	go func() {
 		p.submitProofConcurrencyGuard <- struct{}{}
 
@@ -880,16 +1095,19 @@ func (p *Prover) submitProofOp(ctx context.Context, proofWithHeader *proofProduc
 					return nil
 				}
 
+				p.setProofJobStatus(proofWithHeader.BlockID, db.ProofJobSubmitted, nil)
 				if err := proofSubmitter.SubmitProof(p.ctx, proofWithHeader); err != nil {
 					log.Error("Submit proof error", "error", err)
 					return err
 				}
 
+				p.finalizeProofJob(p.ctx, proofWithHeader)
 				return nil
 			},
 			backoff.WithMaxRetries(backoff.NewConstantBackOff(p.cfg.BackOffRetryInterval), p.cfg.BackOffMaxRetrys),
 		); err != nil {
 			log.Error("Submit proof error", "error", err)
+			p.setProofJobStatus(proofWithHeader.BlockID, db.ProofJobFailed, err)
 		}
 	}()
 }
@@ -920,27 +1138,18 @@ func (p *Prover) onTransitionContested(ctx context.Context, e *bindings.TaikoL1C
 		return err
 	}
 
-	// Compare the contested transition to the block in local L2 canonical chain.
-	isValidProof, err := p.isValidProof(
-		ctx,
-		e.BlockId,
-		e.Tran.ParentHash,
-		contestedTransition.BlockHash,
-		contestedTransition.SignalRoot,
-	)
-	if err != nil {
-		return err
+	// Compare the contested transition to the block in L2 canonical chains, and follow up with
+	// a higher tier proof only if the strategy engine decides so.
+	decision := p.contestStrategy.Decide(ctx, &contest.Transition{
+		BlockID:    e.BlockId,
+		ParentHash: e.Tran.ParentHash,
+		State:      &contestedTransition,
+	})
+	if decision.Action != contest.ActionProve {
+		return nil
 	}
-	if isValidProof {
-		log.Info(
-			"Contested transition is valid to local canonical chain, ignore the contest",
-			"blockID", e.BlockId,
-			"parentHash", common.Bytes2Hex(e.Tran.ParentHash[:]),
-			"hash", common.Bytes2Hex(contestedTransition.BlockHash[:]),
-			"signalRoot", common.BytesToHash(contestedTransition.SignalRoot[:]),
-			"contester", e.Contester,
-			"bond", e.ContestBond,
-		)
+
+	if !p.ledger.ShouldTake(ledger.WorkContestProof, e.BlockId, decision.Tier) {
 		return nil
 	}
 
@@ -949,16 +1158,23 @@ func (p *Prover) onTransitionContested(ctx context.Context, e *bindings.TaikoL1C
 		return err
 	}
 
-	return p.requestProofByBlockID(e.BlockId, new(big.Int).SetUint64(blockInfo.ProposedIn), e.Tier+1, nil)
+	err = p.requestProofByBlockID(e.BlockId, new(big.Int).SetUint64(blockInfo.ProposedIn), decision.Tier, nil)
+	p.contestStrategy.RecordExecution(decision, err)
+
+	return err
 }
 
 // onBlockVerified update the latestVerified block in current state, and cancels
 // the block being proven if it's verified.
-func (p *Prover) onBlockVerified(_ context.Context, e *bindings.TaikoL1ClientBlockVerified) error {
+func (p *Prover) onBlockVerified(ctx context.Context, e *bindings.TaikoL1ClientBlockVerified) error {
 	metrics.ProverLatestVerifiedIDGauge.Update(e.BlockId.Int64())
 
 	p.latestVerifiedL1Height = e.Raw.BlockNumber
 
+	// The block won't need any proofs anymore.
+	p.deleteProofJob(e.BlockId)
+	p.cancelProofGenerations(ctx, e.BlockId, encoding.TierGuardianID)
+
 	log.Info(
 		"New verified block",
 		"blockID", e.BlockId,
@@ -971,16 +1187,34 @@ func (p *Prover) onBlockVerified(_ context.Context, e *bindings.TaikoL1ClientBlo
 	return nil
 }
 
+// cancelProofGenerations cancels the ongoing proof generations of the given block, whose tiers are
+// not higher than the given tier. The cancellations are sent to the remote prover services in the
+// background, so that an unresponsive service won't block the event loop.
+func (p *Prover) cancelProofGenerations(ctx context.Context, blockID *big.Int, maxTier uint16) {
+	for _, s := range p.proofSubmitters {
+		if s.Tier() > maxTier || !s.Producer().Cancellable() {
+			continue
+		}
+
+		p.wg.Add(1)
+		go func(s proofSubmitter.Submitter) {
+			defer p.wg.Done()
+
+			if err := s.Producer().Cancel(ctx, blockID); err != nil {
+				log.Warn("Failed to cancel proof generation", "blockID", blockID, "tier", s.Tier(), "error", err)
+			}
+		}(s)
+	}
+}
+
 // onTransitionProved verifies the proven block hash and will try contesting it if the block hash is wrong.
 func (p *Prover) onTransitionProved(ctx context.Context, event *bindings.TaikoL1ClientTransitionProved) error {
 	metrics.ProverReceivedProvenBlockGauge.Update(event.BlockId.Int64())
 
-	// If the proof generation is cancellable, cancel it and release the capacity.
-	proofSubmitter := p.getSubmitterByTier(event.Tier)
-	if proofSubmitter != nil && proofSubmitter.Producer().Cancellable() {
-		if err := proofSubmitter.Producer().Cancel(ctx, event.BlockId); err != nil {
-			return err
-		}
+	// If the transition is proven by another prover, the proofs of the same or lower tiers
+	// are not needed anymore, cancel them and release the remote prover capacity.
+	if event.Prover != p.proverAddress {
+		p.cancelProofGenerations(ctx, event.BlockId, event.Tier)
 	}
 
 	// If this prover is in contest mode, we check the validity of this proof and if it's invalid,
@@ -1003,6 +1237,38 @@ func (p *Prover) onTransitionProved(ctx context.Context, event *bindings.TaikoL1
 		return nil
 	}
 
+	transition, err := p.rpc.TaikoL1.GetTransition(
+		&bind.CallOpts{Context: ctx},
+		event.BlockId.Uint64(),
+		event.Tran.ParentHash,
+	)
+	if err != nil {
+		return err
+	}
+
+	decision := p.contestStrategy.Decide(ctx, &contest.Transition{
+		BlockID:    event.BlockId,
+		ParentHash: event.Tran.ParentHash,
+		State:      &transition,
+	})
+
+	var (
+		work = ledger.WorkContest
+		tier = event.Tier
+		// If this event is not nil, the transition is contested instead of being proven directly.
+		contestEvent = event
+	)
+	switch decision.Action {
+	case contest.ActionContest:
+	case contest.ActionProve:
+		work, tier, contestEvent = ledger.WorkContestProof, decision.Tier, nil
+	default:
+		return nil
+	}
+	if !p.ledger.ShouldTake(work, event.BlockId, tier) {
+		return nil
+	}
+
 	blockInfo, err := p.rpc.TaikoL1.GetBlock(&bind.CallOpts{Context: ctx}, event.BlockId.Uint64())
 	if err != nil {
 		return err
@@ -1012,13 +1278,17 @@ func (p *Prover) onTransitionProved(ctx context.Context, event *bindings.TaikoL1
 		"Contest a proven transition",
 		"blockID", event.BlockId,
 		"l1Height", blockInfo.ProposedIn,
+		"action", decision.Action,
 		"tier", event.Tier,
 		"parentHash", common.Bytes2Hex(event.Tran.ParentHash[:]),
 		"blockHash", common.Bytes2Hex(event.Tran.BlockHash[:]),
 		"signalRoot", common.Bytes2Hex(event.Tran.SignalRoot[:]),
 	)
 
-	return p.requestProofByBlockID(event.BlockId, new(big.Int).SetUint64(blockInfo.ProposedIn), event.Tier, event)
+	err = p.requestProofByBlockID(event.BlockId, new(big.Int).SetUint64(blockInfo.ProposedIn), tier, contestEvent)
+	p.contestStrategy.RecordExecution(decision, err)
+
+	return err
 }
 
 // Name returns the application name.
@@ -1173,6 +1443,7 @@ func (p *Prover) requestProofByBlockID(
 			minTier = encoding.TierGuardianID
 		}
 		if proofSubmitter := p.selectSubmitter(minTier); proofSubmitter != nil {
+			p.markProofJobGenerating(event, proofSubmitter.Tier())
 			return proofSubmitter.RequestProof(ctx, event)
 		}
 
@@ -1258,12 +1529,15 @@ func (p *Prover) onProvingWindowExpired(ctx context.Context, e *bindings.TaikoL1
 			e.BlockId,
 			new(big.Int).SetUint64(e.Raw.BlockNumber),
 			proofStatus.ParentHeader.Hash(),
-			proofStatus.CurrentTransitionState.Contester,
 			&e.Meta,
-			proofStatus.CurrentTransitionState.Tier,
+			proofStatus.CurrentTransitionState,
 		)
 	}
 
+	if !p.ledger.ShouldTake(ledger.WorkUnassigned, e.BlockId, e.Meta.MinTier) {
+		return nil
+	}
+
 	return p.requestProofByBlockID(e.BlockId, new(big.Int).SetUint64(e.Raw.BlockNumber), e.Meta.MinTier, nil)
 }
 
@@ -1291,6 +1565,17 @@ func (p *Prover) selectSubmitter(minTier uint16) proofSubmitter.Submitter {
 	return nil
 }
 
+// hasSubmitter returns whether the prover has a proof submitter with the given minTier.
+func (p *Prover) hasSubmitter(minTier uint16) bool {
+	for _, s := range p.proofSubmitters {
+		if s.Tier() >= minTier {
+			return true
+		}
+	}
+
+	return false
+}
+
 // getSubmitterByTier returns the proof submitter with the given tier.
 func (p *Prover) getSubmitterByTier(tier uint16) proofSubmitter.Submitter {
 	for _, s := range p.proofSubmitters {
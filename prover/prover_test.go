@@ -23,6 +23,7 @@ import (
 	"github.com/taikoxyz/taiko-client/internal/testutils"
 	"github.com/taikoxyz/taiko-client/pkg/jwt"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
 	"github.com/taikoxyz/taiko-client/proposer"
 	guardianproversender "github.com/taikoxyz/taiko-client/prover/guardian_prover_sender"
 	producer "github.com/taikoxyz/taiko-client/prover/proof_producer"
@@ -360,7 +361,7 @@ func (s *ProverTestSuite) TestProveOp() {
 }
 
 func (s *ProverTestSuite) TestSetApprovalAmount() {
-	opts, err := bind.NewKeyedTransactorWithChainID(s.p.proverPrivateKey, s.p.rpc.L1ChainID)
+	opts, err := signer.NewTransactor(context.Background(), s.p.proverSigner, s.p.rpc.L1ChainID)
 	s.Nil(err)
 
 	tx, err := s.p.rpc.TaikoToken.Approve(opts, s.p.cfg.AssignmentHookAddress, common.Big0)
@@ -508,7 +509,7 @@ func (s *ProverTestSuite) initProver(
 	)
 
 	p.guardianProverSender = guardianproversender.New(
-		key,
+		signer.NewLocalSigner(key),
 		p.cfg.GuardianProverHealthCheckServerEndpoint,
 		memorydb.New(),
 		p.rpc,
@@ -1,8 +1,9 @@
 package server
 
 import (
-	"math/big"
+	"errors"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -12,6 +13,12 @@ import (
 
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/prover/db"
+)
+
+const (
+	defaultSignedBlocksLimit uint64 = 100
+	maxSignedBlocksLimit     uint64 = 1000
 )
 
 // @title Taiko Prover Server API
@@ -25,24 +32,31 @@ import (
 // @license.name MIT
 // @license.url https://github.com/taikoxyz/taiko-client/blob/main/LICENSE.md
 
-// CreateAssignmentRequestBody represents a request body when handling assignment creation request.
+// CreateAssignmentRequestBody represents a request body when handling assignment creation request,
+// a dry-run assignment can only be used to simulate a proposal, see signAssignment.
 type CreateAssignmentRequestBody struct {
 	FeeToken   common.Address
 	TierFees   []encoding.TierFee
 	Expiry     uint64
 	TxListHash common.Hash
+	DryRun     bool
 }
 
 // Status represents the current prover server status.
 type Status struct {
-	MinOptimisticTierFee uint64 `json:"minOptimisticTierFee"`
-	MinSgxTierFee        uint64 `json:"minSgxTierFee"`
-	MinPseZkevmTierFee   uint64 `json:"minPseZkevmTierFee"`
-	MaxExpiry            uint64 `json:"maxExpiry"`
-	Prover               string `json:"prover"`
+	MinOptimisticTierFee     uint64   `json:"minOptimisticTierFee"`
+	MinSgxTierFee            uint64   `json:"minSgxTierFee"`
+	MinPseZkevmTierFee       uint64   `json:"minPseZkevmTierFee"`
+	MinSgxAndPseZkevmTierFee uint64   `json:"minSgxAndPseZkevmTierFee"`
+	L1GasPrice               uint64   `json:"l1GasPrice"`
+	FeeTokens                []string `json:"feeTokens"`
+	MaxExpiry                uint64   `json:"maxExpiry"`
+	Prover                   string   `json:"prover"`
 }
 
-// GetStatus handles a query to the current prover server status.
+// GetStatus handles a query to the current prover server status, the minimum tier fees
+// are the live prices in ETH computed by the tier fee pricing engine, the accepted ERC-20
+// fee tokens are also listed.
 //
 //	@Summary		Get current prover server status
 //	@ID			   	get-status
@@ -51,15 +65,61 @@ type Status struct {
 //	@Success		200	{object} Status
 //	@Router			/status [get]
 func (srv *ProverServer) GetStatus(c echo.Context) error {
+	minTierFees := srv.tierFeePricer.MinTierFees()
+
+	feeTokens := make([]string, 0)
+	for _, token := range srv.tierFeePricer.FeeTokens() {
+		feeTokens = append(feeTokens, token.Hex())
+	}
+
 	return c.JSON(http.StatusOK, &Status{
-		MinOptimisticTierFee: srv.minOptimisticTierFee.Uint64(),
-		MinSgxTierFee:        srv.minSgxTierFee.Uint64(),
-		MinPseZkevmTierFee:   srv.minPseZkevmTierFee.Uint64(),
-		MaxExpiry:            uint64(srv.maxExpiry.Seconds()),
-		Prover:               srv.proverAddress.Hex(),
+		MinOptimisticTierFee:     minTierFees[encoding.TierOptimisticID].Uint64(),
+		MinSgxTierFee:            minTierFees[encoding.TierSgxID].Uint64(),
+		MinPseZkevmTierFee:       minTierFees[encoding.TierPseZkevmID].Uint64(),
+		MinSgxAndPseZkevmTierFee: minTierFees[encoding.TierSgxAndPseZkevmID].Uint64(),
+		L1GasPrice:               srv.tierFeePricer.GasPrice().Uint64(),
+		FeeTokens:                feeTokens,
+		MaxExpiry:                uint64(srv.maxExpiry.Seconds()),
+		Prover:                   srv.proverAddress.Hex(),
 	})
 }
 
+// GetBondStatus handles a query to the prover's bond status, including its TaikoToken balance,
+// allowances, L1 escrow, and the bonds locked for each in-flight block.
+//
+//	@Summary		Get current prover bond status
+//	@ID			   	get-bond-status
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object} bond.Status
+//	@Failure		404	{string} string	"bond manager not enabled"
+//	@Router			/bond [get]
+func (srv *ProverServer) GetBondStatus(c echo.Context) error {
+	if srv.bondManager == nil {
+		return c.JSON(http.StatusNotFound, "bond manager not enabled")
+	}
+
+	return c.JSON(http.StatusOK, srv.bondManager.Status())
+}
+
+// GetLedger handles a query to the prover's profitability ledger, including the fees, costs and bonds
+// of each recent block, and the totals since the prover started.
+//
+//	@Summary		Get current prover ledger
+//	@ID			   	get-ledger
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object} ledger.Status
+//	@Failure		404	{string} string	"ledger not enabled"
+//	@Router			/ledger [get]
+func (srv *ProverServer) GetLedger(c echo.Context) error {
+	if srv.ledger == nil {
+		return c.JSON(http.StatusNotFound, "ledger not enabled")
+	}
+
+	return c.JSON(http.StatusOK, srv.ledger.Status())
+}
+
 // ProposeBlockResponse represents the JSON response which will be returned by
 // the ProposeBlock request handler.
 type ProposeBlockResponse struct {
@@ -71,7 +131,8 @@ type ProposeBlockResponse struct {
 
 // CreateAssignment handles a block proof assignment request, decides if this prover wants to
 // handle this block, and if so, returns a signed payload the proposer
-// can submit onchain.
+// can submit onchain. The tier fees can be paid either in ETH or in one of the accepted
+// ERC-20 fee tokens.
 //
 //	@Summary		Try to accept a block proof assignment
 //	@Param          body        body    CreateAssignmentRequestBody   true    "assignment request body"
@@ -79,8 +140,9 @@ type ProposeBlockResponse struct {
 //	@Produce		json
 //	@Success		200		{object} ProposeBlockResponse
 //	@Failure		422		{string} string	"invalid txList hash"
-//	@Failure		422		{string} string	"only receive ETH"
+//	@Failure		422		{string} string	"unsupported fee token"
 //	@Failure		422		{string} string	"insufficient prover balance"
+//	@Failure		422		{string} string	"unknown tier"
 //	@Failure		422		{string} string	"proof fee too low"
 //	@Failure		422		{string} string "expiry too long"
 //	@Failure		422		{string} string "prover does not have capacity"
@@ -97,14 +159,135 @@ func (srv *ProverServer) CreateAssignment(c echo.Context) error {
 		"expiry", req.Expiry,
 		"tierFees", req.TierFees,
 		"txListHash", req.TxListHash,
+		"dryRun", req.DryRun,
+	)
+
+	if err := srv.checkAssignmentRequest(c, req.FeeToken, req.Expiry, req.TxListHash); err != nil {
+		return err
+	}
+
+	for _, tier := range req.TierFees {
+		if tier.Tier == encoding.TierGuardianID {
+			continue
+		}
+
+		minTierFee, ok := srv.tierFeePricer.MinTierFeeInToken(tier.Tier, req.FeeToken)
+		if !ok {
+			log.Warn("Unknown tier", "tier", tier.Tier, "fee", tier.Fee, "proposerIP", c.RealIP())
+			return echo.NewHTTPError(http.StatusUnprocessableEntity, "unknown tier")
+		}
+
+		if tier.Fee.Cmp(minTierFee) < 0 {
+			log.Warn(
+				"Proof fee too low",
+				"tier", tier.Tier,
+				"feeToken", req.FeeToken,
+				"fee", tier.Fee,
+				"minTierFee", minTierFee,
+				"proposerIP", c.RealIP(),
+			)
+			return echo.NewHTTPError(http.StatusUnprocessableEntity, "proof fee too low")
+		}
+	}
+
+	resp, err := srv.signAssignment(c, req.FeeToken, req.Expiry, req.TxListHash, req.TierFees, req.DryRun)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// CreateQuoteRequestBody represents a request body when handling quote creation request.
+type CreateQuoteRequestBody struct {
+	FeeToken   common.Address
+	Tiers      []uint16
+	Expiry     uint64
+	TxListHash common.Hash
+	DryRun     bool
+}
+
+// QuoteResponse represents the JSON response which will be returned by the CreateQuote
+// request handler, the signed payload is signed over the quoted tier fees.
+type QuoteResponse struct {
+	ProposeBlockResponse
+	TierFees []encoding.TierFee `json:"tierFees"`
+}
+
+// CreateQuote handles a sealed bid request of a block proof assignment auction, if this prover
+// wants to handle this block, it returns its own price of each requested tier, along with a
+// signed payload the proposer can submit onchain, if it picks this bid.
+//
+//	@Summary		Bid for a block proof assignment
+//	@Param          body        body    CreateQuoteRequestBody   true    "quote request body"
+//	@Accept			json
+//	@Produce		json
+//	@Success		200		{object} QuoteResponse
+//	@Failure		422		{string} string	"invalid txList hash"
+//	@Failure		422		{string} string	"unsupported fee token"
+//	@Failure		422		{string} string	"insufficient prover balance"
+//	@Failure		422		{string} string "expiry too long"
+//	@Failure		422		{string} string "prover does not have capacity"
+//	@Failure		422		{string} string	"unknown tier"
+//	@Router			/quote [post]
+func (srv *ProverServer) CreateQuote(c echo.Context) error {
+	req := new(CreateQuoteRequestBody)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusUnprocessableEntity, err)
+	}
+
+	log.Info(
+		"Proof assignment quote request body",
+		"feeToken", req.FeeToken,
+		"expiry", req.Expiry,
+		"tiers", req.Tiers,
+		"txListHash", req.TxListHash,
+		"dryRun", req.DryRun,
 	)
 
-	if req.TxListHash == (common.Hash{}) {
+	if err := srv.checkAssignmentRequest(c, req.FeeToken, req.Expiry, req.TxListHash); err != nil {
+		return err
+	}
+
+	tierFees := make([]encoding.TierFee, 0, len(req.Tiers))
+	for _, tier := range req.Tiers {
+		// Guardian prover should not charge any fee.
+		if tier == encoding.TierGuardianID {
+			tierFees = append(tierFees, encoding.TierFee{Tier: tier, Fee: common.Big0})
+			continue
+		}
+
+		minTierFee, ok := srv.tierFeePricer.MinTierFeeInToken(tier, req.FeeToken)
+		if !ok {
+			log.Warn("Unknown tier", "tier", tier, "proposerIP", c.RealIP())
+			return echo.NewHTTPError(http.StatusUnprocessableEntity, "unknown tier")
+		}
+		tierFees = append(tierFees, encoding.TierFee{Tier: tier, Fee: minTierFee})
+	}
+
+	resp, err := srv.signAssignment(c, req.FeeToken, req.Expiry, req.TxListHash, tierFees, req.DryRun)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &QuoteResponse{ProposeBlockResponse: *resp, TierFees: tierFees})
+}
+
+// checkAssignmentRequest checks whether this prover can accept a block proof assignment with the
+// given fee token, expiry and txList hash.
+func (srv *ProverServer) checkAssignmentRequest(
+	c echo.Context,
+	feeToken common.Address,
+	expiry uint64,
+	txListHash common.Hash,
+) error {
+	if txListHash == (common.Hash{}) {
 		return echo.NewHTTPError(http.StatusUnprocessableEntity, "invalid txList hash")
 	}
 
-	if req.FeeToken != (common.Address{}) {
-		return echo.NewHTTPError(http.StatusUnprocessableEntity, "only receive ETH")
+	if !srv.tierFeePricer.IsFeeTokenAccepted(feeToken) {
+		log.Warn("Unsupported fee token", "feeToken", feeToken, "proposerIP", c.RealIP())
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, "unsupported fee token")
 	}
 
 	if !srv.isGuardian {
@@ -128,41 +311,10 @@ func (srv *ProverServer) CreateAssignment(c echo.Context) error {
 		}
 	}
 
-	for _, tier := range req.TierFees {
-		if tier.Tier == encoding.TierGuardianID {
-			continue
-		}
-
-		var minTierFee *big.Int
-		switch tier.Tier {
-		case encoding.TierOptimisticID:
-			minTierFee = srv.minOptimisticTierFee
-		case encoding.TierSgxID:
-			minTierFee = srv.minSgxTierFee
-		case encoding.TierPseZkevmID:
-			minTierFee = srv.minPseZkevmTierFee
-		case encoding.TierSgxAndPseZkevmID:
-			minTierFee = srv.minSgxAndPseZkevmTierFee
-		default:
-			log.Warn("Unknown tier", "tier", tier.Tier, "fee", tier.Fee, "proposerIP", c.RealIP())
-		}
-
-		if tier.Fee.Cmp(minTierFee) < 0 {
-			log.Warn(
-				"Proof fee too low",
-				"tier", tier.Tier,
-				"fee", tier.Fee,
-				"minTierFee", minTierFee,
-				"proposerIP", c.RealIP(),
-			)
-			return echo.NewHTTPError(http.StatusUnprocessableEntity, "proof fee too low")
-		}
-	}
-
-	if req.Expiry > uint64(time.Now().Add(srv.maxExpiry).Unix()) {
+	if expiry > uint64(time.Now().Add(srv.maxExpiry).Unix()) {
 		log.Warn(
 			"Expiry too long",
-			"requestExpiry", req.Expiry,
+			"requestExpiry", expiry,
 			"srvMaxExpiry", srv.maxExpiry,
 			"proposerIP", c.RealIP(),
 		)
@@ -174,37 +326,144 @@ func (srv *ProverServer) CreateAssignment(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusUnprocessableEntity, "prover does not have capacity")
 	}
 
+	return nil
+}
+
+// signAssignment signs a prover assignment payload with the given fee token, expiry, txList hash
+// and tier fees. A dry-run assignment can only be proposed in the current L1 head block, which has
+// already been mined, so the proposer can simulate the proposal with it, but never submit it.
+func (srv *ProverServer) signAssignment(
+	c echo.Context,
+	feeToken common.Address,
+	expiry uint64,
+	txListHash common.Hash,
+	tierFees []encoding.TierFee,
+	dryRun bool,
+) (*ProposeBlockResponse, error) {
 	l1Head, err := srv.rpc.L1.BlockNumber(c.Request().Context())
 	if err != nil {
 		log.Error("Failed to get L1 block head", "error", err)
-		return echo.NewHTTPError(http.StatusUnprocessableEntity, err)
+		return nil, echo.NewHTTPError(http.StatusUnprocessableEntity, err)
+	}
+
+	maxProposedIn := srv.maxProposedIn
+	if dryRun {
+		maxProposedIn = l1Head
 	}
 
 	encoded, err := encoding.EncodeProverAssignmentPayload(
 		srv.protocolConfigs.ChainId,
 		srv.taikoL1Address,
 		srv.assignmentHookAddress,
-		req.TxListHash,
-		req.FeeToken,
-		req.Expiry,
+		txListHash,
+		feeToken,
+		expiry,
 		l1Head+srv.maxSlippage,
-		srv.maxProposedIn,
-		req.TierFees,
+		maxProposedIn,
+		tierFees,
 	)
 	if err != nil {
 		log.Error("Failed to encode proverAssignment payload data", "error", err)
-		return echo.NewHTTPError(http.StatusUnprocessableEntity, err)
+		return nil, echo.NewHTTPError(http.StatusUnprocessableEntity, err)
 	}
 
-	signed, err := crypto.Sign(crypto.Keccak256Hash(encoded).Bytes(), srv.proverPrivateKey)
+	signed, err := srv.proverSigner.SignMessage(c.Request().Context(), encoded)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err)
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
 
-	return c.JSON(http.StatusOK, &ProposeBlockResponse{
+	return &ProposeBlockResponse{
 		SignedPayload: signed,
 		Prover:        srv.proverAddress,
 		MaxBlockID:    l1Head + srv.maxSlippage,
-		MaxProposedIn: srv.maxProposedIn,
-	})
+		MaxProposedIn: maxProposedIn,
+	}, nil
+}
+
+// SignedBlockResponse represents the JSON response which will be returned by
+// the GetSignedBlock request handler.
+type SignedBlockResponse struct {
+	*db.SignedBlock
+	Prover common.Address `json:"prover"`
+}
+
+// GetSignedBlocks handles a query of the blocks signed by the guardian prover.
+//
+//	@Summary		Get signed blocks
+//	@ID			   	get-signed-blocks
+//	@Param			startTimestamp	query	uint64	false	"minimum block timestamp"
+//	@Param			endTimestamp	query	uint64	false	"maximum block timestamp"
+//	@Param			startBlockID	query	uint64	false	"minimum block ID"
+//	@Param			endBlockID		query	uint64	false	"maximum block ID"
+//	@Param			offset			query	uint64	false	"number of blocks to skip"
+//	@Param			limit			query	uint64	false	"maximum number of blocks to return"
+//	@Produce		json
+//	@Success		200	{array} db.SignedBlock
+//	@Failure		400	{string} string	"invalid query parameters"
+//	@Failure		404	{string} string	"signed blocks database not found"
+//	@Router			/signedBlocks [get]
+func (srv *ProverServer) GetSignedBlocks(c echo.Context) error {
+	if srv.db == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "signed blocks database not found")
+	}
+
+	filter := &db.SignedBlockFilter{Limit: defaultSignedBlocksLimit}
+	if err := echo.QueryParamsBinder(c).
+		Uint64("startTimestamp", &filter.StartTimestamp).
+		Uint64("endTimestamp", &filter.EndTimestamp).
+		Uint64("startBlockID", &filter.StartBlockID).
+		Uint64("endBlockID", &filter.EndBlockID).
+		Uint64("offset", &filter.Offset).
+		Uint64("limit", &filter.Limit).
+		BindError(); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if filter.Limit == 0 || filter.Limit > maxSignedBlocksLimit {
+		filter.Limit = maxSignedBlocksLimit
+	}
+
+	blocks, err := db.GetSignedBlocks(srv.db, filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return c.JSON(http.StatusOK, blocks)
+}
+
+// GetSignedBlock handles a query of a block signed by the guardian prover, and recovers
+// the signer address from the signature.
+//
+//	@Summary		Get a signed block by block ID
+//	@ID			   	get-signed-block
+//	@Param			id	path	uint64	true	"block ID"
+//	@Produce		json
+//	@Success		200	{object} SignedBlockResponse
+//	@Failure		400	{string} string	"invalid block ID"
+//	@Failure		404	{string} string	"signed block not found"
+//	@Router			/signedBlock/{id} [get]
+func (srv *ProverServer) GetSignedBlock(c echo.Context) error {
+	if srv.db == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "signed blocks database not found")
+	}
+
+	blockID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid block ID")
+	}
+
+	block, err := db.GetSignedBlock(srv.db, blockID)
+	if err != nil {
+		if errors.Is(err, db.ErrSignedBlockNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "signed block not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	pubKey, err := crypto.SigToPub(block.BlockHash.Bytes(), common.Hex2Bytes(block.Signature))
+	if err != nil {
+		log.Error("Failed to recover signed block signer", "blockID", blockID, "error", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return c.JSON(http.StatusOK, &SignedBlockResponse{SignedBlock: block, Prover: crypto.PubkeyToAddress(*pubKey)})
 }
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -8,8 +9,10 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/prover/db"
 )
 
 func (s *ProverServerTestSuite) TestGetStatusSuccess() {
@@ -23,13 +26,28 @@ func (s *ProverServerTestSuite) TestGetStatusSuccess() {
 	s.Nil(err)
 	s.Nil(json.Unmarshal(b, &status))
 
-	s.Equal(s.s.minOptimisticTierFee.Uint64(), status.MinOptimisticTierFee)
-	s.Equal(s.s.minSgxTierFee.Uint64(), status.MinSgxTierFee)
-	s.Equal(s.s.minSgxAndPseZkevmTierFee.Uint64(), status.MinSgxTierFee)
+	minTierFees := s.s.tierFeePricer.MinTierFees()
+	s.Equal(minTierFees[encoding.TierOptimisticID].Uint64(), status.MinOptimisticTierFee)
+	s.Equal(minTierFees[encoding.TierSgxID].Uint64(), status.MinSgxTierFee)
+	s.Equal(minTierFees[encoding.TierPseZkevmID].Uint64(), status.MinPseZkevmTierFee)
+	s.Equal(minTierFees[encoding.TierSgxAndPseZkevmID].Uint64(), status.MinSgxAndPseZkevmTierFee)
 	s.Equal(uint64(s.s.maxExpiry.Seconds()), status.MaxExpiry)
+	s.Empty(status.FeeTokens)
 	s.NotEmpty(status.Prover)
 }
 
+func (s *ProverServerTestSuite) TestGetBondStatusNotEnabled() {
+	res := s.sendReq("/bond")
+	defer res.Body.Close()
+	s.Equal(http.StatusNotFound, res.StatusCode)
+}
+
+func (s *ProverServerTestSuite) TestGetLedgerNotEnabled() {
+	res := s.sendReq("/ledger")
+	defer res.Body.Close()
+	s.Equal(http.StatusNotFound, res.StatusCode)
+}
+
 func (s *ProverServerTestSuite) TestProposeBlockSuccess() {
 	data, err := json.Marshal(CreateAssignmentRequestBody{
 		FeeToken: (common.Address{}),
@@ -50,3 +68,135 @@ func (s *ProverServerTestSuite) TestProposeBlockSuccess() {
 	s.Nil(err)
 	s.Contains(string(b), "signedPayload")
 }
+
+func (s *ProverServerTestSuite) TestProposeBlockUnsupportedFeeToken() {
+	data, err := json.Marshal(CreateAssignmentRequestBody{
+		FeeToken: common.BigToAddress(common.Big1),
+		TierFees: []encoding.TierFee{
+			{Tier: encoding.TierOptimisticID, Fee: common.Big256},
+		},
+		Expiry:     uint64(time.Now().Add(time.Minute).Unix()),
+		TxListHash: common.BigToHash(common.Big1),
+	})
+	s.Nil(err)
+	res, err := http.Post(s.testServer.URL+"/assignment", "application/json", strings.NewReader(string(data)))
+	s.Nil(err)
+	s.Equal(http.StatusUnprocessableEntity, res.StatusCode)
+	defer res.Body.Close()
+	b, err := io.ReadAll(res.Body)
+	s.Nil(err)
+	s.Contains(string(b), "unsupported fee token")
+}
+
+func (s *ProverServerTestSuite) TestCreateQuoteSuccess() {
+	var (
+		expiry     = uint64(time.Now().Add(time.Minute).Unix())
+		txListHash = common.BigToHash(common.Big1)
+	)
+	data, err := json.Marshal(CreateQuoteRequestBody{
+		Tiers:      []uint16{encoding.TierOptimisticID, encoding.TierSgxID, encoding.TierGuardianID},
+		Expiry:     expiry,
+		TxListHash: txListHash,
+	})
+	s.Nil(err)
+	res, err := http.Post(s.testServer.URL+"/quote", "application/json", strings.NewReader(string(data)))
+	s.Nil(err)
+	s.Equal(http.StatusOK, res.StatusCode)
+	defer res.Body.Close()
+	b, err := io.ReadAll(res.Body)
+	s.Nil(err)
+
+	quote := new(QuoteResponse)
+	s.Nil(json.Unmarshal(b, quote))
+	s.Len(quote.TierFees, 3)
+	minTierFees := s.s.tierFeePricer.MinTierFees()
+	s.Equal(minTierFees[encoding.TierOptimisticID], quote.TierFees[0].Fee)
+	s.Equal(minTierFees[encoding.TierSgxID], quote.TierFees[1].Fee)
+	s.Zero(quote.TierFees[2].Fee.Sign())
+
+	payload, err := encoding.EncodeProverAssignmentPayload(
+		s.s.protocolConfigs.ChainId,
+		s.s.taikoL1Address,
+		s.s.assignmentHookAddress,
+		txListHash,
+		common.Address{},
+		expiry,
+		quote.MaxBlockID,
+		quote.MaxProposedIn,
+		quote.TierFees,
+	)
+	s.Nil(err)
+	pubKey, err := crypto.SigToPub(crypto.Keccak256(payload), quote.SignedPayload)
+	s.Nil(err)
+	s.Equal(s.s.proverAddress, crypto.PubkeyToAddress(*pubKey))
+}
+
+func (s *ProverServerTestSuite) TestCreateQuoteDryRun() {
+	data, err := json.Marshal(CreateQuoteRequestBody{
+		Tiers:      []uint16{encoding.TierOptimisticID},
+		Expiry:     uint64(time.Now().Add(time.Minute).Unix()),
+		TxListHash: common.BigToHash(common.Big1),
+		DryRun:     true,
+	})
+	s.Nil(err)
+	res, err := http.Post(s.testServer.URL+"/quote", "application/json", strings.NewReader(string(data)))
+	s.Nil(err)
+	s.Equal(http.StatusOK, res.StatusCode)
+	defer res.Body.Close()
+	b, err := io.ReadAll(res.Body)
+	s.Nil(err)
+
+	// The dry-run assignment can't be proposed in any block after the current L1 head.
+	quote := new(QuoteResponse)
+	s.Nil(json.Unmarshal(b, quote))
+	l1Head, err := s.s.rpc.L1.BlockNumber(context.Background())
+	s.Nil(err)
+	s.NotZero(quote.MaxProposedIn)
+	s.LessOrEqual(quote.MaxProposedIn, l1Head)
+}
+
+func (s *ProverServerTestSuite) TestGetSignedBlocks() {
+	hash := common.BigToHash(common.Big1)
+	sig, err := s.s.proverSigner.SignMessage(context.Background(), hash.Bytes())
+	s.Nil(err)
+	s.Nil(db.WriteSignedBlock(s.s.db, 1, common.Big1, hash.Bytes(), sig))
+
+	res := s.sendReq("/signedBlocks?startBlockID=1&endBlockID=1")
+	s.Equal(http.StatusOK, res.StatusCode)
+
+	var blocks []*db.SignedBlock
+	defer res.Body.Close()
+	b, err := io.ReadAll(res.Body)
+	s.Nil(err)
+	s.Nil(json.Unmarshal(b, &blocks))
+	s.Len(blocks, 1)
+	s.Equal(hash, blocks[0].BlockHash)
+
+	res = s.sendReq("/signedBlocks?limit=invalid")
+	defer res.Body.Close()
+	s.Equal(http.StatusBadRequest, res.StatusCode)
+}
+
+func (s *ProverServerTestSuite) TestGetSignedBlock() {
+	res := s.sendReq("/signedBlock/0")
+	defer res.Body.Close()
+	s.Equal(http.StatusNotFound, res.StatusCode)
+
+	// The signed block hash is the keccak256 hash of the signed message.
+	msg := []byte("block")
+	hash := crypto.Keccak256Hash(msg)
+	sig, err := s.s.proverSigner.SignMessage(context.Background(), msg)
+	s.Nil(err)
+	s.Nil(db.WriteSignedBlock(s.s.db, 1, common.Big2, hash.Bytes(), sig))
+
+	res = s.sendReq("/signedBlock/2")
+	s.Equal(http.StatusOK, res.StatusCode)
+
+	block := new(SignedBlockResponse)
+	defer res.Body.Close()
+	b, err := io.ReadAll(res.Body)
+	s.Nil(err)
+	s.Nil(json.Unmarshal(b, block))
+	s.Equal(hash, block.BlockHash)
+	s.Equal(s.s.proverAddress, block.Prover)
+}
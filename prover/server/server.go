@@ -2,20 +2,23 @@ package server
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"math/big"
 	"net/http"
 	"os"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
 	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
+	bond "github.com/taikoxyz/taiko-client/prover/bond_manager"
+	"github.com/taikoxyz/taiko-client/prover/ledger"
+	"github.com/taikoxyz/taiko-client/prover/pricing"
 )
 
 // @title Taiko Prover Server API
@@ -31,33 +34,33 @@ import (
 
 // ProverServer represents a prover server instance.
 type ProverServer struct {
-	echo                     *echo.Echo
-	proverPrivateKey         *ecdsa.PrivateKey
-	proverAddress            common.Address
-	minOptimisticTierFee     *big.Int
-	minSgxTierFee            *big.Int
-	minPseZkevmTierFee       *big.Int
-	minSgxAndPseZkevmTierFee *big.Int
-	maxExpiry                time.Duration
-	maxSlippage              uint64
-	maxProposedIn            uint64
-	proposeConcurrencyGuard  chan struct{}
-	taikoL1Address           common.Address
-	assignmentHookAddress    common.Address
-	rpc                      *rpc.Client
-	protocolConfigs          *bindings.TaikoDataConfig
-	livenessBond             *big.Int
-	isGuardian               bool
-	db                       ethdb.KeyValueStore
+	echo                    *echo.Echo
+	proverSigner            signer.Signer
+	proverAddress           common.Address
+	tierFeePricer           *pricing.Pricer
+	maxExpiry               time.Duration
+	maxSlippage             uint64
+	maxProposedIn           uint64
+	proposeConcurrencyGuard chan struct{}
+	taikoL1Address          common.Address
+	assignmentHookAddress   common.Address
+	rpc                     *rpc.Client
+	protocolConfigs         *bindings.TaikoDataConfig
+	livenessBond            *big.Int
+	isGuardian              bool
+	db                      ethdb.KeyValueStore
+	bondManager             *bond.Manager
+	ledger                  *ledger.Ledger
 }
 
 // NewProverServerOpts contains all configurations for creating a prover server instance.
 type NewProverServerOpts struct {
-	ProverPrivateKey         *ecdsa.PrivateKey
+	ProverSigner             signer.Signer
 	MinOptimisticTierFee     *big.Int
 	MinSgxTierFee            *big.Int
 	MinPseZkevmTierFee       *big.Int
 	MinSgxAndPseZkevmTierFee *big.Int
+	TierFeePricer            *pricing.Pricer
 	MaxExpiry                time.Duration
 	MaxBlockSlippage         uint64
 	MaxProposedIn            uint64
@@ -69,29 +72,42 @@ type NewProverServerOpts struct {
 	LivenessBond             *big.Int
 	IsGuardian               bool
 	DB                       ethdb.KeyValueStore
+	BondManager              *bond.Manager
+	Ledger                   *ledger.Ledger
 }
 
 // New creates a new prover server instance.
 func New(opts *NewProverServerOpts) (*ProverServer, error) {
 	srv := &ProverServer{
-		proverPrivateKey:         opts.ProverPrivateKey,
-		proverAddress:            crypto.PubkeyToAddress(opts.ProverPrivateKey.PublicKey),
-		echo:                     echo.New(),
-		minOptimisticTierFee:     opts.MinOptimisticTierFee,
-		minSgxTierFee:            opts.MinSgxTierFee,
-		minPseZkevmTierFee:       opts.MinPseZkevmTierFee,
-		minSgxAndPseZkevmTierFee: opts.MinSgxAndPseZkevmTierFee,
-		maxExpiry:                opts.MaxExpiry,
-		maxProposedIn:            opts.MaxProposedIn,
-		maxSlippage:              opts.MaxBlockSlippage,
-		proposeConcurrencyGuard:  opts.ProposeConcurrencyGuard,
-		taikoL1Address:           opts.TaikoL1Address,
-		assignmentHookAddress:    opts.AssignmentHookAddress,
-		rpc:                      opts.RPC,
-		protocolConfigs:          opts.ProtocolConfigs,
-		livenessBond:             opts.LivenessBond,
-		isGuardian:               opts.IsGuardian,
-		db:                       opts.DB,
+		proverSigner:            opts.ProverSigner,
+		proverAddress:           opts.ProverSigner.Address(),
+		echo:                    echo.New(),
+		tierFeePricer:           opts.TierFeePricer,
+		maxExpiry:               opts.MaxExpiry,
+		maxProposedIn:           opts.MaxProposedIn,
+		maxSlippage:             opts.MaxBlockSlippage,
+		proposeConcurrencyGuard: opts.ProposeConcurrencyGuard,
+		taikoL1Address:          opts.TaikoL1Address,
+		assignmentHookAddress:   opts.AssignmentHookAddress,
+		rpc:                     opts.RPC,
+		protocolConfigs:         opts.ProtocolConfigs,
+		livenessBond:            opts.LivenessBond,
+		isGuardian:              opts.IsGuardian,
+		db:                      opts.DB,
+		bondManager:             opts.BondManager,
+		ledger:                  opts.Ledger,
+	}
+
+	// Only accept the static minimum tier fees, if no pricing engine is given.
+	if srv.tierFeePricer == nil {
+		srv.tierFeePricer = pricing.New(&pricing.Config{
+			MinTierFees: map[uint16]*big.Int{
+				encoding.TierOptimisticID:     opts.MinOptimisticTierFee,
+				encoding.TierSgxID:            opts.MinSgxTierFee,
+				encoding.TierPseZkevmID:       opts.MinPseZkevmTierFee,
+				encoding.TierSgxAndPseZkevmID: opts.MinSgxAndPseZkevmTierFee,
+			},
+		}, nil, nil)
 	}
 
 	srv.echo.HideBanner = true
@@ -145,5 +161,10 @@ func (srv *ProverServer) configureRoutes() {
 	srv.echo.GET("/", srv.Health)
 	srv.echo.GET("/healthz", srv.Health)
 	srv.echo.GET("/status", srv.GetStatus)
+	srv.echo.GET("/bond", srv.GetBondStatus)
+	srv.echo.GET("/ledger", srv.GetLedger)
 	srv.echo.POST("/assignment", srv.CreateAssignment)
+	srv.echo.POST("/quote", srv.CreateQuote)
+	srv.echo.GET("/signedBlocks", srv.GetSignedBlocks)
+	srv.echo.GET("/signedBlock/:id", srv.GetSignedBlock)
 }
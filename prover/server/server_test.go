@@ -19,6 +19,7 @@ import (
 	"github.com/phayes/freeport"
 	"github.com/stretchr/testify/suite"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/pkg/signer"
 )
 
 type ProverServerTestSuite struct {
@@ -48,7 +49,7 @@ func (s *ProverServerTestSuite) SetupTest() {
 	s.Nil(err)
 
 	p, err := New(&NewProverServerOpts{
-		ProverPrivateKey:         l1ProverPrivKey,
+		ProverSigner:             signer.NewLocalSigner(l1ProverPrivKey),
 		MinOptimisticTierFee:     common.Big1,
 		MinSgxTierFee:            common.Big1,
 		MinPseZkevmTierFee:       common.Big1,
@@ -0,0 +1,59 @@
+package prover
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	txListValidator "github.com/taikoxyz/taiko-client/pkg/txlistvalidator"
+	"github.com/taikoxyz/taiko-client/prover/db"
+)
+
+// checkTxList validates the transactions list of the given proposed block the same way the driver does
+// when deriving it, and records the hint and diagnostics in the block's proof job, so that it's clear why
+// the proven block is an empty one, or which proposed transactions it skips. The prover doesn't fetch blobs,
+// so the transactions lists in blobs are not checked here, the driver status reports them instead.
+func (p *Prover) checkTxList(ctx context.Context, e *bindings.TaikoL1ClientBlockProposed) {
+	if e.Meta.BlobUsed {
+		log.Debug("Transactions list in blob, skip checking it", "blockID", e.BlockId)
+		return
+	}
+
+	tx, err := p.rpc.L1.TransactionInBlock(ctx, e.Raw.BlockHash, e.Raw.TxIndex)
+	if err != nil {
+		log.Warn("Failed to fetch TaikoL1.proposeBlock transaction", "blockID", e.BlockId, "error", err)
+		return
+	}
+
+	txListBytes, hint, err := p.txListValidator.ValidateTxList(e.BlockId, tx.Data())
+	if err != nil {
+		log.Warn("Failed to validate transactions list", "blockID", e.BlockId, "error", err)
+		return
+	}
+
+	var diagnostics []*txListValidator.TxDiagnostic
+	if hint == txListValidator.HintOK {
+		// The fee caps are only checked if the L2 block has already been derived.
+		var baseFee *big.Int
+		if header, err := p.rpc.L2.HeaderByNumber(ctx, e.BlockId); err == nil {
+			baseFee = header.BaseFee
+		}
+		diagnostics = p.txListValidator.DiagnoseTxList(e.BlockId, txListBytes, baseFee)
+	}
+
+	if hint != txListValidator.HintOK || len(diagnostics) != 0 {
+		log.Info(
+			"Proposed transactions list not fully included",
+			"blockID", e.BlockId,
+			"hint", hint,
+			"skippedTransactions", len(diagnostics),
+		)
+	}
+
+	p.updateProofJob(e.BlockId, func(job *db.ProofJob) {
+		job.TxListHint = hint
+		job.TxDiagnostics = diagnostics
+	})
+}
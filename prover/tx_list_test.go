@@ -0,0 +1,147 @@
+package prover
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/internal/testutils"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	txListValidator "github.com/taikoxyz/taiko-client/pkg/txlistvalidator"
+	"github.com/taikoxyz/taiko-client/prover/db"
+)
+
+var testTxListChainID = big.NewInt(167)
+
+// testTxListService is a mock of the `eth` namespace of a L1 node serving a TaikoL1.proposeBlock
+// transaction, and of a L2 node serving the derived block header.
+type testTxListService struct {
+	tx      *types.Transaction
+	baseFee *big.Int
+}
+
+func (s *testTxListService) GetTransactionByBlockHashAndIndex(common.Hash, hexutil.Uint) (*types.Transaction, error) {
+	return s.tx, nil
+}
+
+func (s *testTxListService) GetBlockByNumber(number hexutil.Uint64, _ bool) (*types.Header, error) {
+	return &types.Header{Number: new(big.Int).SetUint64(uint64(number)), Difficulty: common.Big0, BaseFee: s.baseFee}, nil
+}
+
+func newTestTxListProver(t *testing.T, txList []byte) *Prover {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	data, err := encoding.TaikoL1ABI.Pack("proposeBlock", []byte{}, txList)
+	require.Nil(t, err)
+
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(common.Big1), &types.DynamicFeeTx{
+		ChainID:   common.Big1,
+		Gas:       1_000_000,
+		GasTipCap: common.Big1,
+		GasFeeCap: common.Big1,
+		Data:      data,
+	})
+	require.Nil(t, err)
+
+	rpcServer := gethRPC.NewServer()
+	require.Nil(t, rpcServer.RegisterName("eth", &testTxListService{tx: tx, baseFee: big.NewInt(10)}))
+	t.Cleanup(rpcServer.Stop)
+
+	server := httptest.NewServer(rpcServer)
+	t.Cleanup(server.Close)
+
+	client, err := rpc.NewEthClient(context.Background(), server.URL, time.Second)
+	require.Nil(t, err)
+	t.Cleanup(client.Close)
+
+	return &Prover{
+		rpc:             &rpc.Client{L1: client, L2: client},
+		txListValidator: txListValidator.NewTxListValidator(1_000_000, 10, 10_000, testTxListChainID),
+		proofJobs:       db.NewProofJobStore(memorydb.New()),
+	}
+}
+
+func newTestL2Tx(t *testing.T, gasFeeCap *big.Int) *types.Transaction {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(testTxListChainID), &types.DynamicFeeTx{
+		ChainID:   testTxListChainID,
+		To:        &common.Address{},
+		Gas:       21_000,
+		GasTipCap: common.Big0,
+		GasFeeCap: gasFeeCap,
+	})
+	require.Nil(t, err)
+
+	return tx
+}
+
+func TestCheckTxList(t *testing.T) {
+	underpriced := newTestL2Tx(t, common.Big1)
+	txList, err := rlp.EncodeToBytes(types.Transactions{newTestL2Tx(t, big.NewInt(10)), underpriced})
+	require.Nil(t, err)
+
+	tests := []struct {
+		name        string
+		txList      []byte
+		blobUsed    bool
+		hint        txListValidator.InvalidTxListReason
+		diagnostics []*txListValidator.TxDiagnostic
+	}{
+		{
+			"skipped transaction",
+			txList,
+			false,
+			txListValidator.HintOK,
+			[]*txListValidator.TxDiagnostic{
+				{Index: 1, Hash: underpriced.Hash(), Reason: txListValidator.HintTxFeeCapTooLow},
+			},
+		},
+		{
+			"invalid transactions list",
+			testutils.RandomBytes(32),
+			false,
+			txListValidator.HintBinaryNotDecodable,
+			nil,
+		},
+		{
+			"transactions list in blob",
+			txList,
+			true,
+			txListValidator.HintNone,
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestTxListProver(t, tt.txList)
+			require.Nil(t, p.proofJobs.Put(&db.ProofJob{BlockID: 1, Status: db.ProofJobGenerating}))
+
+			e := &bindings.TaikoL1ClientBlockProposed{BlockId: common.Big1}
+			e.Meta.BlobUsed = tt.blobUsed
+			p.checkTxList(context.Background(), e)
+
+			// The result is persisted along with the proof job.
+			job, err := p.proofJobs.Get(1)
+			require.Nil(t, err)
+			require.Equal(t, tt.hint, job.TxListHint)
+			require.Equal(t, tt.diagnostics, job.TxDiagnostics)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package replayer
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-client/pkg/jwt"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+var (
+	errNoReplayRange       = errors.New("either a L2 block ID range or a L1 block range is required")
+	errMultipleReplayRange = errors.New("only one of the L2 block ID range and the L1 block range can be set")
+)
+
+// Config contains the configurations to initialize a Taiko derivation replayer.
+type Config struct {
+	*rpc.ClientConfig
+	StartBlockID     uint64
+	EndBlockID       uint64
+	L1Start          uint64
+	L1End            uint64
+	ReportPath       string
+	RPCTimeout       time.Duration
+	L1BeaconEndpoint string
+	BlobArchiveDir   string
+}
+
+// NewConfigFromCliContext creates a new config instance from
+// the command line inputs.
+func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
+	jwtSecret, err := jwt.ParseSecretFromFile(c.String(flags.JWTSecret.Name))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT secret file: %w", err)
+	}
+
+	var (
+		startBlockID = c.Uint64(flags.ReplayStartBlockID.Name)
+		endBlockID   = c.Uint64(flags.ReplayEndBlockID.Name)
+		l1Start      = c.Uint64(flags.ReplayL1Start.Name)
+		l1End        = c.Uint64(flags.ReplayL1End.Name)
+		byBlockID    = startBlockID != 0 || endBlockID != 0
+		byL1Height   = l1Start != 0 || l1End != 0
+	)
+
+	switch {
+	case byBlockID && byL1Height:
+		return nil, errMultipleReplayRange
+	case byBlockID:
+		if startBlockID == 0 || endBlockID < startBlockID {
+			return nil, fmt.Errorf("invalid L2 block ID range [%d, %d]", startBlockID, endBlockID)
+		}
+	case byL1Height:
+		if l1Start == 0 || l1End < l1Start {
+			return nil, fmt.Errorf("invalid L1 block range [%d, %d]", l1Start, l1End)
+		}
+	default:
+		return nil, errNoReplayRange
+	}
+
+	var timeout = c.Duration(flags.RPCTimeout.Name)
+	return &Config{
+		ClientConfig: &rpc.ClientConfig{
+			L1Endpoint:          c.String(flags.L1WSEndpoint.Name),
+			L1FallbackEndpoints: c.StringSlice(flags.L1FallbackEndpoints.Name),
+			L2Endpoint:          c.String(flags.L2WSEndpoint.Name),
+			L2FallbackEndpoints: c.StringSlice(flags.L2FallbackEndpoints.Name),
+			TaikoL1Address:      common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
+			TaikoL2Address:      common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
+			L2EngineEndpoint:    c.String(flags.L2AuthEndpoint.Name),
+			JwtSecret:           string(jwtSecret),
+			RetryInterval:       c.Duration(flags.BackOffRetryInterval.Name),
+			Timeout:             timeout,
+			HealthCheck: &rpc.HealthCheckConfig{
+				Interval:     c.Duration(flags.RPCHealthCheckInterval.Name),
+				MaxHeadLag:   c.Uint64(flags.RPCMaxHeadLag.Name),
+				MaxLatency:   c.Duration(flags.RPCMaxLatency.Name),
+				MaxErrorRate: c.Float64(flags.RPCMaxErrorRate.Name),
+			},
+		},
+		StartBlockID:     startBlockID,
+		EndBlockID:       endBlockID,
+		L1Start:          l1Start,
+		L1End:            l1End,
+		ReportPath:       c.String(flags.ReplayReport.Name),
+		RPCTimeout:       timeout,
+		L1BeaconEndpoint: c.String(flags.L1BeaconEndpoint.Name),
+		BlobArchiveDir:   c.String(flags.BlobArchiveDir.Name),
+	}, nil
+}
@@ -0,0 +1,67 @@
+package replayer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-client/cmd/flags"
+)
+
+func runConfigApp(args ...string) (*Config, error) {
+	var (
+		app    = cli.NewApp()
+		config *Config
+		err    error
+	)
+	app.Flags = []cli.Flag{
+		&cli.StringFlag{Name: flags.JWTSecret.Name},
+		&cli.Uint64Flag{Name: flags.ReplayStartBlockID.Name},
+		&cli.Uint64Flag{Name: flags.ReplayEndBlockID.Name},
+		&cli.Uint64Flag{Name: flags.ReplayL1Start.Name},
+		&cli.Uint64Flag{Name: flags.ReplayL1End.Name},
+		&cli.StringFlag{Name: flags.ReplayReport.Name},
+	}
+	app.Action = func(ctx *cli.Context) error {
+		config, err = NewConfigFromCliContext(ctx)
+		return nil
+	}
+
+	if runErr := app.Run(append([]string{"TestNewConfigFromCliContext"}, args...)); runErr != nil {
+		return nil, runErr
+	}
+
+	return config, err
+}
+
+func TestNewConfigFromCliContext(t *testing.T) {
+	c, err := runConfigApp(
+		"--"+flags.ReplayStartBlockID.Name, "10",
+		"--"+flags.ReplayEndBlockID.Name, "20",
+		"--"+flags.ReplayReport.Name, "report.json",
+	)
+	require.Nil(t, err)
+	require.Equal(t, uint64(10), c.StartBlockID)
+	require.Equal(t, uint64(20), c.EndBlockID)
+	require.Equal(t, "report.json", c.ReportPath)
+
+	c, err = runConfigApp("--"+flags.ReplayL1Start.Name, "100", "--"+flags.ReplayL1End.Name, "100")
+	require.Nil(t, err)
+	require.Equal(t, uint64(100), c.L1Start)
+	require.Equal(t, uint64(100), c.L1End)
+}
+
+func TestNewConfigFromCliContextInvalidRange(t *testing.T) {
+	_, err := runConfigApp()
+	require.ErrorIs(t, err, errNoReplayRange)
+
+	_, err = runConfigApp("--"+flags.ReplayStartBlockID.Name, "1", "--"+flags.ReplayL1End.Name, "1")
+	require.ErrorIs(t, err, errMultipleReplayRange)
+
+	_, err = runConfigApp("--"+flags.ReplayStartBlockID.Name, "2", "--"+flags.ReplayEndBlockID.Name, "1")
+	require.ErrorContains(t, err, "invalid L2 block ID range")
+
+	_, err = runConfigApp("--"+flags.ReplayL1End.Name, "1")
+	require.ErrorContains(t, err, "invalid L1 block range")
+}
@@ -0,0 +1,276 @@
+package replayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/driver/chain_syncer/calldata"
+	"github.com/taikoxyz/taiko-client/pkg/blobsource"
+	eventIterator "github.com/taikoxyz/taiko-client/pkg/chain_iterator/event_iterator"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+var (
+	errNoReplayEngine     = errors.New("L2 execution engine endpoint to replay the blocks is required")
+	errReplayEngineChain  = errors.New("replay engine is not on the audited L2 chain")
+	errReplayEngineIsNode = errors.New("replay engine has the audited L2 node's head, it must be a separate node")
+)
+
+// Replayer re-derives the proposed L2 blocks from L1 with the same logic as the driver, on a separate
+// L2 execution engine, and compares the results against the blocks in the audited L2 node. The audited
+// node is only read through its public RPC endpoint, so its fork choice is never changed.
+//
+// Each replayed block moves the replay engine's fork choice, so the replay engine must be a separate
+// node of the same chain which no driver follows, and it must already hold the state of the parent of
+// each replayed block.
+type Replayer struct {
+	*Config
+	rpc    *rpc.Client
+	engine *ethclient.Client
+	syncer *calldata.Syncer
+	report *Report
+
+	ctx context.Context
+}
+
+// InitFromCli initializes the given replayer instance based on the command line flags.
+func (r *Replayer) InitFromCli(ctx context.Context, c *cli.Context) error {
+	cfg, err := NewConfigFromCliContext(c)
+	if err != nil {
+		return err
+	}
+
+	return r.InitFromConfig(ctx, cfg)
+}
+
+// InitFromConfig initializes the replayer instance based on the given configurations.
+func (r *Replayer) InitFromConfig(ctx context.Context, cfg *Config) (err error) {
+	r.ctx = ctx
+	r.Config = cfg
+	r.report = new(Report)
+
+	if r.rpc, err = rpc.NewClient(r.ctx, cfg.ClientConfig); err != nil {
+		return err
+	}
+	if r.rpc.L2Engine == nil {
+		return errNoReplayEngine
+	}
+	r.engine = ethclient.NewClient(r.rpc.L2Engine.Client)
+
+	if err := r.checkReplayEngine(ctx); err != nil {
+		return err
+	}
+
+	signalServiceAddress, err := r.rpc.TaikoL1.Resolve0(
+		&bind.CallOpts{Context: ctx},
+		rpc.StringToBytes32("signal_service"),
+		false,
+	)
+	if err != nil {
+		return err
+	}
+
+	blobSource, err := blobsource.New(cfg.BlobArchiveDir, cfg.L1BeaconEndpoint, cfg.RPCTimeout)
+	if err != nil {
+		return err
+	}
+
+	// The syncer is only used to derive the blocks, so it doesn't need a driver state.
+	if r.syncer, err = calldata.NewSyncer(ctx, r.rpc, nil, nil, signalServiceAddress, blobSource, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Name returns the application name.
+func (r *Replayer) Name() string {
+	return "replay"
+}
+
+// Start replays all the blocks in the configured range, and then writes the replay report.
+func (r *Replayer) Start() error {
+	l1Start, l1End, err := r.l1Range()
+	if err != nil {
+		return err
+	}
+
+	log.Info("Start replaying L2 blocks", "l1Start", l1Start, "l1End", l1End)
+
+	iter, err := eventIterator.NewBlockProposedIterator(r.ctx, &eventIterator.BlockProposedIteratorConfig{
+		Client:               r.rpc.L1,
+		TaikoL1:              r.rpc.TaikoL1,
+		StartHeight:          l1Start,
+		EndHeight:            l1End,
+		OnBlockProposedEvent: r.onBlockProposed,
+	})
+	if err != nil {
+		return err
+	}
+	if err := iter.Iter(); err != nil {
+		return err
+	}
+
+	log.Info(
+		"Finished replaying L2 blocks",
+		"matched", r.report.Matched,
+		"mismatched", r.report.Mismatched,
+		"failed", r.report.Failed,
+	)
+
+	return r.writeReport()
+}
+
+// Close closes the replayer instance.
+func (r *Replayer) Close(_ context.Context) {}
+
+// checkReplayEngine checks that the replay engine is on the same chain as the audited L2 node, but is
+// not the audited node itself, whose fork choice would otherwise be changed by the replayed blocks.
+func (r *Replayer) checkReplayEngine(ctx context.Context) error {
+	chainID, err := r.engine.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get replay engine chain ID: %w", err)
+	}
+	if chainID.Cmp(r.rpc.L2ChainID) != 0 {
+		return fmt.Errorf("%w: chain ID %d != %d", errReplayEngineChain, chainID, r.rpc.L2ChainID)
+	}
+
+	genesis, err := r.engine.HeaderByNumber(ctx, common.Big0)
+	if err != nil {
+		return fmt.Errorf("failed to get replay engine genesis block: %w", err)
+	}
+	auditedGenesis, err := r.rpc.L2.HeaderByNumber(ctx, common.Big0)
+	if err != nil {
+		return fmt.Errorf("failed to get audited L2 genesis block: %w", err)
+	}
+	if genesis.Hash() != auditedGenesis.Hash() {
+		return fmt.Errorf("%w: genesis %s != %s", errReplayEngineChain, genesis.Hash(), auditedGenesis.Hash())
+	}
+
+	// A replay engine which no driver follows can't have the latest block of the audited node.
+	auditedHead, err := r.rpc.L2.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get audited L2 head: %w", err)
+	}
+	if auditedHead.Number.Sign() == 0 {
+		return nil
+	}
+	if _, err := r.engine.HeaderByHash(ctx, auditedHead.Hash()); err == nil {
+		return fmt.Errorf("%w: head %d (%s)", errReplayEngineIsNode, auditedHead.Number, auditedHead.Hash())
+	} else if !errors.Is(err, ethereum.NotFound) {
+		return fmt.Errorf("failed to get audited L2 head from replay engine: %w", err)
+	}
+
+	return nil
+}
+
+// l1Range returns the L1 block range to replay, if a L2 block ID range is configured, the L1 range
+// is the range of the L1 blocks in which the first and the last L2 blocks were proposed.
+func (r *Replayer) l1Range() (*big.Int, *big.Int, error) {
+	if r.StartBlockID == 0 {
+		return new(big.Int).SetUint64(r.L1Start), new(big.Int).SetUint64(r.L1End), nil
+	}
+
+	start, err := r.rpc.L2.L1OriginByID(r.ctx, new(big.Int).SetUint64(r.StartBlockID))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get L1 origin of block %d: %w", r.StartBlockID, err)
+	}
+	end, err := r.rpc.L2.L1OriginByID(r.ctx, new(big.Int).SetUint64(r.EndBlockID))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get L1 origin of block %d: %w", r.EndBlockID, err)
+	}
+
+	return start.L1BlockHeight, end.L1BlockHeight, nil
+}
+
+// onBlockProposed is a `BlockProposed` event callback which replays the proposed block, and records
+// the result into the replay report.
+func (r *Replayer) onBlockProposed(
+	ctx context.Context,
+	event *bindings.TaikoL1ClientBlockProposed,
+	_ eventIterator.EndBlockProposedEventIterFunc,
+) error {
+	blockID := event.BlockId.Uint64()
+	if blockID == 0 || (r.StartBlockID != 0 && (blockID < r.StartBlockID || blockID > r.EndBlockID)) {
+		return nil
+	}
+
+	result := &BlockResult{BlockID: blockID, L1Height: event.Raw.BlockNumber}
+	if err := r.replayBlock(ctx, event, result); err != nil {
+		result.Error = err.Error()
+	}
+	r.report.Add(result)
+
+	log.Info(
+		"L2 block replayed",
+		"blockID", blockID,
+		"expectedHash", result.ExpectedHash,
+		"replayedHash", result.ReplayedHash,
+		"match", result.Match,
+		"mismatches", result.Mismatches,
+		"error", result.Error,
+	)
+
+	return nil
+}
+
+// replayBlock re-derives the given proposed block on top of its parent in the audited L2 node, and
+// compares the result against the block in the audited L2 node.
+func (r *Replayer) replayBlock(
+	ctx context.Context,
+	event *bindings.TaikoL1ClientBlockProposed,
+	result *BlockResult,
+) error {
+	expected, err := r.rpc.L2.BlockByNumber(ctx, event.BlockId)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L2 block: %w", err)
+	}
+	result.ExpectedHash = expected.Hash()
+
+	parent, err := r.rpc.L2ParentByBlockID(ctx, event.BlockId)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L2 parent block: %w", err)
+	}
+	if _, err := r.engine.HeaderByHash(ctx, parent.Hash()); err != nil {
+		return fmt.Errorf("replay engine doesn't hold the parent block %d: %w", parent.Number, err)
+	}
+
+	payload, hint, diagnostics, err := r.syncer.InsertBlock(ctx, event, parent, event.BlockId)
+	if err != nil {
+		return err
+	}
+
+	result.ReplayedHash = payload.BlockHash
+	result.TxListHint = hint.String()
+	result.TxDiagnostics = diagnostics
+	result.Mismatches = compareBlock(payload, expected)
+	result.Match = payload.BlockHash == result.ExpectedHash && len(result.Mismatches) == 0
+
+	return nil
+}
+
+// writeReport writes the replay report to the configured path, or stdout if no path is configured.
+func (r *Replayer) writeReport() error {
+	if r.ReportPath == "" {
+		return r.report.Write(os.Stdout)
+	}
+
+	f, err := os.Create(r.ReportPath)
+	if err != nil {
+		return fmt.Errorf("failed to create replay report: %w", err)
+	}
+	defer f.Close()
+
+	return r.report.Write(f)
+}
@@ -0,0 +1,122 @@
+package replayer
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+// testL2Service is a mock of the `eth` namespace of a L2 node.
+type testL2Service struct {
+	chainID *big.Int
+	headers []*types.Header
+}
+
+func (s *testL2Service) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(s.chainID)
+}
+
+func (s *testL2Service) GetBlockByNumber(number gethRPC.BlockNumber, _ bool) (*types.Header, error) {
+	if number == gethRPC.LatestBlockNumber {
+		return s.headers[len(s.headers)-1], nil
+	}
+	if int(number) >= len(s.headers) {
+		return nil, nil
+	}
+	return s.headers[number], nil
+}
+
+func (s *testL2Service) GetBlockByHash(hash common.Hash, _ bool) (*types.Header, error) {
+	for _, header := range s.headers {
+		if header.Hash() == hash {
+			return header, nil
+		}
+	}
+	return nil, nil
+}
+
+func newTestL2Headers(genesisExtra byte, n int) []*types.Header {
+	headers := make([]*types.Header, n)
+	for i := range headers {
+		headers[i] = &types.Header{Number: big.NewInt(int64(i)), Difficulty: common.Big0, Extra: []byte{genesisExtra}}
+		if i > 0 {
+			headers[i].ParentHash = headers[i-1].Hash()
+		}
+	}
+	return headers
+}
+
+func newTestL2Server(t *testing.T, service *testL2Service) string {
+	rpcServer := gethRPC.NewServer()
+	require.Nil(t, rpcServer.RegisterName("eth", service))
+	t.Cleanup(rpcServer.Stop)
+
+	server := httptest.NewServer(rpcServer)
+	t.Cleanup(server.Close)
+
+	return server.URL
+}
+
+func TestCheckReplayEngine(t *testing.T) {
+	audited := newTestL2Headers(0, 10)
+
+	tests := []struct {
+		name   string
+		engine *testL2Service
+		err    error
+	}{
+		{
+			"separate node",
+			&testL2Service{chainID: common.Big1, headers: audited[:5]},
+			nil,
+		},
+		{
+			"different chain ID",
+			&testL2Service{chainID: common.Big2, headers: audited[:5]},
+			errReplayEngineChain,
+		},
+		{
+			"different genesis",
+			&testL2Service{chainID: common.Big1, headers: newTestL2Headers(1, 5)},
+			errReplayEngineChain,
+		},
+		{
+			"audited node",
+			&testL2Service{chainID: common.Big1, headers: audited},
+			errReplayEngineIsNode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l2, err := rpc.NewEthClient(
+				context.Background(),
+				newTestL2Server(t, &testL2Service{chainID: common.Big1, headers: audited}),
+				time.Second,
+			)
+			require.Nil(t, err)
+			t.Cleanup(l2.Close)
+
+			engine, err := gethRPC.Dial(newTestL2Server(t, tt.engine))
+			require.Nil(t, err)
+			t.Cleanup(engine.Close)
+
+			r := &Replayer{
+				rpc:    &rpc.Client{L2: l2, L2ChainID: common.Big1},
+				engine: ethclient.NewClient(engine),
+			}
+			require.ErrorIs(t, r.checkReplayEngine(context.Background()), tt.err)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package replayer
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+
+	txListValidator "github.com/taikoxyz/taiko-client/pkg/txlistvalidator"
+)
+
+// BlockResult is the replay result of a single L2 block.
+type BlockResult struct {
+	BlockID       uint64                          `json:"blockID"`
+	L1Height      uint64                          `json:"l1Height"`
+	ExpectedHash  common.Hash                     `json:"expectedHash"`
+	ReplayedHash  common.Hash                     `json:"replayedHash"`
+	Match         bool                            `json:"match"`
+	TxListHint    string                          `json:"txListHint"`
+	TxDiagnostics []*txListValidator.TxDiagnostic `json:"txDiagnostics,omitempty"`
+	Mismatches    []string                        `json:"mismatches,omitempty"`
+	Error         string                          `json:"error,omitempty"`
+}
+
+// Report is the replay report of all the replayed L2 blocks.
+type Report struct {
+	Blocks     []*BlockResult `json:"blocks"`
+	Matched    int            `json:"matched"`
+	Mismatched int            `json:"mismatched"`
+	Failed     int            `json:"failed"`
+}
+
+// Add adds the given block result to the report.
+func (r *Report) Add(result *BlockResult) {
+	r.Blocks = append(r.Blocks, result)
+
+	switch {
+	case result.Error != "":
+		r.Failed++
+	case result.Match:
+		r.Matched++
+	default:
+		r.Mismatched++
+	}
+}
+
+// Write writes the report to the given writer in JSON.
+func (r *Report) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(r)
+}
+
+// compareBlock compares the replayed payload against the block in the audited L2 node, and returns
+// the names of all the mismatched fields.
+func compareBlock(payload *engine.ExecutableData, expected *types.Block) []string {
+	var mismatches []string
+	check := func(name string, match bool) {
+		if !match {
+			mismatches = append(mismatches, name)
+		}
+	}
+
+	check("parentHash", payload.ParentHash == expected.ParentHash())
+	check("feeRecipient", payload.FeeRecipient == expected.Coinbase())
+	check("stateRoot", payload.StateRoot == expected.Root())
+	check("receiptsRoot", payload.ReceiptsRoot == expected.ReceiptHash())
+	check("mixHash", payload.Random == expected.MixDigest())
+	check("gasLimit", payload.GasLimit == expected.GasLimit())
+	check("gasUsed", payload.GasUsed == expected.GasUsed())
+	check("timestamp", payload.Timestamp == expected.Time())
+	check("extraData", bytes.Equal(payload.ExtraData, expected.Extra()))
+	check(
+		"baseFee",
+		payload.BaseFeePerGas != nil && expected.BaseFee() != nil && payload.BaseFeePerGas.Cmp(expected.BaseFee()) == 0,
+	)
+	check("transactions", sameTransactions(payload.Transactions, expected.Transactions()))
+	check("withdrawals", types.DeriveSha(types.Withdrawals(payload.Withdrawals), trie.NewStackTrie(nil)) ==
+		types.DeriveSha(expected.Withdrawals(), trie.NewStackTrie(nil)))
+
+	return mismatches
+}
+
+// sameTransactions checks whether the given encoded transactions are the same as the expected ones.
+func sameTransactions(encoded [][]byte, expected types.Transactions) bool {
+	if len(encoded) != len(expected) {
+		return false
+	}
+
+	for i, b := range encoded {
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(b); err != nil || tx.Hash() != expected[i].Hash() {
+			return false
+		}
+	}
+
+	return true
+}
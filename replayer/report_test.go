@@ -0,0 +1,66 @@
+package replayer
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareBlock(t *testing.T) {
+	var (
+		tx     = types.NewTransaction(1, common.HexToAddress("0x01"), common.Big1, 21000, common.Big1, nil)
+		header = &types.Header{
+			ParentHash: common.HexToHash("0x01"),
+			Root:       common.HexToHash("0x02"),
+			Number:     common.Big1,
+			GasLimit:   1000000,
+			GasUsed:    21000,
+			Time:       100,
+			Extra:      []byte("extra"),
+			BaseFee:    common.Big1,
+		}
+		expected = types.NewBlockWithWithdrawals(
+			header,
+			types.Transactions{tx},
+			nil,
+			nil,
+			types.Withdrawals{},
+			trie.NewStackTrie(nil),
+		)
+	)
+	txBytes, err := tx.MarshalBinary()
+	require.Nil(t, err)
+
+	payload := engine.BlockToExecutableData(expected, common.Big0, nil).ExecutionPayload
+	require.Empty(t, compareBlock(payload, expected))
+
+	payload.StateRoot = common.HexToHash("0x03")
+	payload.GasUsed = 0
+	payload.Transactions = [][]byte{txBytes, txBytes}
+	require.Equal(t, []string{"stateRoot", "gasUsed", "transactions"}, compareBlock(payload, expected))
+}
+
+func TestReport(t *testing.T) {
+	report := new(Report)
+	report.Add(&BlockResult{BlockID: 1, Match: true})
+	report.Add(&BlockResult{BlockID: 2, Mismatches: []string{"stateRoot"}})
+	report.Add(&BlockResult{BlockID: 3, Error: "failed"})
+
+	require.Equal(t, 1, report.Matched)
+	require.Equal(t, 1, report.Mismatched)
+	require.Equal(t, 1, report.Failed)
+
+	var buf bytes.Buffer
+	require.Nil(t, report.Write(&buf))
+
+	decoded := new(Report)
+	require.Nil(t, json.Unmarshal(buf.Bytes(), decoded))
+	require.Len(t, decoded.Blocks, 3)
+	require.Equal(t, []string{"stateRoot"}, decoded.Blocks[1].Mismatches)
+}